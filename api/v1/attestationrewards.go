@@ -0,0 +1,295 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// AttestationRewards are the rewards for attestations in an epoch.
+type AttestationRewards struct {
+	// IdealRewards are the rewards that a validator would have received for perfect attestations, by effective balance.
+	IdealRewards []*IdealAttestationRewards
+	// TotalRewards are the rewards that each validator received.
+	TotalRewards []*ValidatorAttestationRewards
+}
+
+// IdealAttestationRewards are the rewards for perfect attestations by a validator with the given effective balance.
+type IdealAttestationRewards struct {
+	// EffectiveBalance is the effective balance to which the rewards apply.
+	EffectiveBalance spec.Gwei
+	// Head is the reward for a correct head vote.
+	Head spec.Gwei
+	// Target is the reward for a correct target vote.
+	Target spec.Gwei
+	// Source is the reward for a correct source vote.
+	Source spec.Gwei
+	// InclusionDelay is the reward for timely inclusion; only present prior to Altair.
+	InclusionDelay *spec.Gwei
+	// Inactivity is the inactivity penalty.
+	Inactivity int64
+}
+
+// ValidatorAttestationRewards are the rewards received by a validator for its attestation.
+type ValidatorAttestationRewards struct {
+	// ValidatorIndex is the index of the validator.
+	ValidatorIndex spec.ValidatorIndex
+	// Head is the reward for the head vote.
+	Head int64
+	// Target is the reward for the target vote; negative if the vote was incorrect or missing.
+	Target int64
+	// Source is the reward for the source vote; negative if the vote was incorrect or missing.
+	Source int64
+	// InclusionDelay is the reward for timely inclusion; only present prior to Altair.
+	InclusionDelay *spec.Gwei
+	// Inactivity is the inactivity penalty.
+	Inactivity int64
+}
+
+// attestationRewardsJSON is the spec representation of the struct.
+type attestationRewardsJSON struct {
+	IdealRewards []*IdealAttestationRewards     `json:"ideal_rewards"`
+	TotalRewards []*ValidatorAttestationRewards `json:"total_rewards"`
+}
+
+// idealAttestationRewardsJSON is the spec representation of the struct.
+type idealAttestationRewardsJSON struct {
+	EffectiveBalance string `json:"effective_balance"`
+	Head             string `json:"head"`
+	Target           string `json:"target"`
+	Source           string `json:"source"`
+	InclusionDelay   string `json:"inclusion_delay,omitempty"`
+	Inactivity       string `json:"inactivity"`
+}
+
+// validatorAttestationRewardsJSON is the spec representation of the struct.
+type validatorAttestationRewardsJSON struct {
+	ValidatorIndex string `json:"validator_index"`
+	Head           string `json:"head"`
+	Target         string `json:"target"`
+	Source         string `json:"source"`
+	InclusionDelay string `json:"inclusion_delay,omitempty"`
+	Inactivity     string `json:"inactivity"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (a *AttestationRewards) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&attestationRewardsJSON{
+		IdealRewards: a.IdealRewards,
+		TotalRewards: a.TotalRewards,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (a *AttestationRewards) UnmarshalJSON(input []byte) error {
+	var attestationRewardsJSON attestationRewardsJSON
+	if err := json.Unmarshal(input, &attestationRewardsJSON); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+	if attestationRewardsJSON.IdealRewards == nil {
+		return errors.New("ideal rewards missing")
+	}
+	for i := range attestationRewardsJSON.IdealRewards {
+		if attestationRewardsJSON.IdealRewards[i] == nil {
+			return fmt.Errorf("ideal rewards entry %d missing", i)
+		}
+	}
+	a.IdealRewards = attestationRewardsJSON.IdealRewards
+	if attestationRewardsJSON.TotalRewards == nil {
+		return errors.New("total rewards missing")
+	}
+	for i := range attestationRewardsJSON.TotalRewards {
+		if attestationRewardsJSON.TotalRewards[i] == nil {
+			return fmt.Errorf("total rewards entry %d missing", i)
+		}
+	}
+	a.TotalRewards = attestationRewardsJSON.TotalRewards
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (a *AttestationRewards) String() string {
+	data, err := json.Marshal(a)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	return string(data)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (i *IdealAttestationRewards) MarshalJSON() ([]byte, error) {
+	inclusionDelay := ""
+	if i.InclusionDelay != nil {
+		inclusionDelay = fmt.Sprintf("%d", *i.InclusionDelay)
+	}
+	return json.Marshal(&idealAttestationRewardsJSON{
+		EffectiveBalance: fmt.Sprintf("%d", i.EffectiveBalance),
+		Head:             fmt.Sprintf("%d", i.Head),
+		Target:           fmt.Sprintf("%d", i.Target),
+		Source:           fmt.Sprintf("%d", i.Source),
+		InclusionDelay:   inclusionDelay,
+		Inactivity:       fmt.Sprintf("%d", i.Inactivity),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (i *IdealAttestationRewards) UnmarshalJSON(input []byte) error {
+	var idealAttestationRewardsJSON idealAttestationRewardsJSON
+	if err := json.Unmarshal(input, &idealAttestationRewardsJSON); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+	if idealAttestationRewardsJSON.EffectiveBalance == "" {
+		return errors.New("effective balance missing")
+	}
+	effectiveBalance, err := strconv.ParseUint(idealAttestationRewardsJSON.EffectiveBalance, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for effective balance")
+	}
+	i.EffectiveBalance = spec.Gwei(effectiveBalance)
+	if idealAttestationRewardsJSON.Head == "" {
+		return errors.New("head missing")
+	}
+	head, err := strconv.ParseUint(idealAttestationRewardsJSON.Head, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for head")
+	}
+	i.Head = spec.Gwei(head)
+	if idealAttestationRewardsJSON.Target == "" {
+		return errors.New("target missing")
+	}
+	target, err := strconv.ParseUint(idealAttestationRewardsJSON.Target, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for target")
+	}
+	i.Target = spec.Gwei(target)
+	if idealAttestationRewardsJSON.Source == "" {
+		return errors.New("source missing")
+	}
+	source, err := strconv.ParseUint(idealAttestationRewardsJSON.Source, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for source")
+	}
+	i.Source = spec.Gwei(source)
+	if i.InclusionDelay, err = parseInclusionDelay(idealAttestationRewardsJSON.InclusionDelay); err != nil {
+		return err
+	}
+	if idealAttestationRewardsJSON.Inactivity == "" {
+		return errors.New("inactivity missing")
+	}
+	if i.Inactivity, err = strconv.ParseInt(idealAttestationRewardsJSON.Inactivity, 10, 64); err != nil {
+		return errors.Wrap(err, "invalid value for inactivity")
+	}
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (i *IdealAttestationRewards) String() string {
+	data, err := json.Marshal(i)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	return string(data)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v *ValidatorAttestationRewards) MarshalJSON() ([]byte, error) {
+	inclusionDelay := ""
+	if v.InclusionDelay != nil {
+		inclusionDelay = fmt.Sprintf("%d", *v.InclusionDelay)
+	}
+	return json.Marshal(&validatorAttestationRewardsJSON{
+		ValidatorIndex: fmt.Sprintf("%d", v.ValidatorIndex),
+		Head:           fmt.Sprintf("%d", v.Head),
+		Target:         fmt.Sprintf("%d", v.Target),
+		Source:         fmt.Sprintf("%d", v.Source),
+		InclusionDelay: inclusionDelay,
+		Inactivity:     fmt.Sprintf("%d", v.Inactivity),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *ValidatorAttestationRewards) UnmarshalJSON(input []byte) error {
+	var err error
+
+	var validatorAttestationRewardsJSON validatorAttestationRewardsJSON
+	if err = json.Unmarshal(input, &validatorAttestationRewardsJSON); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+	if validatorAttestationRewardsJSON.ValidatorIndex == "" {
+		return errors.New("validator index missing")
+	}
+	validatorIndex, err := strconv.ParseUint(validatorAttestationRewardsJSON.ValidatorIndex, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for validator index")
+	}
+	v.ValidatorIndex = spec.ValidatorIndex(validatorIndex)
+	if validatorAttestationRewardsJSON.Head == "" {
+		return errors.New("head missing")
+	}
+	if v.Head, err = strconv.ParseInt(validatorAttestationRewardsJSON.Head, 10, 64); err != nil {
+		return errors.Wrap(err, "invalid value for head")
+	}
+	if validatorAttestationRewardsJSON.Target == "" {
+		return errors.New("target missing")
+	}
+	if v.Target, err = strconv.ParseInt(validatorAttestationRewardsJSON.Target, 10, 64); err != nil {
+		return errors.Wrap(err, "invalid value for target")
+	}
+	if validatorAttestationRewardsJSON.Source == "" {
+		return errors.New("source missing")
+	}
+	if v.Source, err = strconv.ParseInt(validatorAttestationRewardsJSON.Source, 10, 64); err != nil {
+		return errors.Wrap(err, "invalid value for source")
+	}
+	if v.InclusionDelay, err = parseInclusionDelay(validatorAttestationRewardsJSON.InclusionDelay); err != nil {
+		return err
+	}
+	if validatorAttestationRewardsJSON.Inactivity == "" {
+		return errors.New("inactivity missing")
+	}
+	if v.Inactivity, err = strconv.ParseInt(validatorAttestationRewardsJSON.Inactivity, 10, 64); err != nil {
+		return errors.Wrap(err, "invalid value for inactivity")
+	}
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (v *ValidatorAttestationRewards) String() string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	return string(data)
+}
+
+// parseInclusionDelay parses the optional inclusion delay reward.
+func parseInclusionDelay(input string) (*spec.Gwei, error) {
+	if input == "" {
+		return nil, nil
+	}
+	inclusionDelay, err := strconv.ParseUint(input, 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid value for inclusion delay")
+	}
+	res := spec.Gwei(inclusionDelay)
+	return &res, nil
+}
@@ -0,0 +1,349 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	require "github.com/stretchr/testify/require"
+	"gotest.tools/assert"
+)
+
+func TestAttestationRewardsJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte(`[]`),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type v1.attestationRewardsJSON",
+		},
+		{
+			name:  "IdealRewardsMissing",
+			input: []byte(`{"total_rewards":[{"validator_index":"12","head":"2000","target":"-5000","source":"4000","inactivity":"-30"}]}`),
+			err:   "ideal rewards missing",
+		},
+		{
+			name:  "IdealRewardsWrongType",
+			input: []byte(`{"ideal_rewards":true,"total_rewards":[{"validator_index":"12","head":"2000","target":"-5000","source":"4000","inactivity":"-30"}]}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field attestationRewardsJSON.ideal_rewards of type []*v1.IdealAttestationRewards",
+		},
+		{
+			name:  "TotalRewardsMissing",
+			input: []byte(`{"ideal_rewards":[{"effective_balance":"32000000000","head":"2500","target":"5000","source":"4500","inactivity":"0"}]}`),
+			err:   "total rewards missing",
+		},
+		{
+			name:  "TotalRewardsWrongType",
+			input: []byte(`{"ideal_rewards":[{"effective_balance":"32000000000","head":"2500","target":"5000","source":"4500","inactivity":"0"}],"total_rewards":true}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field attestationRewardsJSON.total_rewards of type []*v1.ValidatorAttestationRewards",
+		},
+		{
+			name:  "IdealRewardsEntryMissing",
+			input: []byte(`{"ideal_rewards":[null],"total_rewards":[{"validator_index":"12","head":"2000","target":"-5000","source":"4000","inactivity":"-30"}]}`),
+			err:   "ideal rewards entry 0 missing",
+		},
+		{
+			name:  "IdealRewardsEntryInvalid",
+			input: []byte(`{"ideal_rewards":[{}],"total_rewards":[{"validator_index":"12","head":"2000","target":"-5000","source":"4000","inactivity":"-30"}]}`),
+			err:   "invalid JSON: effective balance missing",
+		},
+		{
+			name:  "TotalRewardsEntryMissing",
+			input: []byte(`{"ideal_rewards":[{"effective_balance":"32000000000","head":"2500","target":"5000","source":"4500","inactivity":"0"}],"total_rewards":[null]}`),
+			err:   "total rewards entry 0 missing",
+		},
+		{
+			name:  "TotalRewardsEntryInvalid",
+			input: []byte(`{"ideal_rewards":[{"effective_balance":"32000000000","head":"2500","target":"5000","source":"4500","inactivity":"0"}],"total_rewards":[{}]}`),
+			err:   "invalid JSON: validator index missing",
+		},
+		{
+			name:  "EmptyLists",
+			input: []byte(`{"ideal_rewards":[],"total_rewards":[{"validator_index":"12","head":"2000","target":"-5000","source":"4000","inactivity":"-30"}]}`),
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"ideal_rewards":[{"effective_balance":"32000000000","head":"2500","target":"5000","source":"4500","inactivity":"0"}],"total_rewards":[{"validator_index":"12","head":"2000","target":"-5000","source":"4000","inactivity":"-30"}]}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res api.AttestationRewards
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+				assert.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
+
+func TestIdealAttestationRewardsJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte(`[]`),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type v1.idealAttestationRewardsJSON",
+		},
+		{
+			name:  "EffectiveBalanceMissing",
+			input: []byte(`{"head":"2500","target":"5000","source":"4500","inclusion_delay":"1200","inactivity":"0"}`),
+			err:   "effective balance missing",
+		},
+		{
+			name:  "EffectiveBalanceWrongType",
+			input: []byte(`{"effective_balance":true,"head":"2500","target":"5000","source":"4500","inclusion_delay":"1200","inactivity":"0"}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field idealAttestationRewardsJSON.effective_balance of type string",
+		},
+		{
+			name:  "EffectiveBalanceInvalid",
+			input: []byte(`{"effective_balance":"-1","head":"2500","target":"5000","source":"4500","inclusion_delay":"1200","inactivity":"0"}`),
+			err:   "invalid value for effective balance: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "HeadMissing",
+			input: []byte(`{"effective_balance":"32000000000","target":"5000","source":"4500","inclusion_delay":"1200","inactivity":"0"}`),
+			err:   "head missing",
+		},
+		{
+			name:  "HeadWrongType",
+			input: []byte(`{"effective_balance":"32000000000","head":true,"target":"5000","source":"4500","inclusion_delay":"1200","inactivity":"0"}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field idealAttestationRewardsJSON.head of type string",
+		},
+		{
+			name:  "HeadInvalid",
+			input: []byte(`{"effective_balance":"32000000000","head":"-1","target":"5000","source":"4500","inclusion_delay":"1200","inactivity":"0"}`),
+			err:   "invalid value for head: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "TargetMissing",
+			input: []byte(`{"effective_balance":"32000000000","head":"2500","source":"4500","inclusion_delay":"1200","inactivity":"0"}`),
+			err:   "target missing",
+		},
+		{
+			name:  "TargetWrongType",
+			input: []byte(`{"effective_balance":"32000000000","head":"2500","target":true,"source":"4500","inclusion_delay":"1200","inactivity":"0"}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field idealAttestationRewardsJSON.target of type string",
+		},
+		{
+			name:  "TargetInvalid",
+			input: []byte(`{"effective_balance":"32000000000","head":"2500","target":"-1","source":"4500","inclusion_delay":"1200","inactivity":"0"}`),
+			err:   "invalid value for target: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "SourceMissing",
+			input: []byte(`{"effective_balance":"32000000000","head":"2500","target":"5000","inclusion_delay":"1200","inactivity":"0"}`),
+			err:   "source missing",
+		},
+		{
+			name:  "SourceWrongType",
+			input: []byte(`{"effective_balance":"32000000000","head":"2500","target":"5000","source":true,"inclusion_delay":"1200","inactivity":"0"}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field idealAttestationRewardsJSON.source of type string",
+		},
+		{
+			name:  "SourceInvalid",
+			input: []byte(`{"effective_balance":"32000000000","head":"2500","target":"5000","source":"-1","inclusion_delay":"1200","inactivity":"0"}`),
+			err:   "invalid value for source: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "InactivityMissing",
+			input: []byte(`{"effective_balance":"32000000000","head":"2500","target":"5000","source":"4500","inclusion_delay":"1200"}`),
+			err:   "inactivity missing",
+		},
+		{
+			name:  "InactivityWrongType",
+			input: []byte(`{"effective_balance":"32000000000","head":"2500","target":"5000","source":"4500","inclusion_delay":"1200","inactivity":true}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field idealAttestationRewardsJSON.inactivity of type string",
+		},
+		{
+			name:  "InactivityInvalid",
+			input: []byte(`{"effective_balance":"32000000000","head":"2500","target":"5000","source":"4500","inclusion_delay":"1200","inactivity":"invalid"}`),
+			err:   "invalid value for inactivity: strconv.ParseInt: parsing \"invalid\": invalid syntax",
+		},
+		{
+			name:  "InclusionDelayAbsent",
+			input: []byte(`{"effective_balance":"32000000000","head":"2500","target":"5000","source":"4500","inactivity":"0"}`),
+		},
+		{
+			name:  "InclusionDelayInvalid",
+			input: []byte(`{"effective_balance":"32000000000","head":"2500","target":"5000","source":"4500","inclusion_delay":"-1","inactivity":"0"}`),
+			err:   "invalid value for inclusion delay: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"effective_balance":"32000000000","head":"2500","target":"5000","source":"4500","inclusion_delay":"1200","inactivity":"0"}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res api.IdealAttestationRewards
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+				assert.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
+
+func TestValidatorAttestationRewardsJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte(`[]`),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type v1.validatorAttestationRewardsJSON",
+		},
+		{
+			name:  "ValidatorIndexMissing",
+			input: []byte(`{"head":"2000","target":"-5000","source":"4000","inclusion_delay":"1000","inactivity":"-30"}`),
+			err:   "validator index missing",
+		},
+		{
+			name:  "ValidatorIndexWrongType",
+			input: []byte(`{"validator_index":true,"head":"2000","target":"-5000","source":"4000","inclusion_delay":"1000","inactivity":"-30"}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field validatorAttestationRewardsJSON.validator_index of type string",
+		},
+		{
+			name:  "ValidatorIndexInvalid",
+			input: []byte(`{"validator_index":"-1","head":"2000","target":"-5000","source":"4000","inclusion_delay":"1000","inactivity":"-30"}`),
+			err:   "invalid value for validator index: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "HeadMissing",
+			input: []byte(`{"validator_index":"12","target":"-5000","source":"4000","inclusion_delay":"1000","inactivity":"-30"}`),
+			err:   "head missing",
+		},
+		{
+			name:  "HeadWrongType",
+			input: []byte(`{"validator_index":"12","head":true,"target":"-5000","source":"4000","inclusion_delay":"1000","inactivity":"-30"}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field validatorAttestationRewardsJSON.head of type string",
+		},
+		{
+			name:  "HeadInvalid",
+			input: []byte(`{"validator_index":"12","head":"invalid","target":"-5000","source":"4000","inclusion_delay":"1000","inactivity":"-30"}`),
+			err:   "invalid value for head: strconv.ParseInt: parsing \"invalid\": invalid syntax",
+		},
+		{
+			name:  "TargetMissing",
+			input: []byte(`{"validator_index":"12","head":"2000","source":"4000","inclusion_delay":"1000","inactivity":"-30"}`),
+			err:   "target missing",
+		},
+		{
+			name:  "TargetWrongType",
+			input: []byte(`{"validator_index":"12","head":"2000","target":true,"source":"4000","inclusion_delay":"1000","inactivity":"-30"}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field validatorAttestationRewardsJSON.target of type string",
+		},
+		{
+			name:  "TargetInvalid",
+			input: []byte(`{"validator_index":"12","head":"2000","target":"invalid","source":"4000","inclusion_delay":"1000","inactivity":"-30"}`),
+			err:   "invalid value for target: strconv.ParseInt: parsing \"invalid\": invalid syntax",
+		},
+		{
+			name:  "SourceMissing",
+			input: []byte(`{"validator_index":"12","head":"2000","target":"-5000","inclusion_delay":"1000","inactivity":"-30"}`),
+			err:   "source missing",
+		},
+		{
+			name:  "SourceWrongType",
+			input: []byte(`{"validator_index":"12","head":"2000","target":"-5000","source":true,"inclusion_delay":"1000","inactivity":"-30"}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field validatorAttestationRewardsJSON.source of type string",
+		},
+		{
+			name:  "SourceInvalid",
+			input: []byte(`{"validator_index":"12","head":"2000","target":"-5000","source":"invalid","inclusion_delay":"1000","inactivity":"-30"}`),
+			err:   "invalid value for source: strconv.ParseInt: parsing \"invalid\": invalid syntax",
+		},
+		{
+			name:  "InactivityMissing",
+			input: []byte(`{"validator_index":"12","head":"2000","target":"-5000","source":"4000","inclusion_delay":"1000"}`),
+			err:   "inactivity missing",
+		},
+		{
+			name:  "InactivityWrongType",
+			input: []byte(`{"validator_index":"12","head":"2000","target":"-5000","source":"4000","inclusion_delay":"1000","inactivity":true}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field validatorAttestationRewardsJSON.inactivity of type string",
+		},
+		{
+			name:  "InactivityInvalid",
+			input: []byte(`{"validator_index":"12","head":"2000","target":"-5000","source":"4000","inclusion_delay":"1000","inactivity":"invalid"}`),
+			err:   "invalid value for inactivity: strconv.ParseInt: parsing \"invalid\": invalid syntax",
+		},
+		{
+			name:  "InclusionDelayAbsent",
+			input: []byte(`{"validator_index":"12","head":"2000","target":"-5000","source":"4000","inactivity":"-30"}`),
+		},
+		{
+			name:  "InclusionDelayInvalid",
+			input: []byte(`{"validator_index":"12","head":"2000","target":"-5000","source":"4000","inclusion_delay":"-1","inactivity":"-30"}`),
+			err:   "invalid value for inclusion delay: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"validator_index":"12","head":"2000","target":"-5000","source":"4000","inclusion_delay":"1000","inactivity":"-30"}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res api.ValidatorAttestationRewards
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+				assert.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
@@ -40,6 +40,9 @@ type AttesterDuty struct {
 	CommitteesAtSlot uint64
 	// ValidatorCommitteeIndex is the index of the validator in the list of validators in the committee.
 	ValidatorCommitteeIndex uint64
+	// ExecutionOptimistic is true if the block on which the duty is based has not yet been fully validated by the execution layer.
+	// It is populated from the response metadata, and is not part of the JSON representation.
+	ExecutionOptimistic bool
 }
 
 // attesterDutyJSON is the spec representation of the struct.
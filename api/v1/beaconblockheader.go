@@ -31,6 +31,12 @@ type BeaconBlockHeader struct {
 	Canonical bool
 	// Header is the beacon block header.
 	Header *spec.SignedBeaconBlockHeader
+	// ExecutionOptimistic is true if the block has not yet been fully validated by the execution layer.
+	// It is populated from the response metadata, and is not part of the JSON representation.
+	ExecutionOptimistic bool
+	// Finalized is true if the block is finalized.
+	// It is populated from the response metadata, and is not part of the JSON representation.
+	Finalized bool
 }
 
 // beaconBlockHeaderJSON is the spec representation of the struct.
@@ -0,0 +1,115 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// BlockRewards are the rewards received by the proposer of a block.
+type BlockRewards struct {
+	// ProposerIndex is the index of the proposer of the block.
+	ProposerIndex spec.ValidatorIndex
+	// Total is the total reward for the block.
+	Total spec.Gwei
+	// Attestations is the reward for the attestations included in the block.
+	Attestations spec.Gwei
+	// SyncAggregate is the reward for the sync aggregate included in the block.
+	SyncAggregate spec.Gwei
+	// ProposerSlashings is the reward for the proposer slashings included in the block.
+	ProposerSlashings spec.Gwei
+	// AttesterSlashings is the reward for the attester slashings included in the block.
+	AttesterSlashings spec.Gwei
+}
+
+// blockRewardsJSON is the spec representation of the struct.
+type blockRewardsJSON struct {
+	ProposerIndex     string `json:"proposer_index"`
+	Total             string `json:"total"`
+	Attestations      string `json:"attestations"`
+	SyncAggregate     string `json:"sync_aggregate"`
+	ProposerSlashings string `json:"proposer_slashings"`
+	AttesterSlashings string `json:"attester_slashings"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (b *BlockRewards) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&blockRewardsJSON{
+		ProposerIndex:     fmt.Sprintf("%d", b.ProposerIndex),
+		Total:             fmt.Sprintf("%d", b.Total),
+		Attestations:      fmt.Sprintf("%d", b.Attestations),
+		SyncAggregate:     fmt.Sprintf("%d", b.SyncAggregate),
+		ProposerSlashings: fmt.Sprintf("%d", b.ProposerSlashings),
+		AttesterSlashings: fmt.Sprintf("%d", b.AttesterSlashings),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *BlockRewards) UnmarshalJSON(input []byte) error {
+	var blockRewardsJSON blockRewardsJSON
+	if err := json.Unmarshal(input, &blockRewardsJSON); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+	if blockRewardsJSON.ProposerIndex == "" {
+		return errors.New("proposer index missing")
+	}
+	proposerIndex, err := strconv.ParseUint(blockRewardsJSON.ProposerIndex, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for proposer index")
+	}
+	b.ProposerIndex = spec.ValidatorIndex(proposerIndex)
+	if b.Total, err = parseGwei(blockRewardsJSON.Total, "total"); err != nil {
+		return err
+	}
+	if b.Attestations, err = parseGwei(blockRewardsJSON.Attestations, "attestations"); err != nil {
+		return err
+	}
+	if b.SyncAggregate, err = parseGwei(blockRewardsJSON.SyncAggregate, "sync aggregate"); err != nil {
+		return err
+	}
+	if b.ProposerSlashings, err = parseGwei(blockRewardsJSON.ProposerSlashings, "proposer slashings"); err != nil {
+		return err
+	}
+	if b.AttesterSlashings, err = parseGwei(blockRewardsJSON.AttesterSlashings, "attester slashings"); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (b *BlockRewards) String() string {
+	data, err := json.Marshal(b)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	return string(data)
+}
+
+// parseGwei parses a mandatory Gwei value.
+func parseGwei(input string, name string) (spec.Gwei, error) {
+	if input == "" {
+		return 0, fmt.Errorf("%s missing", name)
+	}
+	val, err := strconv.ParseUint(input, 10, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid value for %s", name)
+	}
+	return spec.Gwei(val), nil
+}
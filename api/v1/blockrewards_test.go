@@ -0,0 +1,151 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	require "github.com/stretchr/testify/require"
+	"gotest.tools/assert"
+)
+
+func TestBlockRewardsJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte(`[]`),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type v1.blockRewardsJSON",
+		},
+		{
+			name:  "ProposerIndexMissing",
+			input: []byte(`{"total":"123456","attestations":"100000","sync_aggregate":"20000","proposer_slashings":"3456","attester_slashings":"0"}`),
+			err:   "proposer index missing",
+		},
+		{
+			name:  "ProposerIndexWrongType",
+			input: []byte(`{"proposer_index":true,"total":"123456","attestations":"100000","sync_aggregate":"20000","proposer_slashings":"3456","attester_slashings":"0"}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field blockRewardsJSON.proposer_index of type string",
+		},
+		{
+			name:  "ProposerIndexInvalid",
+			input: []byte(`{"proposer_index":"-1","total":"123456","attestations":"100000","sync_aggregate":"20000","proposer_slashings":"3456","attester_slashings":"0"}`),
+			err:   "invalid value for proposer index: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "TotalMissing",
+			input: []byte(`{"proposer_index":"123","attestations":"100000","sync_aggregate":"20000","proposer_slashings":"3456","attester_slashings":"0"}`),
+			err:   "total missing",
+		},
+		{
+			name:  "TotalWrongType",
+			input: []byte(`{"proposer_index":"123","total":true,"attestations":"100000","sync_aggregate":"20000","proposer_slashings":"3456","attester_slashings":"0"}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field blockRewardsJSON.total of type string",
+		},
+		{
+			name:  "TotalInvalid",
+			input: []byte(`{"proposer_index":"123","total":"-1","attestations":"100000","sync_aggregate":"20000","proposer_slashings":"3456","attester_slashings":"0"}`),
+			err:   "invalid value for total: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "AttestationsMissing",
+			input: []byte(`{"proposer_index":"123","total":"123456","sync_aggregate":"20000","proposer_slashings":"3456","attester_slashings":"0"}`),
+			err:   "attestations missing",
+		},
+		{
+			name:  "AttestationsWrongType",
+			input: []byte(`{"proposer_index":"123","total":"123456","attestations":true,"sync_aggregate":"20000","proposer_slashings":"3456","attester_slashings":"0"}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field blockRewardsJSON.attestations of type string",
+		},
+		{
+			name:  "AttestationsInvalid",
+			input: []byte(`{"proposer_index":"123","total":"123456","attestations":"-1","sync_aggregate":"20000","proposer_slashings":"3456","attester_slashings":"0"}`),
+			err:   "invalid value for attestations: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "SyncAggregateMissing",
+			input: []byte(`{"proposer_index":"123","total":"123456","attestations":"100000","proposer_slashings":"3456","attester_slashings":"0"}`),
+			err:   "sync aggregate missing",
+		},
+		{
+			name:  "SyncAggregateWrongType",
+			input: []byte(`{"proposer_index":"123","total":"123456","attestations":"100000","sync_aggregate":true,"proposer_slashings":"3456","attester_slashings":"0"}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field blockRewardsJSON.sync_aggregate of type string",
+		},
+		{
+			name:  "SyncAggregateInvalid",
+			input: []byte(`{"proposer_index":"123","total":"123456","attestations":"100000","sync_aggregate":"-1","proposer_slashings":"3456","attester_slashings":"0"}`),
+			err:   "invalid value for sync aggregate: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "ProposerSlashingsMissing",
+			input: []byte(`{"proposer_index":"123","total":"123456","attestations":"100000","sync_aggregate":"20000","attester_slashings":"0"}`),
+			err:   "proposer slashings missing",
+		},
+		{
+			name:  "ProposerSlashingsWrongType",
+			input: []byte(`{"proposer_index":"123","total":"123456","attestations":"100000","sync_aggregate":"20000","proposer_slashings":true,"attester_slashings":"0"}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field blockRewardsJSON.proposer_slashings of type string",
+		},
+		{
+			name:  "ProposerSlashingsInvalid",
+			input: []byte(`{"proposer_index":"123","total":"123456","attestations":"100000","sync_aggregate":"20000","proposer_slashings":"-1","attester_slashings":"0"}`),
+			err:   "invalid value for proposer slashings: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "AttesterSlashingsMissing",
+			input: []byte(`{"proposer_index":"123","total":"123456","attestations":"100000","sync_aggregate":"20000","proposer_slashings":"3456"}`),
+			err:   "attester slashings missing",
+		},
+		{
+			name:  "AttesterSlashingsWrongType",
+			input: []byte(`{"proposer_index":"123","total":"123456","attestations":"100000","sync_aggregate":"20000","proposer_slashings":"3456","attester_slashings":true}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field blockRewardsJSON.attester_slashings of type string",
+		},
+		{
+			name:  "AttesterSlashingsInvalid",
+			input: []byte(`{"proposer_index":"123","total":"123456","attestations":"100000","sync_aggregate":"20000","proposer_slashings":"3456","attester_slashings":"-1"}`),
+			err:   "invalid value for attester slashings: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"proposer_index":"123","total":"123456","attestations":"100000","sync_aggregate":"20000","proposer_slashings":"3456","attester_slashings":"0"}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res api.BlockRewards
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+				assert.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
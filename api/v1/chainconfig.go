@@ -0,0 +1,163 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"time"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// ChainConfig is the typed configuration of the chain.
+// Fork epochs that are not present in the configuration are set to the far future epoch.
+type ChainConfig struct {
+	// ConfigName is CONFIG_NAME.
+	ConfigName string
+	// PresetBase is PRESET_BASE.
+	PresetBase string
+	// MaxCommitteesPerSlot is MAX_COMMITTEES_PER_SLOT.
+	MaxCommitteesPerSlot uint64
+	// TargetCommitteeSize is TARGET_COMMITTEE_SIZE.
+	TargetCommitteeSize uint64
+	// MaxValidatorsPerCommittee is MAX_VALIDATORS_PER_COMMITTEE.
+	MaxValidatorsPerCommittee uint64
+	// ShuffleRoundCount is SHUFFLE_ROUND_COUNT.
+	ShuffleRoundCount uint64
+	// TargetAggregatorsPerCommittee is TARGET_AGGREGATORS_PER_COMMITTEE.
+	TargetAggregatorsPerCommittee uint64
+	// MinGenesisActiveValidatorCount is MIN_GENESIS_ACTIVE_VALIDATOR_COUNT.
+	MinGenesisActiveValidatorCount uint64
+	// MinGenesisTime is MIN_GENESIS_TIME.
+	MinGenesisTime time.Time
+	// GenesisDelay is GENESIS_DELAY.
+	GenesisDelay time.Duration
+	// SecondsPerSlot is SECONDS_PER_SLOT.
+	SecondsPerSlot time.Duration
+	// SecondsPerETH1Block is SECONDS_PER_ETH1_BLOCK.
+	SecondsPerETH1Block time.Duration
+	// SlotsPerEpoch is SLOTS_PER_EPOCH.
+	SlotsPerEpoch uint64
+	// MinAttestationInclusionDelay is MIN_ATTESTATION_INCLUSION_DELAY.
+	MinAttestationInclusionDelay uint64
+	// MinSeedLookahead is MIN_SEED_LOOKAHEAD.
+	MinSeedLookahead spec.Epoch
+	// MaxSeedLookahead is MAX_SEED_LOOKAHEAD.
+	MaxSeedLookahead spec.Epoch
+	// EpochsPerETH1VotingPeriod is EPOCHS_PER_ETH1_VOTING_PERIOD.
+	EpochsPerETH1VotingPeriod spec.Epoch
+	// AttestationPropagationSlotRange is ATTESTATION_PROPAGATION_SLOT_RANGE.
+	AttestationPropagationSlotRange uint64
+	// SlotsPerHistoricalRoot is SLOTS_PER_HISTORICAL_ROOT.
+	SlotsPerHistoricalRoot uint64
+	// MinValidatorWithdrawabilityDelay is MIN_VALIDATOR_WITHDRAWABILITY_DELAY.
+	MinValidatorWithdrawabilityDelay spec.Epoch
+	// ShardCommitteePeriod is SHARD_COMMITTEE_PERIOD.
+	ShardCommitteePeriod spec.Epoch
+	// ETH1FollowDistance is ETH1_FOLLOW_DISTANCE.
+	ETH1FollowDistance uint64
+	// EpochsPerHistoricalVector is EPOCHS_PER_HISTORICAL_VECTOR.
+	EpochsPerHistoricalVector spec.Epoch
+	// EpochsPerSlashingsVector is EPOCHS_PER_SLASHINGS_VECTOR.
+	EpochsPerSlashingsVector spec.Epoch
+	// HistoricalRootsLimit is HISTORICAL_ROOTS_LIMIT.
+	HistoricalRootsLimit uint64
+	// ValidatorRegistryLimit is VALIDATOR_REGISTRY_LIMIT.
+	ValidatorRegistryLimit uint64
+	// BaseRewardFactor is BASE_REWARD_FACTOR.
+	BaseRewardFactor uint64
+	// WhistleblowerRewardQuotient is WHISTLEBLOWER_REWARD_QUOTIENT.
+	WhistleblowerRewardQuotient uint64
+	// ProposerRewardQuotient is PROPOSER_REWARD_QUOTIENT.
+	ProposerRewardQuotient uint64
+	// InactivityPenaltyQuotient is INACTIVITY_PENALTY_QUOTIENT.
+	InactivityPenaltyQuotient uint64
+	// MinSlashingPenaltyQuotient is MIN_SLASHING_PENALTY_QUOTIENT.
+	MinSlashingPenaltyQuotient uint64
+	// ProportionalSlashingMultiplier is PROPORTIONAL_SLASHING_MULTIPLIER.
+	ProportionalSlashingMultiplier uint64
+	// MaxProposerSlashings is MAX_PROPOSER_SLASHINGS.
+	MaxProposerSlashings uint64
+	// MaxAttesterSlashings is MAX_ATTESTER_SLASHINGS.
+	MaxAttesterSlashings uint64
+	// MaxAttestations is MAX_ATTESTATIONS.
+	MaxAttestations uint64
+	// MaxDeposits is MAX_DEPOSITS.
+	MaxDeposits uint64
+	// MaxVoluntaryExits is MAX_VOLUNTARY_EXITS.
+	MaxVoluntaryExits uint64
+	// MinDepositAmount is MIN_DEPOSIT_AMOUNT.
+	MinDepositAmount spec.Gwei
+	// MaxEffectiveBalance is MAX_EFFECTIVE_BALANCE.
+	MaxEffectiveBalance spec.Gwei
+	// EjectionBalance is EJECTION_BALANCE.
+	EjectionBalance spec.Gwei
+	// EffectiveBalanceIncrement is EFFECTIVE_BALANCE_INCREMENT.
+	EffectiveBalanceIncrement spec.Gwei
+	// MinPerEpochChurnLimit is MIN_PER_EPOCH_CHURN_LIMIT.
+	MinPerEpochChurnLimit uint64
+	// ChurnLimitQuotient is CHURN_LIMIT_QUOTIENT.
+	ChurnLimitQuotient uint64
+	// DepositChainID is DEPOSIT_CHAIN_ID.
+	DepositChainID uint64
+	// DepositNetworkID is DEPOSIT_NETWORK_ID.
+	DepositNetworkID uint64
+	// DepositContractAddress is DEPOSIT_CONTRACT_ADDRESS.
+	DepositContractAddress []byte
+	// GenesisForkVersion is GENESIS_FORK_VERSION.
+	GenesisForkVersion spec.Version
+	// AltairForkVersion is ALTAIR_FORK_VERSION.
+	AltairForkVersion spec.Version
+	// AltairForkEpoch is ALTAIR_FORK_EPOCH.
+	AltairForkEpoch spec.Epoch
+	// BellatrixForkVersion is BELLATRIX_FORK_VERSION.
+	BellatrixForkVersion spec.Version
+	// BellatrixForkEpoch is BELLATRIX_FORK_EPOCH.
+	BellatrixForkEpoch spec.Epoch
+	// CapellaForkVersion is CAPELLA_FORK_VERSION.
+	CapellaForkVersion spec.Version
+	// CapellaForkEpoch is CAPELLA_FORK_EPOCH.
+	CapellaForkEpoch spec.Epoch
+	// DenebForkVersion is DENEB_FORK_VERSION.
+	DenebForkVersion spec.Version
+	// DenebForkEpoch is DENEB_FORK_EPOCH.
+	DenebForkEpoch spec.Epoch
+	// SyncCommitteeSize is SYNC_COMMITTEE_SIZE.
+	SyncCommitteeSize uint64
+	// EpochsPerSyncCommitteePeriod is EPOCHS_PER_SYNC_COMMITTEE_PERIOD.
+	EpochsPerSyncCommitteePeriod spec.Epoch
+	// DomainBeaconProposer is DOMAIN_BEACON_PROPOSER.
+	DomainBeaconProposer spec.DomainType
+	// DomainBeaconAttester is DOMAIN_BEACON_ATTESTER.
+	DomainBeaconAttester spec.DomainType
+	// DomainRANDAO is DOMAIN_RANDAO.
+	DomainRANDAO spec.DomainType
+	// DomainDeposit is DOMAIN_DEPOSIT.
+	DomainDeposit spec.DomainType
+	// DomainVoluntaryExit is DOMAIN_VOLUNTARY_EXIT.
+	DomainVoluntaryExit spec.DomainType
+	// DomainSelectionProof is DOMAIN_SELECTION_PROOF.
+	DomainSelectionProof spec.DomainType
+	// DomainAggregateAndProof is DOMAIN_AGGREGATE_AND_PROOF.
+	DomainAggregateAndProof spec.DomainType
+	// DomainSyncCommittee is DOMAIN_SYNC_COMMITTEE.
+	DomainSyncCommittee spec.DomainType
+	// DomainSyncCommitteeSelectionProof is DOMAIN_SYNC_COMMITTEE_SELECTION_PROOF.
+	DomainSyncCommitteeSelectionProof spec.DomainType
+	// DomainContributionAndProof is DOMAIN_CONTRIBUTION_AND_PROOF.
+	DomainContributionAndProof spec.DomainType
+	// DomainApplicationMask is DOMAIN_APPLICATION_MASK.
+	DomainApplicationMask spec.DomainType
+	// DomainBLSToExecutionChange is DOMAIN_BLS_TO_EXECUTION_CHANGE.
+	DomainBLSToExecutionChange spec.DomainType
+}
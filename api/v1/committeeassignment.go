@@ -0,0 +1,34 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// CommitteeAssignment is the assignment of a validator to a beacon committee, as computed from a beacon state.
+type CommitteeAssignment struct {
+	// ValidatorIndex is the index of the assigned validator.
+	ValidatorIndex spec.ValidatorIndex
+	// Slot is the slot in which the validator should attest.
+	Slot spec.Slot
+	// CommitteeIndex is the index of the committee in which the validator has been placed.
+	CommitteeIndex spec.CommitteeIndex
+	// CommitteeLength is the length of the committee in which the validator has been placed.
+	CommitteeLength uint64
+	// CommitteesAtSlot is the number of committees in the slot.
+	CommitteesAtSlot uint64
+	// ValidatorCommitteeIndex is the index of the validator in the list of validators in the committee.
+	ValidatorCommitteeIndex uint64
+}
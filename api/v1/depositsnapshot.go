@@ -0,0 +1,118 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// DepositSnapshot is a snapshot of the finalized deposit tree, as defined in EIP-4881.
+type DepositSnapshot struct {
+	// Finalized are the roots of the finalized subtrees of the deposit tree.
+	Finalized []spec.Root
+	// DepositRoot is the root of the deposit tree.
+	DepositRoot spec.Root
+	// DepositCount is the number of deposits in the deposit tree.
+	DepositCount uint64
+	// ExecutionBlockHash is the hash of the execution block containing the last finalized deposit.
+	ExecutionBlockHash []byte
+	// ExecutionBlockHeight is the height of the execution block containing the last finalized deposit.
+	ExecutionBlockHeight uint64
+}
+
+// depositSnapshotJSON is the spec representation of the struct.
+type depositSnapshotJSON struct {
+	Finalized            []string `json:"finalized"`
+	DepositRoot          string   `json:"deposit_root"`
+	DepositCount         string   `json:"deposit_count"`
+	ExecutionBlockHash   string   `json:"execution_block_hash"`
+	ExecutionBlockHeight string   `json:"execution_block_height"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (d *DepositSnapshot) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&depositSnapshotJSON{
+		Finalized:            branchToJSON(d.Finalized),
+		DepositRoot:          fmt.Sprintf("%#x", d.DepositRoot),
+		DepositCount:         fmt.Sprintf("%d", d.DepositCount),
+		ExecutionBlockHash:   fmt.Sprintf("%#x", d.ExecutionBlockHash),
+		ExecutionBlockHeight: fmt.Sprintf("%d", d.ExecutionBlockHeight),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *DepositSnapshot) UnmarshalJSON(input []byte) error {
+	var err error
+
+	var depositSnapshotJSON depositSnapshotJSON
+	if err = json.Unmarshal(input, &depositSnapshotJSON); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+	if depositSnapshotJSON.Finalized == nil {
+		return errors.New("finalized missing")
+	}
+	if d.Finalized, err = rootsFromJSON(depositSnapshotJSON.Finalized); err != nil {
+		return errors.Wrap(err, "invalid value for finalized")
+	}
+	if depositSnapshotJSON.DepositRoot == "" {
+		return errors.New("deposit root missing")
+	}
+	depositRoot, err := hex.DecodeString(strings.TrimPrefix(depositSnapshotJSON.DepositRoot, "0x"))
+	if err != nil {
+		return errors.Wrap(err, "invalid value for deposit root")
+	}
+	if len(depositRoot) != rootLength {
+		return fmt.Errorf("incorrect length %d for deposit root", len(depositRoot))
+	}
+	copy(d.DepositRoot[:], depositRoot)
+	if depositSnapshotJSON.DepositCount == "" {
+		return errors.New("deposit count missing")
+	}
+	if d.DepositCount, err = strconv.ParseUint(depositSnapshotJSON.DepositCount, 10, 64); err != nil {
+		return errors.Wrap(err, "invalid value for deposit count")
+	}
+	if depositSnapshotJSON.ExecutionBlockHash == "" {
+		return errors.New("execution block hash missing")
+	}
+	if d.ExecutionBlockHash, err = hex.DecodeString(strings.TrimPrefix(depositSnapshotJSON.ExecutionBlockHash, "0x")); err != nil {
+		return errors.Wrap(err, "invalid value for execution block hash")
+	}
+	if len(d.ExecutionBlockHash) != rootLength {
+		return fmt.Errorf("incorrect length %d for execution block hash", len(d.ExecutionBlockHash))
+	}
+	if depositSnapshotJSON.ExecutionBlockHeight == "" {
+		return errors.New("execution block height missing")
+	}
+	if d.ExecutionBlockHeight, err = strconv.ParseUint(depositSnapshotJSON.ExecutionBlockHeight, 10, 64); err != nil {
+		return errors.Wrap(err, "invalid value for execution block height")
+	}
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (d *DepositSnapshot) String() string {
+	data, err := json.Marshal(d)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	return string(data)
+}
@@ -0,0 +1,155 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	require "github.com/stretchr/testify/require"
+	"gotest.tools/assert"
+)
+
+func TestDepositSnapshotJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte(`[]`),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type v1.depositSnapshotJSON",
+		},
+		{
+			name:  "FinalizedMissing",
+			input: []byte(`{"deposit_root":"0x9f5d6a4769cddd2fa0f2e8b6c51a5a8fb2e5ecdb13c9f9b7b4e5d7a0b3c2d1e0","deposit_count":"12345","execution_block_hash":"0x2c3b0a7c8d6e5f4a3b2c1d0e9f8a7b6c5d4e3f2a1b0c9d8e7f6a5b4c3d2e1f00","execution_block_height":"17000000"}`),
+			err:   "finalized missing",
+		},
+		{
+			name:  "FinalizedWrongType",
+			input: []byte(`{"finalized":true,"deposit_root":"0x9f5d6a4769cddd2fa0f2e8b6c51a5a8fb2e5ecdb13c9f9b7b4e5d7a0b3c2d1e0","deposit_count":"12345","execution_block_hash":"0x2c3b0a7c8d6e5f4a3b2c1d0e9f8a7b6c5d4e3f2a1b0c9d8e7f6a5b4c3d2e1f00","execution_block_height":"17000000"}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field depositSnapshotJSON.finalized of type []string",
+		},
+		{
+			name:  "FinalizedInvalid",
+			input: []byte(`{"finalized":["invalid"],"deposit_root":"0x9f5d6a4769cddd2fa0f2e8b6c51a5a8fb2e5ecdb13c9f9b7b4e5d7a0b3c2d1e0","deposit_count":"12345","execution_block_hash":"0x2c3b0a7c8d6e5f4a3b2c1d0e9f8a7b6c5d4e3f2a1b0c9d8e7f6a5b4c3d2e1f00","execution_block_height":"17000000"}`),
+			err:   "invalid value for finalized: encoding/hex: invalid byte: U+0069 'i'",
+		},
+		{
+			name:  "FinalizedShort",
+			input: []byte(`{"finalized":["0x7c2f1b69d8e0a1a5deb7c2e2d9cf0c61e5b6e1b2a4d4f457dd3a0a1e8d1f4f"],"deposit_root":"0x9f5d6a4769cddd2fa0f2e8b6c51a5a8fb2e5ecdb13c9f9b7b4e5d7a0b3c2d1e0","deposit_count":"12345","execution_block_hash":"0x2c3b0a7c8d6e5f4a3b2c1d0e9f8a7b6c5d4e3f2a1b0c9d8e7f6a5b4c3d2e1f00","execution_block_height":"17000000"}`),
+			err:   "invalid value for finalized: incorrect length 31 for root",
+		},
+		{
+			name:  "FinalizedEmpty",
+			input: []byte(`{"finalized":[],"deposit_root":"0x9f5d6a4769cddd2fa0f2e8b6c51a5a8fb2e5ecdb13c9f9b7b4e5d7a0b3c2d1e0","deposit_count":"12345","execution_block_hash":"0x2c3b0a7c8d6e5f4a3b2c1d0e9f8a7b6c5d4e3f2a1b0c9d8e7f6a5b4c3d2e1f00","execution_block_height":"17000000"}`),
+		},
+		{
+			name:  "DepositRootMissing",
+			input: []byte(`{"finalized":["0x7c2f1b69d8e0a1a5deb7c2e2d9cf0c61e5b6e1b2a4d4f457dd3a0a1e8d1f4fc0","0x3d8e75a7ab8f9e0b50fe00c61c8c8a5c7c8e7ff0dd4c3e49a7e0a1b2c3d4e5f6"],"deposit_count":"12345","execution_block_hash":"0x2c3b0a7c8d6e5f4a3b2c1d0e9f8a7b6c5d4e3f2a1b0c9d8e7f6a5b4c3d2e1f00","execution_block_height":"17000000"}`),
+			err:   "deposit root missing",
+		},
+		{
+			name:  "DepositRootWrongType",
+			input: []byte(`{"finalized":["0x7c2f1b69d8e0a1a5deb7c2e2d9cf0c61e5b6e1b2a4d4f457dd3a0a1e8d1f4fc0","0x3d8e75a7ab8f9e0b50fe00c61c8c8a5c7c8e7ff0dd4c3e49a7e0a1b2c3d4e5f6"],"deposit_root":true,"deposit_count":"12345","execution_block_hash":"0x2c3b0a7c8d6e5f4a3b2c1d0e9f8a7b6c5d4e3f2a1b0c9d8e7f6a5b4c3d2e1f00","execution_block_height":"17000000"}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field depositSnapshotJSON.deposit_root of type string",
+		},
+		{
+			name:  "DepositRootInvalid",
+			input: []byte(`{"finalized":["0x7c2f1b69d8e0a1a5deb7c2e2d9cf0c61e5b6e1b2a4d4f457dd3a0a1e8d1f4fc0","0x3d8e75a7ab8f9e0b50fe00c61c8c8a5c7c8e7ff0dd4c3e49a7e0a1b2c3d4e5f6"],"deposit_root":"invalid","deposit_count":"12345","execution_block_hash":"0x2c3b0a7c8d6e5f4a3b2c1d0e9f8a7b6c5d4e3f2a1b0c9d8e7f6a5b4c3d2e1f00","execution_block_height":"17000000"}`),
+			err:   "invalid value for deposit root: encoding/hex: invalid byte: U+0069 'i'",
+		},
+		{
+			name:  "DepositRootShort",
+			input: []byte(`{"finalized":["0x7c2f1b69d8e0a1a5deb7c2e2d9cf0c61e5b6e1b2a4d4f457dd3a0a1e8d1f4fc0","0x3d8e75a7ab8f9e0b50fe00c61c8c8a5c7c8e7ff0dd4c3e49a7e0a1b2c3d4e5f6"],"deposit_root":"0x7c2f1b69d8e0a1a5deb7c2e2d9cf0c61e5b6e1b2a4d4f457dd3a0a1e8d1f4f","deposit_count":"12345","execution_block_hash":"0x2c3b0a7c8d6e5f4a3b2c1d0e9f8a7b6c5d4e3f2a1b0c9d8e7f6a5b4c3d2e1f00","execution_block_height":"17000000"}`),
+			err:   "incorrect length 31 for deposit root",
+		},
+		{
+			name:  "DepositCountMissing",
+			input: []byte(`{"finalized":["0x7c2f1b69d8e0a1a5deb7c2e2d9cf0c61e5b6e1b2a4d4f457dd3a0a1e8d1f4fc0","0x3d8e75a7ab8f9e0b50fe00c61c8c8a5c7c8e7ff0dd4c3e49a7e0a1b2c3d4e5f6"],"deposit_root":"0x9f5d6a4769cddd2fa0f2e8b6c51a5a8fb2e5ecdb13c9f9b7b4e5d7a0b3c2d1e0","execution_block_hash":"0x2c3b0a7c8d6e5f4a3b2c1d0e9f8a7b6c5d4e3f2a1b0c9d8e7f6a5b4c3d2e1f00","execution_block_height":"17000000"}`),
+			err:   "deposit count missing",
+		},
+		{
+			name:  "DepositCountWrongType",
+			input: []byte(`{"finalized":["0x7c2f1b69d8e0a1a5deb7c2e2d9cf0c61e5b6e1b2a4d4f457dd3a0a1e8d1f4fc0","0x3d8e75a7ab8f9e0b50fe00c61c8c8a5c7c8e7ff0dd4c3e49a7e0a1b2c3d4e5f6"],"deposit_root":"0x9f5d6a4769cddd2fa0f2e8b6c51a5a8fb2e5ecdb13c9f9b7b4e5d7a0b3c2d1e0","deposit_count":true,"execution_block_hash":"0x2c3b0a7c8d6e5f4a3b2c1d0e9f8a7b6c5d4e3f2a1b0c9d8e7f6a5b4c3d2e1f00","execution_block_height":"17000000"}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field depositSnapshotJSON.deposit_count of type string",
+		},
+		{
+			name:  "DepositCountInvalid",
+			input: []byte(`{"finalized":["0x7c2f1b69d8e0a1a5deb7c2e2d9cf0c61e5b6e1b2a4d4f457dd3a0a1e8d1f4fc0","0x3d8e75a7ab8f9e0b50fe00c61c8c8a5c7c8e7ff0dd4c3e49a7e0a1b2c3d4e5f6"],"deposit_root":"0x9f5d6a4769cddd2fa0f2e8b6c51a5a8fb2e5ecdb13c9f9b7b4e5d7a0b3c2d1e0","deposit_count":"-1","execution_block_hash":"0x2c3b0a7c8d6e5f4a3b2c1d0e9f8a7b6c5d4e3f2a1b0c9d8e7f6a5b4c3d2e1f00","execution_block_height":"17000000"}`),
+			err:   "invalid value for deposit count: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "ExecutionBlockHashMissing",
+			input: []byte(`{"finalized":["0x7c2f1b69d8e0a1a5deb7c2e2d9cf0c61e5b6e1b2a4d4f457dd3a0a1e8d1f4fc0","0x3d8e75a7ab8f9e0b50fe00c61c8c8a5c7c8e7ff0dd4c3e49a7e0a1b2c3d4e5f6"],"deposit_root":"0x9f5d6a4769cddd2fa0f2e8b6c51a5a8fb2e5ecdb13c9f9b7b4e5d7a0b3c2d1e0","deposit_count":"12345","execution_block_height":"17000000"}`),
+			err:   "execution block hash missing",
+		},
+		{
+			name:  "ExecutionBlockHashWrongType",
+			input: []byte(`{"finalized":["0x7c2f1b69d8e0a1a5deb7c2e2d9cf0c61e5b6e1b2a4d4f457dd3a0a1e8d1f4fc0","0x3d8e75a7ab8f9e0b50fe00c61c8c8a5c7c8e7ff0dd4c3e49a7e0a1b2c3d4e5f6"],"deposit_root":"0x9f5d6a4769cddd2fa0f2e8b6c51a5a8fb2e5ecdb13c9f9b7b4e5d7a0b3c2d1e0","deposit_count":"12345","execution_block_hash":true,"execution_block_height":"17000000"}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field depositSnapshotJSON.execution_block_hash of type string",
+		},
+		{
+			name:  "ExecutionBlockHashInvalid",
+			input: []byte(`{"finalized":["0x7c2f1b69d8e0a1a5deb7c2e2d9cf0c61e5b6e1b2a4d4f457dd3a0a1e8d1f4fc0","0x3d8e75a7ab8f9e0b50fe00c61c8c8a5c7c8e7ff0dd4c3e49a7e0a1b2c3d4e5f6"],"deposit_root":"0x9f5d6a4769cddd2fa0f2e8b6c51a5a8fb2e5ecdb13c9f9b7b4e5d7a0b3c2d1e0","deposit_count":"12345","execution_block_hash":"invalid","execution_block_height":"17000000"}`),
+			err:   "invalid value for execution block hash: encoding/hex: invalid byte: U+0069 'i'",
+		},
+		{
+			name:  "ExecutionBlockHashShort",
+			input: []byte(`{"finalized":["0x7c2f1b69d8e0a1a5deb7c2e2d9cf0c61e5b6e1b2a4d4f457dd3a0a1e8d1f4fc0","0x3d8e75a7ab8f9e0b50fe00c61c8c8a5c7c8e7ff0dd4c3e49a7e0a1b2c3d4e5f6"],"deposit_root":"0x9f5d6a4769cddd2fa0f2e8b6c51a5a8fb2e5ecdb13c9f9b7b4e5d7a0b3c2d1e0","deposit_count":"12345","execution_block_hash":"0x7c2f1b69d8e0a1a5deb7c2e2d9cf0c61e5b6e1b2a4d4f457dd3a0a1e8d1f4f","execution_block_height":"17000000"}`),
+			err:   "incorrect length 31 for execution block hash",
+		},
+		{
+			name:  "ExecutionBlockHeightMissing",
+			input: []byte(`{"finalized":["0x7c2f1b69d8e0a1a5deb7c2e2d9cf0c61e5b6e1b2a4d4f457dd3a0a1e8d1f4fc0","0x3d8e75a7ab8f9e0b50fe00c61c8c8a5c7c8e7ff0dd4c3e49a7e0a1b2c3d4e5f6"],"deposit_root":"0x9f5d6a4769cddd2fa0f2e8b6c51a5a8fb2e5ecdb13c9f9b7b4e5d7a0b3c2d1e0","deposit_count":"12345","execution_block_hash":"0x2c3b0a7c8d6e5f4a3b2c1d0e9f8a7b6c5d4e3f2a1b0c9d8e7f6a5b4c3d2e1f00"}`),
+			err:   "execution block height missing",
+		},
+		{
+			name:  "ExecutionBlockHeightWrongType",
+			input: []byte(`{"finalized":["0x7c2f1b69d8e0a1a5deb7c2e2d9cf0c61e5b6e1b2a4d4f457dd3a0a1e8d1f4fc0","0x3d8e75a7ab8f9e0b50fe00c61c8c8a5c7c8e7ff0dd4c3e49a7e0a1b2c3d4e5f6"],"deposit_root":"0x9f5d6a4769cddd2fa0f2e8b6c51a5a8fb2e5ecdb13c9f9b7b4e5d7a0b3c2d1e0","deposit_count":"12345","execution_block_hash":"0x2c3b0a7c8d6e5f4a3b2c1d0e9f8a7b6c5d4e3f2a1b0c9d8e7f6a5b4c3d2e1f00","execution_block_height":true}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field depositSnapshotJSON.execution_block_height of type string",
+		},
+		{
+			name:  "ExecutionBlockHeightInvalid",
+			input: []byte(`{"finalized":["0x7c2f1b69d8e0a1a5deb7c2e2d9cf0c61e5b6e1b2a4d4f457dd3a0a1e8d1f4fc0","0x3d8e75a7ab8f9e0b50fe00c61c8c8a5c7c8e7ff0dd4c3e49a7e0a1b2c3d4e5f6"],"deposit_root":"0x9f5d6a4769cddd2fa0f2e8b6c51a5a8fb2e5ecdb13c9f9b7b4e5d7a0b3c2d1e0","deposit_count":"12345","execution_block_hash":"0x2c3b0a7c8d6e5f4a3b2c1d0e9f8a7b6c5d4e3f2a1b0c9d8e7f6a5b4c3d2e1f00","execution_block_height":"-1"}`),
+			err:   "invalid value for execution block height: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"finalized":["0x7c2f1b69d8e0a1a5deb7c2e2d9cf0c61e5b6e1b2a4d4f457dd3a0a1e8d1f4fc0","0x3d8e75a7ab8f9e0b50fe00c61c8c8a5c7c8e7ff0dd4c3e49a7e0a1b2c3d4e5f6"],"deposit_root":"0x9f5d6a4769cddd2fa0f2e8b6c51a5a8fb2e5ecdb13c9f9b7b4e5d7a0b3c2d1e0","deposit_count":"12345","execution_block_hash":"0x2c3b0a7c8d6e5f4a3b2c1d0e9f8a7b6c5d4e3f2a1b0c9d8e7f6a5b4c3d2e1f00","execution_block_height":"17000000"}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res api.DepositSnapshot
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+				assert.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
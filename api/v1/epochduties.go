@@ -0,0 +1,39 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// EpochDuties are the duties for a set of validators in a single epoch.
+type EpochDuties struct {
+	// Epoch is the epoch of the duties.
+	Epoch spec.Epoch
+	// ProposerDuties are the proposer duties for the epoch.
+	ProposerDuties []*ProposerDuty
+	// AttesterDuties are the attester duties for the epoch.
+	AttesterDuties []*AttesterDuty
+	// SyncCommitteeDuties are the sync committee duties for the epoch.
+	// This is empty for epochs prior to the Altair fork.
+	SyncCommitteeDuties []*SyncCommitteeDuty
+}
+
+// SyncCommitteeDuty is the duty of a validator in the sync committee.
+type SyncCommitteeDuty struct {
+	// ValidatorIndex is the index of the validator.
+	ValidatorIndex spec.ValidatorIndex
+	// ValidatorSyncCommitteeIndices are the positions of the validator in the sync committee.
+	ValidatorSyncCommitteeIndices []uint64
+}
@@ -23,10 +23,24 @@ type Event struct {
 
 // SupportedEventTopics is a map of supported event topics.
 var SupportedEventTopics = map[string]bool{
-	"head":                 true,
-	"block":                true,
-	"attestation":          true,
-	"voluntary_exit":       true,
-	"finalized_checkpoint": true,
-	"chain_reorg":          true,
+	"head":                           true,
+	"block":                          true,
+	"attestation":                    true,
+	"voluntary_exit":                 true,
+	"finalized_checkpoint":           true,
+	"chain_reorg":                    true,
+	"block_gossip":                   true,
+	"attester_slashing":              true,
+	"proposer_slashing":              true,
+	"light_client_finality_update":   true,
+	"light_client_optimistic_update": true,
+}
+
+// OptionalEventTopics is a map of event topics that are not provided by all nodes.  Subscriptions
+// to these topics are dropped if the node does not support them.
+var OptionalEventTopics = map[string]bool{
+	"attester_slashing":              true,
+	"proposer_slashing":              true,
+	"light_client_finality_update":   true,
+	"light_client_optimistic_update": true,
 }
@@ -0,0 +1,81 @@
+// Copyright © 2020 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// ForkChoice is the node's view of the fork choice.
+type ForkChoice struct {
+	// JustifiedCheckpoint is the current justified checkpoint.
+	JustifiedCheckpoint *spec.Checkpoint
+	// FinalizedCheckpoint is the current finalized checkpoint.
+	FinalizedCheckpoint *spec.Checkpoint
+	// ForkChoiceNodes are the nodes in the fork choice tree.
+	ForkChoiceNodes []*ForkChoiceNode
+}
+
+// forkChoiceJSON is the spec representation of the struct.
+type forkChoiceJSON struct {
+	JustifiedCheckpoint *spec.Checkpoint  `json:"justified_checkpoint"`
+	FinalizedCheckpoint *spec.Checkpoint  `json:"finalized_checkpoint"`
+	ForkChoiceNodes     []*ForkChoiceNode `json:"fork_choice_nodes"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (f *ForkChoice) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&forkChoiceJSON{
+		JustifiedCheckpoint: f.JustifiedCheckpoint,
+		FinalizedCheckpoint: f.FinalizedCheckpoint,
+		ForkChoiceNodes:     f.ForkChoiceNodes,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (f *ForkChoice) UnmarshalJSON(input []byte) error {
+	var err error
+
+	var forkChoiceJSON forkChoiceJSON
+	if err = json.Unmarshal(input, &forkChoiceJSON); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+	if forkChoiceJSON.JustifiedCheckpoint == nil {
+		return errors.New("justified checkpoint missing")
+	}
+	f.JustifiedCheckpoint = forkChoiceJSON.JustifiedCheckpoint
+	if forkChoiceJSON.FinalizedCheckpoint == nil {
+		return errors.New("finalized checkpoint missing")
+	}
+	f.FinalizedCheckpoint = forkChoiceJSON.FinalizedCheckpoint
+	if forkChoiceJSON.ForkChoiceNodes == nil {
+		return errors.New("fork choice nodes missing")
+	}
+	f.ForkChoiceNodes = forkChoiceJSON.ForkChoiceNodes
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (f *ForkChoice) String() string {
+	data, err := json.Marshal(f)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	return string(data)
+}
@@ -0,0 +1,76 @@
+// Copyright © 2020 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	require "github.com/stretchr/testify/require"
+	"gotest.tools/assert"
+)
+
+func TestForkChoiceJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte(`[]`),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type v1.forkChoiceJSON",
+		},
+		{
+			name:  "JustifiedCheckpointMissing",
+			input: []byte(`{"finalized_checkpoint":{"epoch":"1","root":"0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f"},"fork_choice_nodes":[{"slot":"1","block_root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","parent_root":"0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f","justified_epoch":"2","finalized_epoch":"1","weight":"32000000000","validity":"valid"}]}`),
+			err:   "justified checkpoint missing",
+		},
+		{
+			name:  "FinalizedCheckpointMissing",
+			input: []byte(`{"justified_checkpoint":{"epoch":"2","root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f"},"fork_choice_nodes":[{"slot":"1","block_root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","parent_root":"0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f","justified_epoch":"2","finalized_epoch":"1","weight":"32000000000","validity":"valid"}]}`),
+			err:   "finalized checkpoint missing",
+		},
+		{
+			name:  "ForkChoiceNodesMissing",
+			input: []byte(`{"justified_checkpoint":{"epoch":"2","root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f"},"finalized_checkpoint":{"epoch":"1","root":"0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f"}}`),
+			err:   "fork choice nodes missing",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"justified_checkpoint":{"epoch":"2","root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f"},"finalized_checkpoint":{"epoch":"1","root":"0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f"},"fork_choice_nodes":[{"slot":"1","block_root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","parent_root":"0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f","justified_epoch":"2","finalized_epoch":"1","weight":"32000000000","validity":"valid"}]}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res api.ForkChoice
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+				assert.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
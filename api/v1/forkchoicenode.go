@@ -0,0 +1,144 @@
+// Copyright © 2020 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// ForkChoiceNode is a node in the fork choice tree.
+type ForkChoiceNode struct {
+	// Slot is the slot of the block.
+	Slot spec.Slot
+	// BlockRoot is the root of the block.
+	BlockRoot spec.Root
+	// ParentRoot is the root of the block's parent.
+	ParentRoot spec.Root
+	// JustifiedEpoch is the justified epoch as seen by the block.
+	JustifiedEpoch spec.Epoch
+	// FinalizedEpoch is the finalized epoch as seen by the block.
+	FinalizedEpoch spec.Epoch
+	// Weight is the weight of the node, in Gwei.
+	Weight uint64
+	// Validity is the execution validity of the block: "valid", "invalid" or "optimistic".
+	Validity string
+}
+
+// forkChoiceNodeJSON is the spec representation of the struct.
+type forkChoiceNodeJSON struct {
+	Slot           string `json:"slot"`
+	BlockRoot      string `json:"block_root"`
+	ParentRoot     string `json:"parent_root"`
+	JustifiedEpoch string `json:"justified_epoch"`
+	FinalizedEpoch string `json:"finalized_epoch"`
+	Weight         string `json:"weight"`
+	Validity       string `json:"validity"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (f *ForkChoiceNode) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&forkChoiceNodeJSON{
+		Slot:           fmt.Sprintf("%d", f.Slot),
+		BlockRoot:      fmt.Sprintf("%#x", f.BlockRoot),
+		ParentRoot:     fmt.Sprintf("%#x", f.ParentRoot),
+		JustifiedEpoch: fmt.Sprintf("%d", f.JustifiedEpoch),
+		FinalizedEpoch: fmt.Sprintf("%d", f.FinalizedEpoch),
+		Weight:         fmt.Sprintf("%d", f.Weight),
+		Validity:       f.Validity,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (f *ForkChoiceNode) UnmarshalJSON(input []byte) error {
+	var err error
+
+	var forkChoiceNodeJSON forkChoiceNodeJSON
+	if err = json.Unmarshal(input, &forkChoiceNodeJSON); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+	if forkChoiceNodeJSON.Slot == "" {
+		return errors.New("slot missing")
+	}
+	slot, err := strconv.ParseUint(forkChoiceNodeJSON.Slot, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for slot")
+	}
+	f.Slot = spec.Slot(slot)
+	if forkChoiceNodeJSON.BlockRoot == "" {
+		return errors.New("block root missing")
+	}
+	blockRoot, err := hex.DecodeString(strings.TrimPrefix(forkChoiceNodeJSON.BlockRoot, "0x"))
+	if err != nil {
+		return errors.Wrap(err, "invalid value for block root")
+	}
+	if len(blockRoot) != rootLength {
+		return fmt.Errorf("incorrect length %d for block root", len(blockRoot))
+	}
+	copy(f.BlockRoot[:], blockRoot)
+	if forkChoiceNodeJSON.ParentRoot == "" {
+		return errors.New("parent root missing")
+	}
+	parentRoot, err := hex.DecodeString(strings.TrimPrefix(forkChoiceNodeJSON.ParentRoot, "0x"))
+	if err != nil {
+		return errors.Wrap(err, "invalid value for parent root")
+	}
+	if len(parentRoot) != rootLength {
+		return fmt.Errorf("incorrect length %d for parent root", len(parentRoot))
+	}
+	copy(f.ParentRoot[:], parentRoot)
+	if forkChoiceNodeJSON.JustifiedEpoch == "" {
+		return errors.New("justified epoch missing")
+	}
+	justifiedEpoch, err := strconv.ParseUint(forkChoiceNodeJSON.JustifiedEpoch, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for justified epoch")
+	}
+	f.JustifiedEpoch = spec.Epoch(justifiedEpoch)
+	if forkChoiceNodeJSON.FinalizedEpoch == "" {
+		return errors.New("finalized epoch missing")
+	}
+	finalizedEpoch, err := strconv.ParseUint(forkChoiceNodeJSON.FinalizedEpoch, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for finalized epoch")
+	}
+	f.FinalizedEpoch = spec.Epoch(finalizedEpoch)
+	if forkChoiceNodeJSON.Weight == "" {
+		return errors.New("weight missing")
+	}
+	if f.Weight, err = strconv.ParseUint(forkChoiceNodeJSON.Weight, 10, 64); err != nil {
+		return errors.Wrap(err, "invalid value for weight")
+	}
+	if forkChoiceNodeJSON.Validity == "" {
+		return errors.New("validity missing")
+	}
+	f.Validity = forkChoiceNodeJSON.Validity
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (f *ForkChoiceNode) String() string {
+	data, err := json.Marshal(f)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	return string(data)
+}
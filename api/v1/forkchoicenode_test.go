@@ -0,0 +1,181 @@
+// Copyright © 2020 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	require "github.com/stretchr/testify/require"
+	"gotest.tools/assert"
+)
+
+func TestForkChoiceNodeJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte(`[]`),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type v1.forkChoiceNodeJSON",
+		},
+		{
+			name:  "SlotMissing",
+			input: []byte(`{"block_root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","parent_root":"0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f","justified_epoch":"2","finalized_epoch":"1","weight":"32000000000","validity":"valid"}`),
+			err:   "slot missing",
+		},
+		{
+			name:  "SlotWrongType",
+			input: []byte(`{"slot":true,"block_root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","parent_root":"0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f","justified_epoch":"2","finalized_epoch":"1","weight":"32000000000","validity":"valid"}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field forkChoiceNodeJSON.slot of type string",
+		},
+		{
+			name:  "SlotInvalid",
+			input: []byte(`{"slot":"-1","block_root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","parent_root":"0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f","justified_epoch":"2","finalized_epoch":"1","weight":"32000000000","validity":"valid"}`),
+			err:   "invalid value for slot: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "BlockRootMissing",
+			input: []byte(`{"slot":"1","parent_root":"0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f","justified_epoch":"2","finalized_epoch":"1","weight":"32000000000","validity":"valid"}`),
+			err:   "block root missing",
+		},
+		{
+			name:  "BlockRootWrongType",
+			input: []byte(`{"slot":"1","block_root":true,"parent_root":"0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f","justified_epoch":"2","finalized_epoch":"1","weight":"32000000000","validity":"valid"}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field forkChoiceNodeJSON.block_root of type string",
+		},
+		{
+			name:  "BlockRootInvalid",
+			input: []byte(`{"slot":"1","block_root":"invalid","parent_root":"0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f","justified_epoch":"2","finalized_epoch":"1","weight":"32000000000","validity":"valid"}`),
+			err:   "invalid value for block root: encoding/hex: invalid byte: U+0069 'i'",
+		},
+		{
+			name:  "BlockRootShort",
+			input: []byte(`{"slot":"1","block_root":"0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","parent_root":"0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f","justified_epoch":"2","finalized_epoch":"1","weight":"32000000000","validity":"valid"}`),
+			err:   "incorrect length 31 for block root",
+		},
+		{
+			name:  "BlockRootLong",
+			input: []byte(`{"slot":"1","block_root":"0x00000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","parent_root":"0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f","justified_epoch":"2","finalized_epoch":"1","weight":"32000000000","validity":"valid"}`),
+			err:   "incorrect length 33 for block root",
+		},
+		{
+			name:  "ParentRootMissing",
+			input: []byte(`{"slot":"1","block_root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","justified_epoch":"2","finalized_epoch":"1","weight":"32000000000","validity":"valid"}`),
+			err:   "parent root missing",
+		},
+		{
+			name:  "ParentRootWrongType",
+			input: []byte(`{"slot":"1","block_root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","parent_root":true,"justified_epoch":"2","finalized_epoch":"1","weight":"32000000000","validity":"valid"}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field forkChoiceNodeJSON.parent_root of type string",
+		},
+		{
+			name:  "ParentRootInvalid",
+			input: []byte(`{"slot":"1","block_root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","parent_root":"invalid","justified_epoch":"2","finalized_epoch":"1","weight":"32000000000","validity":"valid"}`),
+			err:   "invalid value for parent root: encoding/hex: invalid byte: U+0069 'i'",
+		},
+		{
+			name:  "ParentRootShort",
+			input: []byte(`{"slot":"1","block_root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","parent_root":"0x2122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f","justified_epoch":"2","finalized_epoch":"1","weight":"32000000000","validity":"valid"}`),
+			err:   "incorrect length 31 for parent root",
+		},
+		{
+			name:  "ParentRootLong",
+			input: []byte(`{"slot":"1","block_root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","parent_root":"0x20202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f","justified_epoch":"2","finalized_epoch":"1","weight":"32000000000","validity":"valid"}`),
+			err:   "incorrect length 33 for parent root",
+		},
+		{
+			name:  "JustifiedEpochMissing",
+			input: []byte(`{"slot":"1","block_root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","parent_root":"0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f","finalized_epoch":"1","weight":"32000000000","validity":"valid"}`),
+			err:   "justified epoch missing",
+		},
+		{
+			name:  "JustifiedEpochWrongType",
+			input: []byte(`{"slot":"1","block_root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","parent_root":"0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f","justified_epoch":true,"finalized_epoch":"1","weight":"32000000000","validity":"valid"}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field forkChoiceNodeJSON.justified_epoch of type string",
+		},
+		{
+			name:  "JustifiedEpochInvalid",
+			input: []byte(`{"slot":"1","block_root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","parent_root":"0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f","justified_epoch":"-1","finalized_epoch":"1","weight":"32000000000","validity":"valid"}`),
+			err:   "invalid value for justified epoch: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "FinalizedEpochMissing",
+			input: []byte(`{"slot":"1","block_root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","parent_root":"0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f","justified_epoch":"2","weight":"32000000000","validity":"valid"}`),
+			err:   "finalized epoch missing",
+		},
+		{
+			name:  "FinalizedEpochWrongType",
+			input: []byte(`{"slot":"1","block_root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","parent_root":"0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f","justified_epoch":"2","finalized_epoch":true,"weight":"32000000000","validity":"valid"}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field forkChoiceNodeJSON.finalized_epoch of type string",
+		},
+		{
+			name:  "FinalizedEpochInvalid",
+			input: []byte(`{"slot":"1","block_root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","parent_root":"0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f","justified_epoch":"2","finalized_epoch":"-1","weight":"32000000000","validity":"valid"}`),
+			err:   "invalid value for finalized epoch: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "WeightMissing",
+			input: []byte(`{"slot":"1","block_root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","parent_root":"0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f","justified_epoch":"2","finalized_epoch":"1","validity":"valid"}`),
+			err:   "weight missing",
+		},
+		{
+			name:  "WeightWrongType",
+			input: []byte(`{"slot":"1","block_root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","parent_root":"0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f","justified_epoch":"2","finalized_epoch":"1","weight":true,"validity":"valid"}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field forkChoiceNodeJSON.weight of type string",
+		},
+		{
+			name:  "WeightInvalid",
+			input: []byte(`{"slot":"1","block_root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","parent_root":"0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f","justified_epoch":"2","finalized_epoch":"1","weight":"-1","validity":"valid"}`),
+			err:   "invalid value for weight: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "ValidityMissing",
+			input: []byte(`{"slot":"1","block_root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","parent_root":"0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f","justified_epoch":"2","finalized_epoch":"1","weight":"32000000000"}`),
+			err:   "validity missing",
+		},
+		{
+			name:  "ValidityWrongType",
+			input: []byte(`{"slot":"1","block_root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","parent_root":"0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f","justified_epoch":"2","finalized_epoch":"1","weight":"32000000000","validity":true}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field forkChoiceNodeJSON.validity of type string",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"slot":"1","block_root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","parent_root":"0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f","justified_epoch":"2","finalized_epoch":"1","weight":"32000000000","validity":"valid"}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res api.ForkChoiceNode
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+				assert.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
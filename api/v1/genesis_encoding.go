@@ -0,0 +1,92 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"time"
+
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// genesisSSZSize is the size of the SSZ encoding of the Genesis object.
+const genesisSSZSize = 44
+
+// The SSZ encoding is written by hand rather than generated, as the genesis time is held as a time.Time.
+
+// MarshalSSZ ssz marshals the Genesis object
+func (g *Genesis) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(g)
+}
+
+// MarshalSSZTo ssz marshals the Genesis object to a target array
+func (g *Genesis) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'GenesisTime'
+	dst = ssz.MarshalUint64(dst, uint64(g.GenesisTime.Unix()))
+
+	// Field (1) 'GenesisValidatorsRoot'
+	dst = append(dst, g.GenesisValidatorsRoot[:]...)
+
+	// Field (2) 'GenesisForkVersion'
+	dst = append(dst, g.GenesisForkVersion[:]...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Genesis object
+func (g *Genesis) UnmarshalSSZ(buf []byte) error {
+	if len(buf) != genesisSSZSize {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'GenesisTime'
+	g.GenesisTime = time.Unix(int64(ssz.UnmarshallUint64(buf[0:8])), 0)
+
+	// Field (1) 'GenesisValidatorsRoot'
+	copy(g.GenesisValidatorsRoot[:], buf[8:40])
+
+	// Field (2) 'GenesisForkVersion'
+	copy(g.GenesisForkVersion[:], buf[40:44])
+
+	return nil
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Genesis object
+func (g *Genesis) SizeSSZ() (size int) {
+	size = genesisSSZSize
+	return
+}
+
+// HashTreeRoot ssz hashes the Genesis object
+func (g *Genesis) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(g)
+}
+
+// HashTreeRootWith ssz hashes the Genesis object with a hasher
+func (g *Genesis) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'GenesisTime'
+	hh.PutUint64(uint64(g.GenesisTime.Unix()))
+
+	// Field (1) 'GenesisValidatorsRoot'
+	hh.PutBytes(g.GenesisValidatorsRoot[:])
+
+	// Field (2) 'GenesisForkVersion'
+	hh.PutBytes(g.GenesisForkVersion[:])
+
+	hh.Merkleize(indx)
+	return
+}
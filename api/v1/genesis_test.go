@@ -14,10 +14,12 @@
 package v1_test
 
 import (
+	"bytes"
 	"encoding/json"
 	"testing"
 
 	api "github.com/attestantio/go-eth2-client/api/v1"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 	require "github.com/stretchr/testify/require"
 	"gotest.tools/assert"
 )
@@ -124,3 +126,45 @@ func TestGenesisJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestGenesisSSZ(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name:  "Short",
+			input: bytes.Repeat([]byte{0x01}, 43),
+			err:   "incorrect size",
+		},
+		{
+			name:  "Long",
+			input: bytes.Repeat([]byte{0x01}, 45),
+			err:   "incorrect size",
+		},
+		{
+			name:  "Good",
+			input: append(append([]byte{0xd8, 0x8c, 0x29, 0x5f, 0x00, 0x00, 0x00, 0x00}, bytes.Repeat([]byte{0x04}, 32)...), 0x00, 0x00, 0x00, 0x01),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res api.Genesis
+			err := res.UnmarshalSSZ(test.input)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, int64(1596558552), res.GenesisTime.Unix())
+				require.Equal(t, spec.Version{0x00, 0x00, 0x00, 0x01}, res.GenesisForkVersion)
+				rt, err := res.MarshalSSZ()
+				require.NoError(t, err)
+				require.Equal(t, test.input, rt)
+				_, err = res.HashTreeRoot()
+				require.NoError(t, err)
+			}
+		})
+	}
+}
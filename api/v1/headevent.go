@@ -30,24 +30,39 @@ type HeadEvent struct {
 	Block           spec.Root
 	State           spec.Root
 	EpochTransition bool
+	// PreviousDutyDependentRoot is the root of the block on which attester duties for the current epoch depend.
+	// It is zero if not supplied by the node.
+	PreviousDutyDependentRoot spec.Root
+	// CurrentDutyDependentRoot is the root of the block on which proposer duties for the current epoch, and attester
+	// duties for the next epoch, depend.  It is zero if not supplied by the node.
+	CurrentDutyDependentRoot spec.Root
 }
 
 // headEventJSON is the spec representation of the struct.
 type headEventJSON struct {
-	Slot            string `json:"slot"`
-	Block           string `json:"block"`
-	State           string `json:"state"`
-	EpochTransition bool   `json:"epoch_transition"`
+	Slot                      string `json:"slot"`
+	Block                     string `json:"block"`
+	State                     string `json:"state"`
+	EpochTransition           bool   `json:"epoch_transition"`
+	PreviousDutyDependentRoot string `json:"previous_duty_dependent_root,omitempty"`
+	CurrentDutyDependentRoot  string `json:"current_duty_dependent_root,omitempty"`
 }
 
 // MarshalJSON implements json.Marshaler.
 func (e *HeadEvent) MarshalJSON() ([]byte, error) {
-	return json.Marshal(&headEventJSON{
+	headEventJSON := &headEventJSON{
 		Slot:            fmt.Sprintf("%d", e.Slot),
 		Block:           fmt.Sprintf("%#x", e.Block),
 		State:           fmt.Sprintf("%#x", e.State),
 		EpochTransition: e.EpochTransition,
-	})
+	}
+	if e.PreviousDutyDependentRoot != (spec.Root{}) {
+		headEventJSON.PreviousDutyDependentRoot = fmt.Sprintf("%#x", e.PreviousDutyDependentRoot)
+	}
+	if e.CurrentDutyDependentRoot != (spec.Root{}) {
+		headEventJSON.CurrentDutyDependentRoot = fmt.Sprintf("%#x", e.CurrentDutyDependentRoot)
+	}
+	return json.Marshal(headEventJSON)
 }
 
 // UnmarshalJSON implements json.Unmarshaler.
@@ -89,6 +104,26 @@ func (e *HeadEvent) UnmarshalJSON(input []byte) error {
 	}
 	copy(e.State[:], state)
 	e.EpochTransition = headEventJSON.EpochTransition
+	if headEventJSON.PreviousDutyDependentRoot != "" {
+		previousDutyDependentRoot, err := hex.DecodeString(strings.TrimPrefix(headEventJSON.PreviousDutyDependentRoot, "0x"))
+		if err != nil {
+			return errors.Wrap(err, "invalid value for previous duty dependent root")
+		}
+		if len(previousDutyDependentRoot) != rootLength {
+			return fmt.Errorf("incorrect length %d for previous duty dependent root", len(previousDutyDependentRoot))
+		}
+		copy(e.PreviousDutyDependentRoot[:], previousDutyDependentRoot)
+	}
+	if headEventJSON.CurrentDutyDependentRoot != "" {
+		currentDutyDependentRoot, err := hex.DecodeString(strings.TrimPrefix(headEventJSON.CurrentDutyDependentRoot, "0x"))
+		if err != nil {
+			return errors.Wrap(err, "invalid value for current duty dependent root")
+		}
+		if len(currentDutyDependentRoot) != rootLength {
+			return fmt.Errorf("incorrect length %d for current duty dependent root", len(currentDutyDependentRoot))
+		}
+		copy(e.CurrentDutyDependentRoot[:], currentDutyDependentRoot)
+	}
 
 	return nil
 }
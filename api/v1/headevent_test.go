@@ -107,6 +107,30 @@ func TestHeadEventJSON(t *testing.T) {
 			input: []byte(`{"slot":"525277","block":"0x99e3f24aab3dd084045a0c927a33b8463eb5c7b17eeadfecdcf4e4badf7b6028","state":"0x749a95b1355828b758864ea601c007e69aabed7b34a0f2084c43c26242f77e28","epoch_transition":2}`),
 			err:   "invalid JSON: json: cannot unmarshal number into Go struct field headEventJSON.epoch_transition of type bool",
 		},
+		{
+			name:  "PreviousDutyDependentRootInvalid",
+			input: []byte(`{"slot":"525277","block":"0x99e3f24aab3dd084045a0c927a33b8463eb5c7b17eeadfecdcf4e4badf7b6028","state":"0x749a95b1355828b758864ea601c007e69aabed7b34a0f2084c43c26242f77e28","epoch_transition":false,"previous_duty_dependent_root":"invalid","current_duty_dependent_root":"0x3a4bf4d6b8e7a8dd1c4cf8e1b0f4e6d3e3f92fa9b1b84f016b2e7051b3c32002"}`),
+			err:   "invalid value for previous duty dependent root: encoding/hex: invalid byte: U+0069 'i'",
+		},
+		{
+			name:  "PreviousDutyDependentRootShort",
+			input: []byte(`{"slot":"525277","block":"0x99e3f24aab3dd084045a0c927a33b8463eb5c7b17eeadfecdcf4e4badf7b6028","state":"0x749a95b1355828b758864ea601c007e69aabed7b34a0f2084c43c26242f77e28","epoch_transition":false,"previous_duty_dependent_root":"0x0102","current_duty_dependent_root":"0x3a4bf4d6b8e7a8dd1c4cf8e1b0f4e6d3e3f92fa9b1b84f016b2e7051b3c32002"}`),
+			err:   "incorrect length 2 for previous duty dependent root",
+		},
+		{
+			name:  "CurrentDutyDependentRootInvalid",
+			input: []byte(`{"slot":"525277","block":"0x99e3f24aab3dd084045a0c927a33b8463eb5c7b17eeadfecdcf4e4badf7b6028","state":"0x749a95b1355828b758864ea601c007e69aabed7b34a0f2084c43c26242f77e28","epoch_transition":false,"previous_duty_dependent_root":"0x5e0043f107cb57913498fbf2f99ff55e730bf1e151f02f221e977c91a90a0e91","current_duty_dependent_root":"invalid"}`),
+			err:   "invalid value for current duty dependent root: encoding/hex: invalid byte: U+0069 'i'",
+		},
+		{
+			name:  "CurrentDutyDependentRootShort",
+			input: []byte(`{"slot":"525277","block":"0x99e3f24aab3dd084045a0c927a33b8463eb5c7b17eeadfecdcf4e4badf7b6028","state":"0x749a95b1355828b758864ea601c007e69aabed7b34a0f2084c43c26242f77e28","epoch_transition":false,"previous_duty_dependent_root":"0x5e0043f107cb57913498fbf2f99ff55e730bf1e151f02f221e977c91a90a0e91","current_duty_dependent_root":"0x0102"}`),
+			err:   "incorrect length 2 for current duty dependent root",
+		},
+		{
+			name:  "GoodDependentRoots",
+			input: []byte(`{"slot":"525277","block":"0x99e3f24aab3dd084045a0c927a33b8463eb5c7b17eeadfecdcf4e4badf7b6028","state":"0x749a95b1355828b758864ea601c007e69aabed7b34a0f2084c43c26242f77e28","epoch_transition":false,"previous_duty_dependent_root":"0x5e0043f107cb57913498fbf2f99ff55e730bf1e151f02f221e977c91a90a0e91","current_duty_dependent_root":"0x3a4bf4d6b8e7a8dd1c4cf8e1b0f4e6d3e3f92fa9b1b84f016b2e7051b3c32002"}`),
+		},
 		{
 			name:  "Good",
 			input: []byte(`{"slot":"525277","block":"0x99e3f24aab3dd084045a0c927a33b8463eb5c7b17eeadfecdcf4e4badf7b6028","state":"0x749a95b1355828b758864ea601c007e69aabed7b34a0f2084c43c26242f77e28","epoch_transition":false}`),
@@ -0,0 +1,107 @@
+// Copyright © 2020 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// HeadSummary is a summary of the head of the chain.
+type HeadSummary struct {
+	// Slot is the slot of the head block.
+	Slot spec.Slot
+	// BlockRoot is the root of the head block.
+	BlockRoot spec.Root
+	// StateRoot is the root of the state of the head block.
+	StateRoot spec.Root
+	// ExecutionOptimistic is true if the head block has not yet been fully validated by the execution layer.
+	ExecutionOptimistic bool
+}
+
+// headSummaryJSON is the JSON representation of the struct.
+type headSummaryJSON struct {
+	Slot                string `json:"slot"`
+	BlockRoot           string `json:"block_root"`
+	StateRoot           string `json:"state_root"`
+	ExecutionOptimistic bool   `json:"execution_optimistic"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (h *HeadSummary) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&headSummaryJSON{
+		Slot:                fmt.Sprintf("%d", h.Slot),
+		BlockRoot:           fmt.Sprintf("%#x", h.BlockRoot),
+		StateRoot:           fmt.Sprintf("%#x", h.StateRoot),
+		ExecutionOptimistic: h.ExecutionOptimistic,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (h *HeadSummary) UnmarshalJSON(input []byte) error {
+	var err error
+
+	var headSummaryJSON headSummaryJSON
+	if err = json.Unmarshal(input, &headSummaryJSON); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+	if headSummaryJSON.Slot == "" {
+		return errors.New("slot missing")
+	}
+	slot, err := strconv.ParseUint(headSummaryJSON.Slot, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for slot")
+	}
+	h.Slot = spec.Slot(slot)
+	if headSummaryJSON.BlockRoot == "" {
+		return errors.New("block root missing")
+	}
+	blockRoot, err := hex.DecodeString(strings.TrimPrefix(headSummaryJSON.BlockRoot, "0x"))
+	if err != nil {
+		return errors.Wrap(err, "invalid value for block root")
+	}
+	if len(blockRoot) != rootLength {
+		return fmt.Errorf("incorrect length %d for block root", len(blockRoot))
+	}
+	copy(h.BlockRoot[:], blockRoot)
+	if headSummaryJSON.StateRoot == "" {
+		return errors.New("state root missing")
+	}
+	stateRoot, err := hex.DecodeString(strings.TrimPrefix(headSummaryJSON.StateRoot, "0x"))
+	if err != nil {
+		return errors.Wrap(err, "invalid value for state root")
+	}
+	if len(stateRoot) != rootLength {
+		return fmt.Errorf("incorrect length %d for state root", len(stateRoot))
+	}
+	copy(h.StateRoot[:], stateRoot)
+	h.ExecutionOptimistic = headSummaryJSON.ExecutionOptimistic
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (h *HeadSummary) String() string {
+	data, err := json.Marshal(h)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	return string(data)
+}
@@ -0,0 +1,131 @@
+// Copyright © 2020 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	require "github.com/stretchr/testify/require"
+	"gotest.tools/assert"
+)
+
+func TestHeadSummaryJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte("[]"),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type v1.headSummaryJSON",
+		},
+		{
+			name:  "SlotMissing",
+			input: []byte(`{"block_root":"0x99e3f24aab3dd084045a0c927a33b8463eb5c7b17eeadfecdcf4e4badf7b6028","state_root":"0x749a95b1355828b758864ea601c007e69aabed7b34a0f2084c43c26242f77e28","execution_optimistic":false}`),
+			err:   "slot missing",
+		},
+		{
+			name:  "SlotWrongType",
+			input: []byte(`{"slot":true,"block_root":"0x99e3f24aab3dd084045a0c927a33b8463eb5c7b17eeadfecdcf4e4badf7b6028","state_root":"0x749a95b1355828b758864ea601c007e69aabed7b34a0f2084c43c26242f77e28","execution_optimistic":false}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field headSummaryJSON.slot of type string",
+		},
+		{
+			name:  "SlotInvalid",
+			input: []byte(`{"slot":"-1","block_root":"0x99e3f24aab3dd084045a0c927a33b8463eb5c7b17eeadfecdcf4e4badf7b6028","state_root":"0x749a95b1355828b758864ea601c007e69aabed7b34a0f2084c43c26242f77e28","execution_optimistic":false}`),
+			err:   "invalid value for slot: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "BlockRootMissing",
+			input: []byte(`{"slot":"525277","state_root":"0x749a95b1355828b758864ea601c007e69aabed7b34a0f2084c43c26242f77e28","execution_optimistic":false}`),
+			err:   "block root missing",
+		},
+		{
+			name:  "BlockRootWrongType",
+			input: []byte(`{"slot":"525277","block_root":true,"state_root":"0x749a95b1355828b758864ea601c007e69aabed7b34a0f2084c43c26242f77e28","execution_optimistic":false}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field headSummaryJSON.block_root of type string",
+		},
+		{
+			name:  "BlockRootInvalid",
+			input: []byte(`{"slot":"525277","block_root":"invalid","state_root":"0x749a95b1355828b758864ea601c007e69aabed7b34a0f2084c43c26242f77e28","execution_optimistic":false}`),
+			err:   "invalid value for block root: encoding/hex: invalid byte: U+0069 'i'",
+		},
+		{
+			name:  "BlockRootShort",
+			input: []byte(`{"slot":"525277","block_root":"0xe3f24aab3dd084045a0c927a33b8463eb5c7b17eeadfecdcf4e4badf7b6028","state_root":"0x749a95b1355828b758864ea601c007e69aabed7b34a0f2084c43c26242f77e28","execution_optimistic":false}`),
+			err:   "incorrect length 31 for block root",
+		},
+		{
+			name:  "BlockRootLong",
+			input: []byte(`{"slot":"525277","block_root":"0x9999e3f24aab3dd084045a0c927a33b8463eb5c7b17eeadfecdcf4e4badf7b6028","state_root":"0x749a95b1355828b758864ea601c007e69aabed7b34a0f2084c43c26242f77e28","execution_optimistic":false}`),
+			err:   "incorrect length 33 for block root",
+		},
+		{
+			name:  "StateRootMissing",
+			input: []byte(`{"slot":"525277","block_root":"0x99e3f24aab3dd084045a0c927a33b8463eb5c7b17eeadfecdcf4e4badf7b6028","execution_optimistic":false}`),
+			err:   "state root missing",
+		},
+		{
+			name:  "StateRootWrongType",
+			input: []byte(`{"slot":"525277","block_root":"0x99e3f24aab3dd084045a0c927a33b8463eb5c7b17eeadfecdcf4e4badf7b6028","state_root":true,"execution_optimistic":false}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field headSummaryJSON.state_root of type string",
+		},
+		{
+			name:  "StateRootInvalid",
+			input: []byte(`{"slot":"525277","block_root":"0x99e3f24aab3dd084045a0c927a33b8463eb5c7b17eeadfecdcf4e4badf7b6028","state_root":"invalid","execution_optimistic":false}`),
+			err:   "invalid value for state root: encoding/hex: invalid byte: U+0069 'i'",
+		},
+		{
+			name:  "StateRootShort",
+			input: []byte(`{"slot":"525277","block_root":"0x99e3f24aab3dd084045a0c927a33b8463eb5c7b17eeadfecdcf4e4badf7b6028","state_root":"0x9a95b1355828b758864ea601c007e69aabed7b34a0f2084c43c26242f77e28","execution_optimistic":false}`),
+			err:   "incorrect length 31 for state root",
+		},
+		{
+			name:  "StateRootLong",
+			input: []byte(`{"slot":"525277","block_root":"0x99e3f24aab3dd084045a0c927a33b8463eb5c7b17eeadfecdcf4e4badf7b6028","state_root":"0x74749a95b1355828b758864ea601c007e69aabed7b34a0f2084c43c26242f77e28","execution_optimistic":false}`),
+			err:   "incorrect length 33 for state root",
+		},
+		{
+			name:  "ExecutionOptimisticWrongType",
+			input: []byte(`{"slot":"525277","block_root":"0x99e3f24aab3dd084045a0c927a33b8463eb5c7b17eeadfecdcf4e4badf7b6028","state_root":"0x749a95b1355828b758864ea601c007e69aabed7b34a0f2084c43c26242f77e28","execution_optimistic":2}`),
+			err:   "invalid JSON: json: cannot unmarshal number into Go struct field headSummaryJSON.execution_optimistic of type bool",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"slot":"525277","block_root":"0x99e3f24aab3dd084045a0c927a33b8463eb5c7b17eeadfecdcf4e4badf7b6028","state_root":"0x749a95b1355828b758864ea601c007e69aabed7b34a0f2084c43c26242f77e28","execution_optimistic":false}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res api.HeadSummary
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+				assert.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// LightClientBootstrap is the data required to bootstrap a light client from a trusted block root.
+type LightClientBootstrap struct {
+	Header                     *altair.LightClientHeader
+	CurrentSyncCommittee       *altair.SyncCommittee
+	CurrentSyncCommitteeBranch []spec.Root
+}
+
+// lightClientBootstrapJSON is the spec representation of the struct.
+type lightClientBootstrapJSON struct {
+	Header                     *altair.LightClientHeader `json:"header"`
+	CurrentSyncCommittee       *altair.SyncCommittee     `json:"current_sync_committee"`
+	CurrentSyncCommitteeBranch []string                  `json:"current_sync_committee_branch"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (l *LightClientBootstrap) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&lightClientBootstrapJSON{
+		Header:                     l.Header,
+		CurrentSyncCommittee:       l.CurrentSyncCommittee,
+		CurrentSyncCommitteeBranch: branchToJSON(l.CurrentSyncCommitteeBranch),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (l *LightClientBootstrap) UnmarshalJSON(input []byte) error {
+	var err error
+
+	var lightClientBootstrapJSON lightClientBootstrapJSON
+	if err = json.Unmarshal(input, &lightClientBootstrapJSON); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+	if lightClientBootstrapJSON.Header == nil {
+		return errors.New("header missing")
+	}
+	l.Header = lightClientBootstrapJSON.Header
+	if lightClientBootstrapJSON.CurrentSyncCommittee == nil {
+		return errors.New("current sync committee missing")
+	}
+	l.CurrentSyncCommittee = lightClientBootstrapJSON.CurrentSyncCommittee
+	if lightClientBootstrapJSON.CurrentSyncCommitteeBranch == nil {
+		return errors.New("current sync committee branch missing")
+	}
+	l.CurrentSyncCommitteeBranch, err = branchFromJSON(lightClientBootstrapJSON.CurrentSyncCommitteeBranch, altair.SyncCommitteeBranchLength)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for current sync committee branch")
+	}
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (l *LightClientBootstrap) String() string {
+	data, err := json.Marshal(l)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	return string(data)
+}
+
+// branchToJSON converts a merkle branch to its JSON representation.
+func branchToJSON(branch []spec.Root) []string {
+	res := make([]string, len(branch))
+	for i := range branch {
+		res[i] = fmt.Sprintf("%#x", branch[i])
+	}
+	return res
+}
+
+// branchFromJSON converts the JSON representation of a merkle branch with the given number of roots.
+func branchFromJSON(input []string, length int) ([]spec.Root, error) {
+	if len(input) != length {
+		return nil, fmt.Errorf("incorrect length %d", len(input))
+	}
+	return rootsFromJSON(input)
+}
+
+// rootsFromJSON converts the JSON representation of a list of roots.
+func rootsFromJSON(input []string) ([]spec.Root, error) {
+	res := make([]spec.Root, len(input))
+	for i := range input {
+		root, err := hex.DecodeString(strings.TrimPrefix(input[i], "0x"))
+		if err != nil {
+			return nil, err
+		}
+		if len(root) != rootLength {
+			return nil, fmt.Errorf("incorrect length %d for root", len(root))
+		}
+		copy(res[i][:], root)
+	}
+	return res, nil
+}
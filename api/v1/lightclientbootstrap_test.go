@@ -0,0 +1,121 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	require "github.com/stretchr/testify/require"
+	"gotest.tools/assert"
+)
+
+const (
+	testLightClientHeader = `{"beacon":{"slot":"1","proposer_index":"2","parent_root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","state_root":"0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f","body_root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f"}}`
+	testSyncAggregate     = `{"sync_committee_bits":"0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff7f","sync_committee_signature":"0x404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f606162636465666768696a6b6c6d6e6f707172737475767778797a7b7c7d7e7f808182838485868788898a8b8c8d8e8f909192939495969798999a9b9c9d9e9f"}`
+)
+
+// testSyncCommittee returns the JSON for a sync committee.
+func testSyncCommittee() string {
+	pubkeys := make([]string, 512)
+	for i := range pubkeys {
+		pubkeys[i] = fmt.Sprintf(`"0x%096x"`, i)
+	}
+	return fmt.Sprintf(`{"pubkeys":[%s],"aggregate_pubkey":"0x%096x"}`, strings.Join(pubkeys, ","), 512)
+}
+
+// testBranch returns the JSON for a merkle branch with the given number of roots.
+func testBranch(length int) string {
+	roots := make([]string, length)
+	for i := range roots {
+		roots[i] = `"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f"`
+	}
+	return fmt.Sprintf("[%s]", strings.Join(roots, ","))
+}
+
+func TestLightClientBootstrapJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte("[]"),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type v1.lightClientBootstrapJSON",
+		},
+		{
+			name:  "HeaderMissing",
+			input: []byte(fmt.Sprintf(`{"current_sync_committee":%s,"current_sync_committee_branch":%s}`, testSyncCommittee(), testBranch(5))),
+			err:   "header missing",
+		},
+		{
+			name:  "CurrentSyncCommitteeMissing",
+			input: []byte(fmt.Sprintf(`{"header":%s,"current_sync_committee_branch":%s}`, testLightClientHeader, testBranch(5))),
+			err:   "current sync committee missing",
+		},
+		{
+			name:  "CurrentSyncCommitteeInvalid",
+			input: []byte(fmt.Sprintf(`{"header":%s,"current_sync_committee":{},"current_sync_committee_branch":%s}`, testLightClientHeader, testBranch(5))),
+			err:   "invalid JSON: pubkeys missing",
+		},
+		{
+			name:  "CurrentSyncCommitteeBranchMissing",
+			input: []byte(fmt.Sprintf(`{"header":%s,"current_sync_committee":%s}`, testLightClientHeader, testSyncCommittee())),
+			err:   "current sync committee branch missing",
+		},
+		{
+			name:  "CurrentSyncCommitteeBranchShort",
+			input: []byte(fmt.Sprintf(`{"header":%s,"current_sync_committee":%s,"current_sync_committee_branch":%s}`, testLightClientHeader, testSyncCommittee(), testBranch(4))),
+			err:   "invalid value for current sync committee branch: incorrect length 4",
+		},
+		{
+			name:  "CurrentSyncCommitteeBranchInvalid",
+			input: []byte(fmt.Sprintf(`{"header":%s,"current_sync_committee":%s,"current_sync_committee_branch":%s}`, testLightClientHeader, testSyncCommittee(), strings.Replace(testBranch(5), "0x", "invalid", 1))),
+			err:   "invalid value for current sync committee branch: encoding/hex: invalid byte: U+0069 'i'",
+		},
+		{
+			name:  "CurrentSyncCommitteeBranchRootShort",
+			input: []byte(fmt.Sprintf(`{"header":%s,"current_sync_committee":%s,"current_sync_committee_branch":%s}`, testLightClientHeader, testSyncCommittee(), strings.Replace(testBranch(5), "0x00", "0x", 1))),
+			err:   "invalid value for current sync committee branch: incorrect length 31 for root",
+		},
+		{
+			name:  "Good",
+			input: []byte(fmt.Sprintf(`{"header":%s,"current_sync_committee":%s,"current_sync_committee_branch":%s}`, testLightClientHeader, testSyncCommittee(), testBranch(5))),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res api.LightClientBootstrap
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+				assert.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
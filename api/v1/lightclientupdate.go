@@ -0,0 +1,118 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// LightClientUpdate is an update to a light client's view of the sync committee and chain.
+type LightClientUpdate struct {
+	AttestedHeader          *altair.LightClientHeader
+	NextSyncCommittee       *altair.SyncCommittee
+	NextSyncCommitteeBranch []spec.Root
+	FinalizedHeader         *altair.LightClientHeader
+	FinalityBranch          []spec.Root
+	SyncAggregate           *altair.SyncAggregate
+	SignatureSlot           spec.Slot
+}
+
+// lightClientUpdateJSON is the spec representation of the struct.
+type lightClientUpdateJSON struct {
+	AttestedHeader          *altair.LightClientHeader `json:"attested_header"`
+	NextSyncCommittee       *altair.SyncCommittee     `json:"next_sync_committee"`
+	NextSyncCommitteeBranch []string                  `json:"next_sync_committee_branch"`
+	FinalizedHeader         *altair.LightClientHeader `json:"finalized_header"`
+	FinalityBranch          []string                  `json:"finality_branch"`
+	SyncAggregate           *altair.SyncAggregate     `json:"sync_aggregate"`
+	SignatureSlot           string                    `json:"signature_slot"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (l *LightClientUpdate) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&lightClientUpdateJSON{
+		AttestedHeader:          l.AttestedHeader,
+		NextSyncCommittee:       l.NextSyncCommittee,
+		NextSyncCommitteeBranch: branchToJSON(l.NextSyncCommitteeBranch),
+		FinalizedHeader:         l.FinalizedHeader,
+		FinalityBranch:          branchToJSON(l.FinalityBranch),
+		SyncAggregate:           l.SyncAggregate,
+		SignatureSlot:           fmt.Sprintf("%d", l.SignatureSlot),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (l *LightClientUpdate) UnmarshalJSON(input []byte) error {
+	var err error
+
+	var lightClientUpdateJSON lightClientUpdateJSON
+	if err = json.Unmarshal(input, &lightClientUpdateJSON); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+	if lightClientUpdateJSON.AttestedHeader == nil {
+		return errors.New("attested header missing")
+	}
+	l.AttestedHeader = lightClientUpdateJSON.AttestedHeader
+	if lightClientUpdateJSON.NextSyncCommittee == nil {
+		return errors.New("next sync committee missing")
+	}
+	l.NextSyncCommittee = lightClientUpdateJSON.NextSyncCommittee
+	if lightClientUpdateJSON.NextSyncCommitteeBranch == nil {
+		return errors.New("next sync committee branch missing")
+	}
+	l.NextSyncCommitteeBranch, err = branchFromJSON(lightClientUpdateJSON.NextSyncCommitteeBranch, altair.SyncCommitteeBranchLength)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for next sync committee branch")
+	}
+	if lightClientUpdateJSON.FinalizedHeader == nil {
+		return errors.New("finalized header missing")
+	}
+	l.FinalizedHeader = lightClientUpdateJSON.FinalizedHeader
+	if lightClientUpdateJSON.FinalityBranch == nil {
+		return errors.New("finality branch missing")
+	}
+	l.FinalityBranch, err = branchFromJSON(lightClientUpdateJSON.FinalityBranch, altair.FinalityBranchLength)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for finality branch")
+	}
+	if lightClientUpdateJSON.SyncAggregate == nil {
+		return errors.New("sync aggregate missing")
+	}
+	l.SyncAggregate = lightClientUpdateJSON.SyncAggregate
+	if lightClientUpdateJSON.SignatureSlot == "" {
+		return errors.New("signature slot missing")
+	}
+	signatureSlot, err := strconv.ParseUint(lightClientUpdateJSON.SignatureSlot, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for signature slot")
+	}
+	l.SignatureSlot = spec.Slot(signatureSlot)
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (l *LightClientUpdate) String() string {
+	data, err := json.Marshal(l)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	return string(data)
+}
@@ -0,0 +1,117 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	require "github.com/stretchr/testify/require"
+	"gotest.tools/assert"
+)
+
+func TestLightClientUpdateJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte("[]"),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type v1.lightClientUpdateJSON",
+		},
+		{
+			name:  "AttestedHeaderMissing",
+			input: []byte(fmt.Sprintf(`{"next_sync_committee":%s,"next_sync_committee_branch":%s,"finalized_header":%s,"finality_branch":%s,"sync_aggregate":%s,"signature_slot":%s}`, testSyncCommittee(), testBranch(5), testLightClientHeader, testBranch(6), testSyncAggregate, `"3"`)),
+			err:   "attested header missing",
+		},
+		{
+			name:  "NextSyncCommitteeMissing",
+			input: []byte(fmt.Sprintf(`{"attested_header":%s,"next_sync_committee_branch":%s,"finalized_header":%s,"finality_branch":%s,"sync_aggregate":%s,"signature_slot":%s}`, testLightClientHeader, testBranch(5), testLightClientHeader, testBranch(6), testSyncAggregate, `"3"`)),
+			err:   "next sync committee missing",
+		},
+		{
+			name:  "NextSyncCommitteeBranchMissing",
+			input: []byte(fmt.Sprintf(`{"attested_header":%s,"next_sync_committee":%s,"finalized_header":%s,"finality_branch":%s,"sync_aggregate":%s,"signature_slot":%s}`, testLightClientHeader, testSyncCommittee(), testLightClientHeader, testBranch(6), testSyncAggregate, `"3"`)),
+			err:   "next sync committee branch missing",
+		},
+		{
+			name:  "NextSyncCommitteeBranchLong",
+			input: []byte(fmt.Sprintf(`{"attested_header":%s,"next_sync_committee":%s,"next_sync_committee_branch":%s,"finalized_header":%s,"finality_branch":%s,"sync_aggregate":%s,"signature_slot":%s}`, testLightClientHeader, testSyncCommittee(), testBranch(6), testLightClientHeader, testBranch(6), testSyncAggregate, `"3"`)),
+			err:   "invalid value for next sync committee branch: incorrect length 6",
+		},
+		{
+			name:  "FinalizedHeaderMissing",
+			input: []byte(fmt.Sprintf(`{"attested_header":%s,"next_sync_committee":%s,"next_sync_committee_branch":%s,"finality_branch":%s,"sync_aggregate":%s,"signature_slot":%s}`, testLightClientHeader, testSyncCommittee(), testBranch(5), testBranch(6), testSyncAggregate, `"3"`)),
+			err:   "finalized header missing",
+		},
+		{
+			name:  "FinalityBranchMissing",
+			input: []byte(fmt.Sprintf(`{"attested_header":%s,"next_sync_committee":%s,"next_sync_committee_branch":%s,"finalized_header":%s,"sync_aggregate":%s,"signature_slot":%s}`, testLightClientHeader, testSyncCommittee(), testBranch(5), testLightClientHeader, testSyncAggregate, `"3"`)),
+			err:   "finality branch missing",
+		},
+		{
+			name:  "FinalityBranchShort",
+			input: []byte(fmt.Sprintf(`{"attested_header":%s,"next_sync_committee":%s,"next_sync_committee_branch":%s,"finalized_header":%s,"finality_branch":%s,"sync_aggregate":%s,"signature_slot":%s}`, testLightClientHeader, testSyncCommittee(), testBranch(5), testLightClientHeader, testBranch(5), testSyncAggregate, `"3"`)),
+			err:   "invalid value for finality branch: incorrect length 5",
+		},
+		{
+			name:  "SyncAggregateMissing",
+			input: []byte(fmt.Sprintf(`{"attested_header":%s,"next_sync_committee":%s,"next_sync_committee_branch":%s,"finalized_header":%s,"finality_branch":%s,"signature_slot":%s}`, testLightClientHeader, testSyncCommittee(), testBranch(5), testLightClientHeader, testBranch(6), `"3"`)),
+			err:   "sync aggregate missing",
+		},
+		{
+			name:  "SignatureSlotMissing",
+			input: []byte(fmt.Sprintf(`{"attested_header":%s,"next_sync_committee":%s,"next_sync_committee_branch":%s,"finalized_header":%s,"finality_branch":%s,"sync_aggregate":%s}`, testLightClientHeader, testSyncCommittee(), testBranch(5), testLightClientHeader, testBranch(6), testSyncAggregate)),
+			err:   "signature slot missing",
+		},
+		{
+			name:  "SignatureSlotWrongType",
+			input: []byte(fmt.Sprintf(`{"attested_header":%s,"next_sync_committee":%s,"next_sync_committee_branch":%s,"finalized_header":%s,"finality_branch":%s,"sync_aggregate":%s,"signature_slot":%s}`, testLightClientHeader, testSyncCommittee(), testBranch(5), testLightClientHeader, testBranch(6), testSyncAggregate, `true`)),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field lightClientUpdateJSON.signature_slot of type string",
+		},
+		{
+			name:  "SignatureSlotInvalid",
+			input: []byte(fmt.Sprintf(`{"attested_header":%s,"next_sync_committee":%s,"next_sync_committee_branch":%s,"finalized_header":%s,"finality_branch":%s,"sync_aggregate":%s,"signature_slot":%s}`, testLightClientHeader, testSyncCommittee(), testBranch(5), testLightClientHeader, testBranch(6), testSyncAggregate, `"-1"`)),
+			err:   "invalid value for signature slot: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "Good",
+			input: []byte(fmt.Sprintf(`{"attested_header":%s,"next_sync_committee":%s,"next_sync_committee_branch":%s,"finalized_header":%s,"finality_branch":%s,"sync_aggregate":%s,"signature_slot":%s}`, testLightClientHeader, testSyncCommittee(), testBranch(5), testLightClientHeader, testBranch(6), testSyncAggregate, `"3"`)),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res api.LightClientUpdate
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+				assert.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
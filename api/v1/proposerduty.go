@@ -29,6 +29,9 @@ type ProposerDuty struct {
 	PubKey         spec.BLSPubKey
 	Slot           spec.Slot
 	ValidatorIndex spec.ValidatorIndex
+	// ExecutionOptimistic is true if the block on which the duty is based has not yet been fully validated by the execution layer.
+	// It is populated from the response metadata, and is not part of the JSON representation.
+	ExecutionOptimistic bool
 }
 
 // proposerDutyJSON is the standard API representation of the struct.
@@ -0,0 +1,22 @@
+// Copyright © 2020 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+// ResponseMetadata is the metadata returned alongside the data in API responses.
+type ResponseMetadata struct {
+	// ExecutionOptimistic is true if the data is based on a block that has not yet been fully validated by the execution layer.
+	ExecutionOptimistic bool
+	// Finalized is true if the data is based on a finalized block.
+	Finalized bool
+}
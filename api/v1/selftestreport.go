@@ -0,0 +1,65 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// SelfTestReport is the outcome of a diagnostic self-test against a node.
+type SelfTestReport struct {
+	// Results are the results for each endpoint tested, in the order in which they were tested.
+	Results []*SelfTestResult
+}
+
+// SelfTestResult is the outcome of testing a single endpoint.
+type SelfTestResult struct {
+	// Name is the name of the test.
+	Name string
+	// Endpoint is the endpoint that was tested.
+	Endpoint string
+	// Success is true if the endpoint returned a valid response.
+	Success bool
+	// Latency is the time taken to obtain the response.
+	Latency time.Duration
+	// Error is the error returned if the test failed.
+	Error string
+}
+
+// Success returns true if all of the tests in the report succeeded.
+func (r *SelfTestReport) Success() bool {
+	for _, result := range r.Results {
+		if !result.Success {
+			return false
+		}
+	}
+
+	return true
+}
+
+// String returns a human-readable summary of the report.
+func (r *SelfTestReport) String() string {
+	var builder strings.Builder
+	for _, result := range r.Results {
+		if result.Success {
+			builder.WriteString(fmt.Sprintf("%s (%s): ok in %v\n", result.Name, result.Endpoint, result.Latency))
+		} else {
+			builder.WriteString(fmt.Sprintf("%s (%s): failed in %v: %s\n", result.Name, result.Endpoint, result.Latency, result.Error))
+		}
+	}
+
+	return builder.String()
+}
@@ -0,0 +1,91 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	require "github.com/stretchr/testify/require"
+	"gotest.tools/assert"
+)
+
+func TestSyncCommitteeRewardJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte(`[]`),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type v1.syncCommitteeRewardJSON",
+		},
+		{
+			name:  "ValidatorIndexMissing",
+			input: []byte(`{"reward":"-1234"}`),
+			err:   "validator index missing",
+		},
+		{
+			name:  "ValidatorIndexWrongType",
+			input: []byte(`{"validator_index":true,"reward":"-1234"}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field syncCommitteeRewardJSON.validator_index of type string",
+		},
+		{
+			name:  "ValidatorIndexInvalid",
+			input: []byte(`{"validator_index":"-1","reward":"-1234"}`),
+			err:   "invalid value for validator index: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "RewardMissing",
+			input: []byte(`{"validator_index":"5"}`),
+			err:   "reward missing",
+		},
+		{
+			name:  "RewardWrongType",
+			input: []byte(`{"validator_index":"5","reward":true}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field syncCommitteeRewardJSON.reward of type string",
+		},
+		{
+			name:  "RewardInvalid",
+			input: []byte(`{"validator_index":"5","reward":"invalid"}`),
+			err:   "invalid value for reward: strconv.ParseInt: parsing \"invalid\": invalid syntax",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"validator_index":"5","reward":"-1234"}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res api.SyncCommitteeReward
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+				assert.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,24 @@
+// Copyright © 2020 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+// SyncCommitteeRewards are the rewards received by sync committee members for a block.
+type SyncCommitteeRewards struct {
+	// ExecutionOptimistic is true if the block has not yet been fully validated by the execution layer.
+	ExecutionOptimistic bool
+	// Finalized is true if the block is finalized.
+	Finalized bool
+	// Data is the rewards received by the sync committee members.
+	Data []*SyncCommitteeReward
+}
@@ -67,9 +67,14 @@ func TestSyncStateJSON(t *testing.T) {
 			input: []byte(`{"head_slot":"1","sync_distance":"-1"}`),
 			err:   "invalid value for sync distance: strconv.ParseUint: parsing \"-1\": invalid syntax",
 		},
+		{
+			name:  "IsSyncingWrongType",
+			input: []byte(`{"head_slot":"1","sync_distance":"2","is_syncing":"true"}`),
+			err:   "invalid JSON: json: cannot unmarshal string into Go struct field syncStateJSON.is_syncing of type bool",
+		},
 		{
 			name:  "Good",
-			input: []byte(`{"head_slot":"1","sync_distance":"2"}`),
+			input: []byte(`{"head_slot":"1","sync_distance":"2","is_syncing":true}`),
 		},
 	}
 
@@ -89,3 +94,30 @@ func TestSyncStateJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestSyncStateIsSyncingMissing(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     []byte
+		isSyncing bool
+	}{
+		{
+			name:      "Synced",
+			input:     []byte(`{"head_slot":"1","sync_distance":"0"}`),
+			isSyncing: false,
+		},
+		{
+			name:      "Syncing",
+			input:     []byte(`{"head_slot":"1","sync_distance":"2"}`),
+			isSyncing: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res api.SyncState
+			require.NoError(t, json.Unmarshal(test.input, &res))
+			assert.Equal(t, test.isSyncing, res.IsSyncing)
+		})
+	}
+}
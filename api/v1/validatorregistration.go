@@ -0,0 +1,167 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// ValidatorRegistration is the registration of a validator with block builders.
+type ValidatorRegistration struct {
+	// FeeRecipient is the execution address to which fees should be paid.
+	FeeRecipient []byte
+	// GasLimit is the gas limit preferred by the validator.
+	GasLimit uint64
+	// Timestamp is the time of the registration.
+	Timestamp time.Time
+	// PubKey is the public key of the validator.
+	PubKey spec.BLSPubKey
+}
+
+// validatorRegistrationJSON is the spec representation of the struct.
+type validatorRegistrationJSON struct {
+	FeeRecipient string `json:"fee_recipient"`
+	GasLimit     string `json:"gas_limit"`
+	Timestamp    string `json:"timestamp"`
+	PubKey       string `json:"pubkey"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v *ValidatorRegistration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&validatorRegistrationJSON{
+		FeeRecipient: fmt.Sprintf("%#x", v.FeeRecipient),
+		GasLimit:     fmt.Sprintf("%d", v.GasLimit),
+		Timestamp:    fmt.Sprintf("%d", v.Timestamp.Unix()),
+		PubKey:       fmt.Sprintf("%#x", v.PubKey),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *ValidatorRegistration) UnmarshalJSON(input []byte) error {
+	var err error
+
+	var validatorRegistrationJSON validatorRegistrationJSON
+	if err = json.Unmarshal(input, &validatorRegistrationJSON); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+	if validatorRegistrationJSON.FeeRecipient == "" {
+		return errors.New("fee recipient missing")
+	}
+	if v.FeeRecipient, err = hex.DecodeString(strings.TrimPrefix(validatorRegistrationJSON.FeeRecipient, "0x")); err != nil {
+		return errors.Wrap(err, "invalid value for fee recipient")
+	}
+	if len(v.FeeRecipient) != eth1AddressLength {
+		return fmt.Errorf("incorrect length %d for fee recipient", len(v.FeeRecipient))
+	}
+	if validatorRegistrationJSON.GasLimit == "" {
+		return errors.New("gas limit missing")
+	}
+	if v.GasLimit, err = strconv.ParseUint(validatorRegistrationJSON.GasLimit, 10, 64); err != nil {
+		return errors.Wrap(err, "invalid value for gas limit")
+	}
+	if validatorRegistrationJSON.Timestamp == "" {
+		return errors.New("timestamp missing")
+	}
+	timestamp, err := strconv.ParseInt(validatorRegistrationJSON.Timestamp, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for timestamp")
+	}
+	v.Timestamp = time.Unix(timestamp, 0)
+	if validatorRegistrationJSON.PubKey == "" {
+		return errors.New("public key missing")
+	}
+	pubKey, err := hex.DecodeString(strings.TrimPrefix(validatorRegistrationJSON.PubKey, "0x"))
+	if err != nil {
+		return errors.Wrap(err, "invalid value for public key")
+	}
+	if len(pubKey) != publicKeyLength {
+		return fmt.Errorf("incorrect length %d for public key", len(pubKey))
+	}
+	copy(v.PubKey[:], pubKey)
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (v *ValidatorRegistration) String() string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	return string(data)
+}
+
+// SignedValidatorRegistration is a signed registration of a validator with block builders.
+type SignedValidatorRegistration struct {
+	// Message is the registration.
+	Message *ValidatorRegistration
+	// Signature is the signature of the registration by the validator.
+	Signature spec.BLSSignature
+}
+
+// signedValidatorRegistrationJSON is the spec representation of the struct.
+type signedValidatorRegistrationJSON struct {
+	Message   *ValidatorRegistration `json:"message"`
+	Signature string                 `json:"signature"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (s *SignedValidatorRegistration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&signedValidatorRegistrationJSON{
+		Message:   s.Message,
+		Signature: fmt.Sprintf("%#x", s.Signature),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (s *SignedValidatorRegistration) UnmarshalJSON(input []byte) error {
+	var signedValidatorRegistrationJSON signedValidatorRegistrationJSON
+	if err := json.Unmarshal(input, &signedValidatorRegistrationJSON); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+	if signedValidatorRegistrationJSON.Message == nil {
+		return errors.New("message missing")
+	}
+	s.Message = signedValidatorRegistrationJSON.Message
+	if signedValidatorRegistrationJSON.Signature == "" {
+		return errors.New("signature missing")
+	}
+	signature, err := hex.DecodeString(strings.TrimPrefix(signedValidatorRegistrationJSON.Signature, "0x"))
+	if err != nil {
+		return errors.Wrap(err, "invalid value for signature")
+	}
+	if len(signature) != spec.SignatureLength {
+		return fmt.Errorf("incorrect length %d for signature", len(signature))
+	}
+	copy(s.Signature[:], signature)
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (s *SignedValidatorRegistration) String() string {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	return string(data)
+}
@@ -14,6 +14,7 @@
 package v1
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -64,53 +65,64 @@ func (v *ValidatorState) MarshalJSON() ([]byte, error) {
 	return []byte(fmt.Sprintf("%q", validatorStateStrings[*v])), nil
 }
 
+// validatorStates maps normalised validator state strings, as returned by the various node implementations,
+// to validator states.
+var validatorStates = map[string]ValidatorState{
+	"unknown":             ValidatorStateUnknown,
+	"pending_initialized": ValidatorStatePendingInitialized,
+	"pending_queued":      ValidatorStatePendingQueued,
+	"active_ongoing":      ValidatorStateActiveOngoing,
+	"active_exiting":      ValidatorStateActiveExiting,
+	"active_slashed":      ValidatorStateActiveSlashed,
+	"exited_unslashed":    ValidatorStateExitedUnslashed,
+	"exited_slashed":      ValidatorStateExitedSlashed,
+	"withdrawal_possible": ValidatorStateWithdrawalPossible,
+	"withdrawal_done":     ValidatorStateWithdrawalDone,
+	// Lighthouse uses different states from currently-defined standard.
+	"waiting_for_eligibility":        ValidatorStatePendingInitialized,
+	"waiting_for_finality":           ValidatorStatePendingQueued,
+	"standby_for_active":             ValidatorStatePendingQueued,
+	"active":                         ValidatorStateActiveOngoing,
+	"active_awaiting_voluntary_exit": ValidatorStateActiveExiting,
+	"exited_voluntarily":             ValidatorStateExitedUnslashed,
+	"withdrawable":                   ValidatorStateWithdrawalPossible,
+	"waiting_in_queue":               ValidatorStatePendingQueued,
+	"active_awaiting_slashed_exit":   ValidatorStateActiveSlashed,
+	// Prysm uses its own validator status enumeration.
+	"unknown_status": ValidatorStateUnknown,
+	"deposited":      ValidatorStatePendingInitialized,
+	"pending":        ValidatorStatePendingQueued,
+	"exiting":        ValidatorStateActiveExiting,
+	"slashing":       ValidatorStateActiveSlashed,
+	"exited":         ValidatorStateExitedUnslashed,
+}
+
+// ParseValidatorState parses a validator state as returned by any known node implementation.
+// Case, surrounding whitespace and the use of spaces or hyphens in place of underscores are ignored.
+func ParseValidatorState(input string) (ValidatorState, error) {
+	normalised := strings.ToLower(strings.TrimSpace(input))
+	normalised = strings.NewReplacer("-", "_", " ", "_").Replace(normalised)
+	state, exists := validatorStates[normalised]
+	if !exists {
+		return ValidatorStateUnknown, fmt.Errorf("unrecognised validator state %q", input)
+	}
+
+	return state, nil
+}
+
 // UnmarshalJSON implements json.Unmarshaler.
 func (v *ValidatorState) UnmarshalJSON(input []byte) error {
-	var err error
-	switch strings.ToLower(string(input)) {
-	case `"unknown"`:
-		*v = ValidatorStateUnknown
-	case `"pending_initialized"`:
-		*v = ValidatorStatePendingInitialized
-	case `"pending_queued"`:
-		*v = ValidatorStatePendingQueued
-	case `"active_ongoing"`:
-		*v = ValidatorStateActiveOngoing
-	case `"active_exiting"`:
-		*v = ValidatorStateActiveExiting
-	case `"active_slashed"`:
-		*v = ValidatorStateActiveSlashed
-	case `"exited_unslashed"`:
-		*v = ValidatorStateExitedUnslashed
-	case `"exited_slashed"`:
-		*v = ValidatorStateExitedSlashed
-	case `"withdrawal_possible"`:
-		*v = ValidatorStateWithdrawalPossible
-	case `"withdrawal_done"`:
-		*v = ValidatorStateWithdrawalDone
-		// Lighthouse uses different states from currently-defined standard.
-	case `"waiting_for_eligibility"`:
-		*v = ValidatorStatePendingInitialized
-	case `"waiting_for_finality"`:
-		*v = ValidatorStatePendingQueued
-	case `"standby_for_active"`:
-		*v = ValidatorStatePendingQueued
-	case `"active"`:
-		*v = ValidatorStateActiveOngoing
-	case `"active_awaiting_voluntary_exit"`:
-		*v = ValidatorStateActiveExiting
-	case `"exited_voluntarily"`:
-		*v = ValidatorStateExitedUnslashed
-	case `"withdrawable"`:
-		*v = ValidatorStateWithdrawalPossible
-	case `"waiting_in_queue"`:
-		*v = ValidatorStatePendingQueued
-	case `"active_awaiting_slashed_exit"`:
-		*v = ValidatorStateActiveSlashed
-	default:
-		err = fmt.Errorf("unrecognised validator state %s", string(input))
+	var state string
+	if err := json.Unmarshal(input, &state); err != nil {
+		return fmt.Errorf("unrecognised validator state %s", string(input))
 	}
-	return err
+	parsed, err := ParseValidatorState(state)
+	if err != nil {
+		return err
+	}
+	*v = parsed
+
+	return nil
 }
 
 func (v ValidatorState) String() string {
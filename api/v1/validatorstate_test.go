@@ -111,6 +111,11 @@ func TestValidatorStateJSON(t *testing.T) {
 			input: []byte(`"Invalid"`),
 			err:   "unrecognised validator state \"Invalid\"",
 		},
+		{
+			name:  "NotString",
+			input: []byte(`1`),
+			err:   "unrecognised validator state 1",
+		},
 	}
 
 	for _, test := range tests {
@@ -137,6 +142,78 @@ func TestValidatorStateJSON(t *testing.T) {
 	}
 }
 
+func TestParseValidatorState(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		state api.ValidatorState
+		err   string
+	}{
+		{
+			name:  "Standard",
+			input: "active_ongoing",
+			state: api.ValidatorStateActiveOngoing,
+		},
+		{
+			name:  "UpperCase",
+			input: "ACTIVE_EXITING",
+			state: api.ValidatorStateActiveExiting,
+		},
+		{
+			name:  "Whitespace",
+			input: " pending_queued\n",
+			state: api.ValidatorStatePendingQueued,
+		},
+		{
+			name:  "Hyphens",
+			input: "exited-slashed",
+			state: api.ValidatorStateExitedSlashed,
+		},
+		{
+			name:  "Spaces",
+			input: "Withdrawal Possible",
+			state: api.ValidatorStateWithdrawalPossible,
+		},
+		{
+			name:  "Lighthouse",
+			input: "active_awaiting_voluntary_exit",
+			state: api.ValidatorStateActiveExiting,
+		},
+		{
+			name:  "Prysm",
+			input: "DEPOSITED",
+			state: api.ValidatorStatePendingInitialized,
+		},
+		{
+			name:  "PrysmUnknown",
+			input: "UNKNOWN_STATUS",
+			state: api.ValidatorStateUnknown,
+		},
+		{
+			name:  "Empty",
+			input: "",
+			err:   `unrecognised validator state ""`,
+		},
+		{
+			name:  "Invalid",
+			input: "active_maybe",
+			err:   `unrecognised validator state "active_maybe"`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			state, err := api.ParseValidatorState(test.input)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.state, state)
+			}
+		})
+	}
+}
+
 func TestValidatorToState(t *testing.T) {
 	farFutureEpoch := spec.Epoch(99999)
 	currentEpoch := spec.Epoch(100)
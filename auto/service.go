@@ -68,6 +68,7 @@ func tryStandard(ctx context.Context, parameters *parameters) (*standardhttp.Ser
 	standardhttpParameters = append(standardhttpParameters, standardhttp.WithLogLevel(parameters.logLevel))
 	standardhttpParameters = append(standardhttpParameters, standardhttp.WithAddress(parameters.address))
 	standardhttpParameters = append(standardhttpParameters, standardhttp.WithTimeout(parameters.timeout))
+	standardhttpParameters = append(standardhttpParameters, standardhttp.WithConnectionTimeout(parameters.connectionTimeout))
 	client, err := standardhttp.New(ctx, standardhttpParameters...)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed when trying to open connection with standard API")
@@ -80,6 +81,7 @@ func tryPrysm(ctx context.Context, parameters *parameters) (*prysmgrpc.Service,
 	prysmParameters = append(prysmParameters, prysmgrpc.WithLogLevel(parameters.logLevel))
 	prysmParameters = append(prysmParameters, prysmgrpc.WithAddress(parameters.address))
 	prysmParameters = append(prysmParameters, prysmgrpc.WithTimeout(parameters.timeout))
+	prysmParameters = append(prysmParameters, prysmgrpc.WithConnectionTimeout(parameters.connectionTimeout))
 	client, err := prysmgrpc.New(ctx, prysmParameters...)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed when trying to open connection to prysm")
@@ -92,6 +94,7 @@ func tryTeku(ctx context.Context, parameters *parameters) (*tekuhttp.Service, er
 	tekuParameters = append(tekuParameters, tekuhttp.WithLogLevel(parameters.logLevel))
 	tekuParameters = append(tekuParameters, tekuhttp.WithAddress(parameters.address))
 	tekuParameters = append(tekuParameters, tekuhttp.WithTimeout(parameters.timeout))
+	tekuParameters = append(tekuParameters, tekuhttp.WithConnectionTimeout(parameters.connectionTimeout))
 	client, err := tekuhttp.New(ctx, tekuParameters...)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed when trying to open connection to teku")
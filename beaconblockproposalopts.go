@@ -0,0 +1,42 @@
+// Copyright © 2020 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+// BeaconBlockProposalOpts are the options for obtaining a beacon block proposal.
+type BeaconBlockProposalOpts struct {
+	// SkipRandaoVerification requests that the node does not verify the RANDAO reveal.
+	SkipRandaoVerification bool
+}
+
+// BeaconBlockProposalOption is an option for obtaining a beacon block proposal.
+type BeaconBlockProposalOption func(*BeaconBlockProposalOpts)
+
+// WithSkipRandaoVerification requests that the node does not verify the RANDAO reveal.
+// This is for testing only, as the resultant block will not be valid.
+func WithSkipRandaoVerification(skip bool) BeaconBlockProposalOption {
+	return func(o *BeaconBlockProposalOpts) {
+		o.SkipRandaoVerification = skip
+	}
+}
+
+// NewBeaconBlockProposalOpts creates beacon block proposal options from the supplied individual options.
+func NewBeaconBlockProposalOpts(opts ...BeaconBlockProposalOption) *BeaconBlockProposalOpts {
+	res := &BeaconBlockProposalOpts{}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(res)
+		}
+	}
+	return res
+}
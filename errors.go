@@ -0,0 +1,258 @@
+// Copyright © 2020 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"fmt"
+	"strings"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// ErrNotFound is returned when the requested item is not known to the node.
+// Callers should check for it with errors.Is(), as it may be wrapped.
+var ErrNotFound = errors.New("not found")
+
+// ErrNotCanonical is returned when the requested block is not on the node's canonical chain.
+// Callers should check for it with errors.Is(), as it may be wrapped.
+var ErrNotCanonical = errors.New("not canonical")
+
+// SubmissionFailure is a failure reported by the node for an individual item in a submission.
+type SubmissionFailure struct {
+	// Index is the index of the failed item in the submitted list.
+	Index int
+	// Message is the node's explanation of the failure.
+	Message string
+}
+
+// SubmissionError is returned when the node rejects some or all of the items in a submission.
+// Callers can obtain it with errors.As(), as it may be wrapped.
+type SubmissionError struct {
+	// Message is the node's overall message.
+	Message string
+	// Failures are the individual item failures.
+	Failures []*SubmissionFailure
+}
+
+// Error implements error.
+func (e *SubmissionError) Error() string {
+	failures := make([]string, len(e.Failures))
+	for i, failure := range e.Failures {
+		failures[i] = fmt.Sprintf("item %d: %s", failure.Index, failure.Message)
+	}
+	return fmt.Sprintf("%s: %s", e.Message, strings.Join(failures, "; "))
+}
+
+// BadRequestError is returned when the node rejects a request as invalid.
+// Callers can obtain it with errors.As(), as it may be wrapped.
+type BadRequestError struct {
+	// Code is the status code returned by the node.
+	Code int
+	// Message is the node's explanation of the rejection.
+	Message string
+}
+
+// Error implements error.
+func (e *BadRequestError) Error() string {
+	return fmt.Sprintf("bad request (%d): %s", e.Code, e.Message)
+}
+
+// ItemFailure is the failure to obtain one or more items requested together as part of a larger request.
+type ItemFailure struct {
+	// Items are the items that could not be obtained, for example epochs, slots or validator indices.
+	Items []uint64
+	// Err is the error encountered.
+	Err error
+}
+
+// ItemsError is returned when some of the items in a request could not be obtained.  The items that could be
+// obtained are returned alongside the error.
+// Callers can obtain it with errors.As(), as it may be wrapped.  errors.Is() and errors.As() also match the errors
+// of the individual failures.
+type ItemsError struct {
+	// ItemName is the name of a single item, for example "epoch".
+	ItemName string
+	// Failures are the individual failures, in request order.
+	Failures []*ItemFailure
+}
+
+// Error implements error.
+func (e *ItemsError) Error() string {
+	count := 0
+	failures := make([]string, len(e.Failures))
+	for i, failure := range e.Failures {
+		count += len(failure.Items)
+		switch len(failure.Items) {
+		case 0:
+			failures[i] = fmt.Sprintf("%v", failure.Err)
+		case 1:
+			failures[i] = fmt.Sprintf("%s %d: %v", e.ItemName, failure.Items[0], failure.Err)
+		default:
+			failures[i] = fmt.Sprintf("%d %ss from %d: %v", len(failure.Items), e.ItemName, failure.Items[0], failure.Err)
+		}
+	}
+	return fmt.Sprintf("failed to obtain %d of the requested %ss: %s", count, e.ItemName, strings.Join(failures, "; "))
+}
+
+// Unwrap returns the error of the first failure.
+func (e *ItemsError) Unwrap() error {
+	if len(e.Failures) == 0 {
+		return nil
+	}
+	return e.Failures[0].Err
+}
+
+// Is returns true if the error of any failure matches the target.
+func (e *ItemsError) Is(target error) bool {
+	for _, failure := range e.Failures {
+		if errors.Is(failure.Err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// As finds the first failure error that matches the target, and if so sets the target to that error.
+func (e *ItemsError) As(target interface{}) bool {
+	for _, failure := range e.Failures {
+		if errors.As(failure.Err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// NetworkChangedError is returned when the node's genesis validators root has changed since the client connected,
+// for example because the node was restarted on a different network.  The client should be recreated.
+// Callers can obtain it with errors.As(), as it may be wrapped.
+type NetworkChangedError struct {
+	// Previous is the genesis validators root when the client connected.
+	Previous spec.Root
+	// Current is the genesis validators root now reported by the node.
+	Current spec.Root
+}
+
+// Error implements error.
+func (e *NetworkChangedError) Error() string {
+	return fmt.Sprintf("node genesis validators root changed from %#x to %#x; network changed", e.Previous, e.Current)
+}
+
+// ServerError is returned when the node fails with a server error.
+// Callers can obtain it with errors.As(), as it may be wrapped.
+type ServerError struct {
+	// Status is the HTTP status code returned by the node.
+	Status int
+	// Message is the node's explanation of the failure, if supplied.
+	Message string
+	// RequestID is the node's identifier for the failed request, if supplied.
+	RequestID string
+}
+
+// Error implements error.
+func (e *ServerError) Error() string {
+	msg := fmt.Sprintf("server error (%d)", e.Status)
+	if e.Message != "" {
+		msg = fmt.Sprintf("%s: %s", msg, e.Message)
+	}
+	if e.RequestID != "" {
+		msg = fmt.Sprintf("%s (request ID %s)", msg, e.RequestID)
+	}
+	return msg
+}
+
+// ResponseTooLargeError is returned when a response from the node is larger than the maximum allowed size.
+// Callers can obtain it with errors.As(), as it may be wrapped.
+type ResponseTooLargeError struct {
+	// MaxBytes is the maximum allowed size of a response.
+	MaxBytes int64
+}
+
+// Error implements error.
+func (e *ResponseTooLargeError) Error() string {
+	return fmt.Sprintf("response exceeds maximum size of %d bytes", e.MaxBytes)
+}
+
+// StreamFailedError is provided when an event stream is abandoned after failing to reconnect.
+// Callers can obtain the underlying error with errors.Unwrap().
+type StreamFailedError struct {
+	// Endpoint is the endpoint of the stream.
+	Endpoint string
+	// Err is the final error encountered.
+	Err error
+}
+
+// Error implements error.
+func (e *StreamFailedError) Error() string {
+	return fmt.Sprintf("event stream %s failed: %v", e.Endpoint, e.Err)
+}
+
+// Unwrap returns the final error encountered.
+func (e *StreamFailedError) Unwrap() error {
+	return e.Err
+}
+
+// StatePrunedError is returned when the requested state is no longer available from the node because it has been
+// pruned.  Unlike ErrNotFound, retrying against the same node will not help; the state must be obtained from an
+// archive node.
+// Callers can obtain it with errors.As(), as it may be wrapped.
+type StatePrunedError struct {
+	// StateID is the ID of the requested state.
+	StateID string
+	// Message is the node's explanation of the failure.
+	Message string
+}
+
+// Error implements error.
+func (e *StatePrunedError) Error() string {
+	return fmt.Sprintf("state %s has been pruned: %s", e.StateID, e.Message)
+}
+
+// StateRootMismatchError is returned when the state root in a block does not match the root of the state at the
+// block's slot, for example because the node served inconsistent data during a reorg.
+// Callers can obtain it with errors.As(), as it may be wrapped.
+type StateRootMismatchError struct {
+	// BlockID is the ID of the block.
+	BlockID string
+	// BlockStateRoot is the state root in the block.
+	BlockStateRoot spec.Root
+	// StateRoot is the root of the state at the block's slot.
+	StateRoot spec.Root
+}
+
+// Error implements error.
+func (e *StateRootMismatchError) Error() string {
+	return fmt.Sprintf("block %s has state root %#x but state has root %#x", e.BlockID, e.BlockStateRoot, e.StateRoot)
+}
+
+// UnknownValidatorsError is returned when some of the requested validators are not known to the node.
+// Callers can obtain it with errors.As(), as it may be wrapped.  It also matches ErrNotFound with errors.Is().
+type UnknownValidatorsError struct {
+	// PubKeys are the public keys of the unknown validators.
+	PubKeys []spec.BLSPubKey
+}
+
+// Error implements error.
+func (e *UnknownValidatorsError) Error() string {
+	pubKeys := make([]string, len(e.PubKeys))
+	for i := range e.PubKeys {
+		pubKeys[i] = fmt.Sprintf("%#x", e.PubKeys[i])
+	}
+	return fmt.Sprintf("%d unknown validators: %s", len(e.PubKeys), strings.Join(pubKeys, ", "))
+}
+
+// Unwrap provides the underlying error.
+func (e *UnknownValidatorsError) Unwrap() error {
+	return ErrNotFound
+}
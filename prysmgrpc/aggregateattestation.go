@@ -24,6 +24,9 @@ import (
 
 // PrysmAggregateAttestation fetches the aggregate attestation given an attestation.
 func (s *Service) PrysmAggregateAttestation(ctx context.Context, attestation *spec.Attestation, validatorPubKey spec.BLSPubKey, slotSignature spec.BLSSignature) (*spec.Attestation, error) {
+	if err := checkPubKeys([]spec.BLSPubKey{validatorPubKey}); err != nil {
+		return nil, errors.Wrap(err, "invalid validator public key")
+	}
 	conn := ethpb.NewBeaconNodeValidatorClient(s.conn)
 	log.Trace().Msg("Calling SubmitAggregateSelectionProof()")
 	opCtx, cancel := context.WithTimeout(ctx, s.timeout)
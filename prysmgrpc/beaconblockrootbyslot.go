@@ -16,11 +16,13 @@ package prysmgrpc
 import (
 	"context"
 
+	client "github.com/attestantio/go-eth2-client"
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 )
 
 // BeaconBlockRootBySlot fetches a block's root given its slot.
+// N.B if there is no block at the given slot this will return an error that wraps client.ErrNotFound.
 func (s *Service) BeaconBlockRootBySlot(ctx context.Context, slot uint64) ([]byte, error) {
 	conn := ethpb.NewBeaconChainClient(s.conn)
 
@@ -37,7 +39,7 @@ func (s *Service) BeaconBlockRootBySlot(ctx context.Context, slot uint64) ([]byt
 		return nil, errors.Wrap(err, "call to ListBlocks() failed")
 	}
 	if len(resp.BlockContainers) == 0 {
-		return nil, nil
+		return nil, errors.Wrap(client.ErrNotFound, "failed to obtain block root")
 	}
 
 	root, err := resp.BlockContainers[0].Block.Block.Body.HashTreeRoot()
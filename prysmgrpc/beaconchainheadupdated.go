@@ -76,10 +76,6 @@ func (s *Service) streamBeaconChainHead(ctx context.Context) {
 				log.Warn().Err(err).Msg("failed to obtain block for slot")
 				return
 			}
-			if signedBeaconBlock == nil {
-				log.Warn().Err(err).Msg("obtained nil block for slot")
-				return
-			}
 
 			s.beaconChainHeadUpdatedMutex.RLock()
 			for i := range s.beaconChainHeadUpdatedHandlers {
@@ -0,0 +1,110 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prysmgrpc_test
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/prysmgrpc"
+	"github.com/gogo/protobuf/types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// deadlineNodeServer is a node server that records the deadline of incoming requests.
+type deadlineNodeServer struct {
+	ethpb.UnimplementedNodeServer
+	mu        sync.Mutex
+	deadlines []time.Duration
+}
+
+func (d *deadlineNodeServer) GetVersion(ctx context.Context, req *types.Empty) (*ethpb.Version, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	deadline, exists := ctx.Deadline()
+	if exists {
+		d.deadlines = append(d.deadlines, time.Until(deadline))
+	} else {
+		d.deadlines = append(d.deadlines, 0)
+	}
+
+	return &ethpb.Version{Version: "test"}, nil
+}
+
+func (d *deadlineNodeServer) lastDeadline() time.Duration {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.deadlines[len(d.deadlines)-1]
+}
+
+func TestDeadlinePropagation(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	nodeServer := &deadlineNodeServer{}
+	server := grpc.NewServer()
+	ethpb.RegisterNodeServer(server, nodeServer)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	defer server.Stop()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	service, err := prysmgrpc.New(ctx,
+		prysmgrpc.WithAddress(listener.Addr().String()),
+		prysmgrpc.WithTimeout(30*time.Second),
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		timeout time.Duration
+		min     time.Duration
+		max     time.Duration
+	}{
+		{
+			name: "ServiceTimeout",
+			min:  20 * time.Second,
+			max:  30 * time.Second,
+		},
+		{
+			name:    "CallerTimeout",
+			timeout: 5 * time.Second,
+			min:     time.Second,
+			max:     5 * time.Second,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			opCtx := context.Background()
+			if test.timeout != 0 {
+				var opCancel context.CancelFunc
+				opCtx, opCancel = context.WithTimeout(opCtx, test.timeout)
+				defer opCancel()
+			}
+			_, err := service.NodeVersion(opCtx)
+			require.NoError(t, err)
+			deadline := nodeServer.lastDeadline()
+			require.Greater(t, int64(deadline), int64(test.min))
+			require.LessOrEqual(t, int64(deadline), int64(test.max))
+		})
+	}
+}
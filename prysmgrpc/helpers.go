@@ -15,6 +15,7 @@ package prysmgrpc
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 	"strings"
 	"time"
@@ -100,3 +101,15 @@ func (s *Service) indicesToPubKeys(ctx context.Context, indices []spec.Validator
 	}
 	return pubKeys, nil
 }
+
+// checkPubKeys ensures that the supplied public keys are plausible, to catch caller errors
+// before they are sent to the node.
+func checkPubKeys(pubKeys []spec.BLSPubKey) error {
+	var zeroPubKey spec.BLSPubKey
+	for i := range pubKeys {
+		if pubKeys[i] == zeroPubKey {
+			return fmt.Errorf("public key %d is zero", i)
+		}
+	}
+	return nil
+}
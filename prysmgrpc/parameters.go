@@ -24,6 +24,8 @@ type parameters struct {
 	logLevel zerolog.Level
 	address  string
 	timeout  time.Duration
+
+	connectionTimeout time.Duration
 }
 
 // Parameter is the interface for service parameters.
@@ -52,18 +54,28 @@ func WithAddress(address string) Parameter {
 }
 
 // WithTimeout sets the maximum duration for all requests to the endpoint.
+// The resultant deadline, or that of the caller's context if earlier, is sent to the server with each request.
 func WithTimeout(timeout time.Duration) Parameter {
 	return parameterFunc(func(p *parameters) {
 		p.timeout = timeout
 	})
 }
 
+// WithConnectionTimeout sets the maximum duration to establish a connection to the endpoint.
+// This is separate from, and should be lower than, the request timeout.
+func WithConnectionTimeout(timeout time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.connectionTimeout = timeout
+	})
+}
+
 // parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
 func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	parameters := parameters{
-		logLevel: zerolog.GlobalLevel(),
-		address:  "localhost:4000",
-		timeout:  2 * time.Minute,
+		logLevel:          zerolog.GlobalLevel(),
+		address:           "localhost:4000",
+		timeout:           2 * time.Minute,
+		connectionTimeout: 30 * time.Second,
 	}
 	for _, p := range params {
 		if params != nil {
@@ -74,6 +86,9 @@ func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	if parameters.address == "" {
 		return nil, errors.New("no address specified")
 	}
+	if parameters.connectionTimeout <= 0 {
+		return nil, errors.New("no connection timeout specified")
+	}
 
 	return &parameters, nil
 }
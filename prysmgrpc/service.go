@@ -15,6 +15,7 @@ package prysmgrpc
 
 import (
 	"context"
+	"net"
 	"regexp"
 	"strconv"
 	"strings"
@@ -68,6 +69,7 @@ type Service struct {
 var log zerolog.Logger
 
 // New creates a new Ethereum 2 client service, connecting with Prysm GRPC.
+// Cancelling the supplied context stops all background work, such as event streams, and closes the connection.
 func New(ctx context.Context, params ...Parameter) (*Service, error) {
 	parameters, err := parseAndCheckParameters(params...)
 	if err != nil {
@@ -84,6 +86,9 @@ func New(ctx context.Context, params ...Parameter) (*Service, error) {
 		grpc.WithInsecure(),
 		// Maximum receive value 128 MB
 		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(128 * 1024 * 1024)),
+		grpc.WithContextDialer(func(ctx context.Context, address string) (net.Conn, error) {
+			return (&net.Dialer{Timeout: parameters.connectionTimeout}).DialContext(ctx, "tcp", address)
+		}),
 	}
 
 	dialCtx, cancel := context.WithTimeout(ctx, parameters.timeout)
@@ -101,7 +106,8 @@ func New(ctx context.Context, params ...Parameter) (*Service, error) {
 		maxPageSize: 250, // Prysm default.
 	}
 
-	// Obtain the node version to confirm the connection is good.
+	// Obtain the node version to confirm the connection is good.  The dial does not block, and each call is
+	// bounded by the request timeout, so an unresponsive node cannot block creation indefinitely.
 	if _, err := s.NodeVersion(ctx); err != nil {
 		return nil, errors.Wrap(err, "failed to confirm node connection")
 	}
@@ -134,6 +140,12 @@ func (s *Service) Address() string {
 	return s.address
 }
 
+// Context provides the context supplied when the service was created.
+// The service is closed when this context is done, so it can be used to tie goroutines to the lifecycle of the service.
+func (s *Service) Context() context.Context {
+	return s.ctx
+}
+
 // Close the service, freeing up resources.
 func (s *Service) close() {
 	if err := s.conn.Close(); err != nil {
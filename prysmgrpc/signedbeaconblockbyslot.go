@@ -18,6 +18,7 @@ import (
 	"strconv"
 	"time"
 
+	client "github.com/attestantio/go-eth2-client"
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
@@ -48,6 +49,7 @@ func (s *Service) SignedBeaconBlock(ctx context.Context, blockID string) (*spec.
 }
 
 // SignedBeaconBlockBySlot fetches a signed beacon block given its slot.
+// N.B if there is no block at the given slot this will return an error that wraps client.ErrNotFound.
 func (s *Service) SignedBeaconBlockBySlot(ctx context.Context, slot uint64) (*spec.SignedBeaconBlock, error) {
 	conn := ethpb.NewBeaconChainClient(s.conn)
 
@@ -64,7 +66,7 @@ func (s *Service) SignedBeaconBlockBySlot(ctx context.Context, slot uint64) (*sp
 		return nil, errors.Wrap(err, "call to ListBlocks() failed")
 	}
 	if len(resp.BlockContainers) == 0 {
-		return nil, nil
+		return nil, errors.Wrap(client.ErrNotFound, "failed to obtain signed beacon block")
 	}
 
 	block := resp.BlockContainers[0].Block
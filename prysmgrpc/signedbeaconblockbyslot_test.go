@@ -15,21 +15,25 @@ package prysmgrpc_test
 
 import (
 	"context"
+	"errors"
 	"os"
 	"testing"
 
+	client "github.com/attestantio/go-eth2-client"
 	"github.com/attestantio/go-eth2-client/prysmgrpc"
 	"github.com/stretchr/testify/require"
 )
 
 func TestSignedBeaconBlockBySlot(t *testing.T) {
 	tests := []struct {
-		name string
-		slot uint64
+		name     string
+		slot     uint64
+		notFound bool
 	}{
 		{
-			name: "Missing",
-			slot: 1,
+			name:     "Missing",
+			slot:     1,
+			notFound: true,
 		},
 		{
 			name: "Good",
@@ -58,6 +62,10 @@ func TestSignedBeaconBlockBySlot(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			block, err := service.SignedBeaconBlockBySlot(context.Background(), test.slot)
+			if test.notFound {
+				require.True(t, errors.Is(err, client.ErrNotFound))
+				return
+			}
 			require.NoError(t, err)
 			require.NotNil(t, block)
 		})
@@ -25,6 +25,9 @@ import (
 // stateID can be a slot number or state root, or one of the special values "genesis", "head", "justified" or "finalized".
 // validators is a list of validators to restrict the returned values.  If no validators are supplied no filter will be applied.
 func (s *Service) PrysmValidatorBalances(ctx context.Context, stateID string, validatorPubKeys []spec.BLSPubKey) (map[spec.ValidatorIndex]spec.Gwei, error) {
+	if err := checkPubKeys(validatorPubKeys); err != nil {
+		return nil, errors.Wrap(err, "invalid validator public keys")
+	}
 	if len(validatorPubKeys) == 0 {
 		return s.validatorBalances(ctx, stateID)
 	}
@@ -57,6 +57,9 @@ func (s *Service) ValidatorsByPubKey(ctx context.Context, stateID string, valida
 // stateID can be a slot number or state root, or one of the special values "genesis", "head", "justified" or "finalized".
 // validators is a list of validators to restrict the returned values.  If no validators are supplied no filter will be applied.
 func (s *Service) PrysmValidators(ctx context.Context, stateID string, validatorPubKeys []spec.BLSPubKey) (map[spec.ValidatorIndex]*api.Validator, error) {
+	if err := checkPubKeys(validatorPubKeys); err != nil {
+		return nil, errors.Wrap(err, "invalid validator public keys")
+	}
 	if len(validatorPubKeys) == 0 {
 		return s.validators(ctx, stateID, true)
 	}
@@ -75,6 +78,9 @@ func (s *Service) PrysmValidatorsByPubKey(ctx context.Context, stateID string, v
 // stateID can be a slot number or state root, or one of the special values "genesis", "head", "justified" or "finalized".
 // validators is a list of validators to restrict the returned values.  If no validators are supplied no filter will be applied.
 func (s *Service) PrysmValidatorsWithoutBalance(ctx context.Context, stateID string, validatorPubKeys []spec.BLSPubKey) (map[spec.ValidatorIndex]*api.Validator, error) {
+	if err := checkPubKeys(validatorPubKeys); err != nil {
+		return nil, errors.Wrap(err, "invalid validator public keys")
+	}
 	if len(validatorPubKeys) == 0 {
 		return s.validators(ctx, stateID, false)
 	}
@@ -0,0 +1,93 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recorder
+
+import (
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// Mode is the mode in which the recorder operates.
+type Mode int
+
+const (
+	// ModeReplay serves responses from previously recorded fixtures.
+	ModeReplay Mode = iota
+	// ModeRecord passes requests to the node and records the responses as fixtures.
+	ModeRecord
+)
+
+type parameters struct {
+	mode      Mode
+	directory string
+	transport http.RoundTripper
+}
+
+// Parameter is the interface for recorder parameters.
+type Parameter interface {
+	apply(*parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithMode sets the mode of the recorder.
+func WithMode(mode Mode) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.mode = mode
+	})
+}
+
+// WithDirectory sets the directory in which fixtures are stored.
+func WithDirectory(directory string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.directory = directory
+	})
+}
+
+// WithTransport sets the transport used to talk to the node when recording.
+func WithTransport(transport http.RoundTripper) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.transport = transport
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		mode:      ModeReplay,
+		transport: http.DefaultTransport,
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.directory == "" {
+		return nil, errors.New("no directory specified")
+	}
+	if parameters.mode != ModeReplay && parameters.mode != ModeRecord {
+		return nil, errors.New("invalid mode specified")
+	}
+	if parameters.transport == nil {
+		return nil, errors.New("no transport specified")
+	}
+
+	return &parameters, nil
+}
@@ -0,0 +1,201 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package recorder provides an HTTP round tripper that records responses from a beacon node to disk,
+// and later replays them.  This allows tests to exercise the real parsing code against real node
+// output without requiring a live node.
+package recorder
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// recordedHeaders are the response headers that are stored in fixtures.
+var recordedHeaders = []string{
+	"Content-Type",
+	"Eth-Consensus-Version",
+	"Eth-Execution-Payload-Blinded",
+}
+
+// RoundTripper records or replays HTTP responses.
+type RoundTripper struct {
+	mode      Mode
+	directory string
+	transport http.RoundTripper
+	mutex     sync.Mutex
+}
+
+// fixture is a single recorded response.
+type fixture struct {
+	Method     string            `json:"method"`
+	URI        string            `json:"uri"`
+	StatusCode int               `json:"status_code"`
+	Headers    map[string]string `json:"headers,omitempty"`
+	// Body is present if the response body is JSON, otherwise BodyBase64 is present.
+	Body       json.RawMessage `json:"body,omitempty"`
+	BodyBase64 string          `json:"body_base64,omitempty"`
+}
+
+// New creates a new recording round tripper.
+func New(params ...Parameter) (*RoundTripper, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	return &RoundTripper{
+		mode:      parameters.mode,
+		directory: parameters.directory,
+		transport: parameters.transport,
+	}, nil
+}
+
+// RoundTrip executes a single HTTP transaction.
+func (r *RoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	var reqBody []byte
+	if req.Body != nil {
+		var err error
+		reqBody, err = ioutil.ReadAll(req.Body)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read request body")
+		}
+		if err := req.Body.Close(); err != nil {
+			return nil, errors.Wrap(err, "failed to close request body")
+		}
+		req.Body = ioutil.NopCloser(bytes.NewReader(reqBody))
+	}
+	path := filepath.Join(r.directory, fixtureName(req, reqBody))
+
+	if r.mode == ModeRecord {
+		return r.record(req, path)
+	}
+	return r.replay(req, path)
+}
+
+// record passes the request to the node and stores the response.
+func (r *RoundTripper) record(req *http.Request, path string) (*http.Response, error) {
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read response body")
+	}
+	if err := resp.Body.Close(); err != nil {
+		return nil, errors.Wrap(err, "failed to close response body")
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	f := &fixture{
+		Method:     req.Method,
+		URI:        req.URL.RequestURI(),
+		StatusCode: resp.StatusCode,
+		Headers:    make(map[string]string),
+	}
+	for _, header := range recordedHeaders {
+		if value := resp.Header.Get(header); value != "" {
+			f.Headers[header] = value
+		}
+	}
+	if json.Valid(body) {
+		var indented bytes.Buffer
+		if err := json.Indent(&indented, body, "", "  "); err != nil {
+			return nil, errors.Wrap(err, "failed to indent response body")
+		}
+		f.Body = indented.Bytes()
+	} else {
+		f.BodyBase64 = base64.StdEncoding.EncodeToString(body)
+	}
+
+	data, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal fixture")
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if err := os.MkdirAll(r.directory, 0o755); err != nil {
+		return nil, errors.Wrap(err, "failed to create fixture directory")
+	}
+	if err := ioutil.WriteFile(path, append(data, '\n'), 0o644); err != nil {
+		return nil, errors.Wrap(err, "failed to write fixture")
+	}
+
+	return resp, nil
+}
+
+// replay serves the response from a stored fixture.
+func (r *RoundTripper) replay(req *http.Request, path string) (*http.Response, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("no recorded response for %s %s", req.Method, req.URL.RequestURI())
+		}
+		return nil, errors.Wrap(err, "failed to read fixture")
+	}
+	var f fixture
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, errors.Wrap(err, "failed to parse fixture")
+	}
+
+	body := []byte(f.Body)
+	if f.BodyBase64 != "" {
+		body, err = base64.StdEncoding.DecodeString(f.BodyBase64)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid body in fixture")
+		}
+	}
+	header := make(http.Header)
+	for k, v := range f.Headers {
+		header.Set(k, v)
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", f.StatusCode, http.StatusText(f.StatusCode)),
+		StatusCode:    f.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+// fixtureName provides the name of the fixture file for a request.
+// The name is readable from the method and path, with a hash suffix if the request has a query or body.
+func fixtureName(req *http.Request, body []byte) string {
+	name := strings.ToLower(req.Method) + strings.ReplaceAll(req.URL.Path, "/", "_")
+	if req.URL.RawQuery != "" || len(body) > 0 {
+		hash := sha256.New()
+		hash.Write([]byte(req.URL.RawQuery))
+		hash.Write([]byte{0})
+		hash.Write(body)
+		name = fmt.Sprintf("%s_%s", name, hex.EncodeToString(hash.Sum(nil))[:8])
+	}
+	return name + ".json"
+}
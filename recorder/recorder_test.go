@@ -0,0 +1,148 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recorder_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/recorder"
+	"github.com/stretchr/testify/require"
+)
+
+// tempDir creates a temporary directory that is removed when the test finishes.
+func tempDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "recorder")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		os.RemoveAll(dir)
+	})
+
+	return dir
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name   string
+		params []recorder.Parameter
+		err    string
+	}{
+		{
+			name: "DirectoryMissing",
+			err:  "problem with parameters: no directory specified",
+		},
+		{
+			name: "ModeInvalid",
+			params: []recorder.Parameter{
+				recorder.WithDirectory(tempDir(t)),
+				recorder.WithMode(recorder.Mode(-1)),
+			},
+			err: "problem with parameters: invalid mode specified",
+		},
+		{
+			name: "TransportNil",
+			params: []recorder.Parameter{
+				recorder.WithDirectory(tempDir(t)),
+				recorder.WithTransport(nil),
+			},
+			err: "problem with parameters: no transport specified",
+		},
+		{
+			name: "Good",
+			params: []recorder.Parameter{
+				recorder.WithDirectory(tempDir(t)),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := recorder.New(test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRecordReplay(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/json":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"data":"` + r.URL.Query().Get("id") + `"}`))
+		case "/ssz":
+			w.Header().Set("Content-Type", "application/octet-stream")
+			w.Header().Set("Eth-Consensus-Version", "capella")
+			_, _ = w.Write([]byte{0x00, 0x01, 0xff})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	dir := tempDir(t)
+
+	requests := []struct {
+		uri         string
+		status      int
+		contentType string
+		body        string
+	}{
+		{uri: "/json?id=1", status: http.StatusOK, contentType: "application/json", body: `{"data":"1"}`},
+		{uri: "/json?id=2", status: http.StatusOK, contentType: "application/json", body: `{"data":"2"}`},
+		{uri: "/ssz", status: http.StatusOK, contentType: "application/octet-stream", body: "\x00\x01\xff"},
+		{uri: "/missing", status: http.StatusNotFound, contentType: "text/plain; charset=utf-8", body: "404 page not found\n"},
+	}
+
+	recording, err := recorder.New(recorder.WithDirectory(dir), recorder.WithMode(recorder.ModeRecord))
+	require.NoError(t, err)
+	recordingClient := &http.Client{Transport: recording}
+	for _, request := range requests {
+		resp, err := recordingClient.Get(server.URL + request.uri)
+		require.NoError(t, err)
+		body, err := ioutil.ReadAll(resp.Body)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		require.Equal(t, request.body, string(body))
+	}
+
+	// Replay does not need the server.
+	server.Close()
+	replaying, err := recorder.New(recorder.WithDirectory(dir))
+	require.NoError(t, err)
+	replayingClient := &http.Client{Transport: replaying}
+	for _, request := range requests {
+		resp, err := replayingClient.Get("http://replay" + request.uri)
+		require.NoError(t, err)
+		require.Equal(t, request.status, resp.StatusCode)
+		require.Equal(t, request.contentType, resp.Header.Get("Content-Type"))
+		body, err := ioutil.ReadAll(resp.Body)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		if strings.HasPrefix(request.contentType, "application/json") {
+			require.JSONEq(t, request.body, string(body))
+		} else {
+			require.Equal(t, request.body, string(body))
+		}
+	}
+
+	_, err = replayingClient.Get("http://replay/json?id=3")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "no recorded response for GET /json?id=3")
+}
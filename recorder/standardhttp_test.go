@@ -0,0 +1,80 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recorder_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/recorder"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
+	"github.com/stretchr/testify/require"
+)
+
+// newRecordedService creates a standard HTTP service using recorded fixtures.
+// If RECORD_HTTP_ADDRESS is set the fixtures are recorded afresh from the node at that address.
+func newRecordedService(t *testing.T) *standardhttp.Service {
+	address := "http://replay"
+	params := []recorder.Parameter{
+		recorder.WithDirectory("testdata"),
+	}
+	if recordAddress := os.Getenv("RECORD_HTTP_ADDRESS"); recordAddress != "" {
+		address = recordAddress
+		params = append(params, recorder.WithMode(recorder.ModeRecord), recorder.WithTransport(http.DefaultTransport))
+	}
+	transport, err := recorder.New(params...)
+	require.NoError(t, err)
+
+	service, err := standardhttp.New(context.Background(),
+		standardhttp.WithTimeout(10*time.Second),
+		standardhttp.WithAddress(address),
+		standardhttp.WithTransport(transport),
+	)
+	require.NoError(t, err)
+
+	return service
+}
+
+func TestRecordedGenesis(t *testing.T) {
+	service := newRecordedService(t)
+
+	genesis, err := service.Genesis(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, time.Unix(1606824023, 0), genesis.GenesisTime)
+	require.Equal(t, "0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95", fmt.Sprintf("%#x", genesis.GenesisValidatorsRoot))
+	require.Equal(t, spec.Version{0x00, 0x00, 0x00, 0x00}, genesis.GenesisForkVersion)
+
+	config, err := service.ChainConfig(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 12*time.Second, config.SecondsPerSlot)
+	require.Equal(t, uint64(32), config.SlotsPerEpoch)
+	require.Equal(t, spec.Epoch(74240), config.AltairForkEpoch)
+}
+
+func TestRecordedValidators(t *testing.T) {
+	service := newRecordedService(t)
+
+	validators, err := service.Validators(context.Background(), "head", []spec.ValidatorIndex{0, 1})
+	require.NoError(t, err)
+	require.Len(t, validators, 2)
+	require.Equal(t, spec.Gwei(32000000000), validators[0].Validator.EffectiveBalance)
+	require.Equal(t, api.ValidatorStateActiveOngoing, validators[0].Status)
+	require.Equal(t, spec.ValidatorIndex(1), validators[1].Index)
+}
@@ -18,7 +18,11 @@ import (
 	"time"
 
 	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	bitfield "github.com/prysmaticlabs/go-bitfield"
 )
 
 // Service is the service providing a connection to an Ethereum 2 client.
@@ -84,6 +88,18 @@ type NodeVersionProvider interface {
 	NodeVersion(ctx context.Context) (string, error)
 }
 
+// NodeLivenessProvider is the interface for providing the liveness of the node.
+type NodeLivenessProvider interface {
+	// NodeLiveness returns true if the node is live.
+	NodeLiveness(ctx context.Context) (bool, error)
+}
+
+// NodeReadinessProvider is the interface for providing the readiness of the node.
+type NodeReadinessProvider interface {
+	// NodeReadiness returns true if the node is ready to serve requests.
+	NodeReadiness(ctx context.Context) (bool, error)
+}
+
 // SlotDurationProvider is the interface for providing the duration of each slot of a chain.
 type SlotDurationProvider interface {
 	// SlotDuration provides the duration of a slot of the chain.
@@ -102,6 +118,12 @@ type GenesisValidatorsRootProvider interface {
 	GenesisValidatorsRoot(ctx context.Context) ([]byte, error)
 }
 
+// GenesisForkVersionProvider is the interface for providing the genesis fork version of a chain.
+type GenesisForkVersionProvider interface {
+	// GenesisForkVersion provides the genesis fork version of the chain.
+	GenesisForkVersion(ctx context.Context) (spec.Version, error)
+}
+
 // TargetAggregatorsPerCommitteeProvider is the interface for providing the target number of
 // aggregators in each attestation committee.
 type TargetAggregatorsPerCommitteeProvider interface {
@@ -255,6 +277,13 @@ type AttestationDataProvider interface {
 	AttestationData(ctx context.Context, slot spec.Slot, committeeIndex spec.CommitteeIndex) (*spec.AttestationData, error)
 }
 
+// AttestationRewardsProvider is the interface for providing attestation rewards.
+type AttestationRewardsProvider interface {
+	// AttestationRewards provides the attestation rewards for the given epoch.
+	// If validatorIndices is empty rewards for all validators are returned, otherwise only matching rewards are returned.
+	AttestationRewards(ctx context.Context, epoch spec.Epoch, validatorIndices []spec.ValidatorIndex) (*api.AttestationRewards, error)
+}
+
 // AttestationSubmitter is the interface for submitting attestations.
 type AttestationSubmitter interface {
 	// SubmitAttestation submits an attestation.
@@ -274,10 +303,20 @@ type AttesterDutiesProvider interface {
 	AttesterDuties(ctx context.Context, epoch spec.Epoch, validatorIndices []spec.ValidatorIndex) ([]*api.AttesterDuty, error)
 }
 
+// BLSToExecutionChangesSubmitter is the interface for submitting BLS to execution address changes.
+type BLSToExecutionChangesSubmitter interface {
+	// SubmitBLSToExecutionChanges submits BLS to execution address changes.
+	SubmitBLSToExecutionChanges(ctx context.Context, blsToExecutionChanges []*capella.SignedBLSToExecutionChange) error
+}
+
 // BeaconBlockHeadersProvider is the interface for providing beacon block headers.
 type BeaconBlockHeadersProvider interface {
 	// BeaconBlockHeader provides the block header of a given block ID.
 	BeaconBlockHeader(ctx context.Context, blockID string) (*api.BeaconBlockHeader, error)
+
+	// BeaconBlockHeaders provides the block headers matching the optional slot and parent root filters.  This
+	// includes non-canonical headers.  If no filters are supplied the node returns the header of the head block.
+	BeaconBlockHeaders(ctx context.Context, slot *spec.Slot, parentRoot *spec.Root) ([]*api.BeaconBlockHeader, error)
 }
 
 // BeaconBlockProposalProvider is the interface for providing beacon block proposals.
@@ -304,12 +343,40 @@ type BeaconStateProvider interface {
 	BeaconState(ctx context.Context, stateID string) (*spec.BeaconState, error)
 }
 
+// BlobSidecarsProvider is the interface for providing blob sidecars.
+type BlobSidecarsProvider interface {
+	// BlobSidecars fetches the blob sidecars for a given block ID.
+	// blockID can be a slot number or block root, or one of the special values "genesis", "head" or "finalized".
+	// indices is a list of blob indices to restrict the returned values.  If no indices are supplied no filter will be applied.
+	BlobSidecars(ctx context.Context, blockID string, indices []uint64) ([]*deneb.BlobSidecar, error)
+}
+
+// BlockRewardsProvider is the interface for providing block rewards.
+type BlockRewardsProvider interface {
+	// BlockRewards provides the rewards received by the proposer of the given block.
+	// blockID can be a slot number or block root, or one of the special values "genesis", "head" or "finalized".
+	BlockRewards(ctx context.Context, blockID string) (*api.BlockRewards, error)
+}
+
+// DepositSnapshotProvider is the interface for providing the deposit snapshot.
+type DepositSnapshotProvider interface {
+	// DepositSnapshot provides the snapshot of the finalized deposit tree.
+	DepositSnapshot(ctx context.Context) (*api.DepositSnapshot, error)
+}
+
 // EventsProvider is the interface for providing events.
 type EventsProvider interface {
 	// Events feeds requested events with the given topics to the supplied handler.
 	Events(ctx context.Context, topics []string, handler EventHandlerFunc) error
 }
 
+// ExpectedWithdrawalsProvider is the interface for providing expected withdrawals.
+type ExpectedWithdrawalsProvider interface {
+	// ExpectedWithdrawals provides the withdrawals that the node expects to be included in the next block built on the
+	// given state.  If proposalSlot is supplied the withdrawals are those expected for a block proposed at that slot.
+	ExpectedWithdrawals(ctx context.Context, stateID string, proposalSlot *spec.Slot) ([]*capella.Withdrawal, error)
+}
+
 // FinalityProvider is the interface for providing finality information.
 type FinalityProvider interface {
 	// Finality provides the finality given a state ID.
@@ -334,6 +401,18 @@ type GenesisProvider interface {
 	Genesis(ctx context.Context) (*api.Genesis, error)
 }
 
+// LightClientBootstrapProvider is the interface for providing light client bootstrap data.
+type LightClientBootstrapProvider interface {
+	// LightClientBootstrap provides the data to bootstrap a light client from the given trusted block root.
+	LightClientBootstrap(ctx context.Context, blockRoot spec.Root) (*api.LightClientBootstrap, error)
+}
+
+// LightClientUpdatesProvider is the interface for providing light client updates.
+type LightClientUpdatesProvider interface {
+	// LightClientUpdates provides up to count light client updates, starting at the given sync committee period.
+	LightClientUpdates(ctx context.Context, startPeriod uint64, count uint64) ([]*api.LightClientUpdate, error)
+}
+
 // NodeSyncingProvider is the interface for providing synchronization state.
 type NodeSyncingProvider interface {
 	// NodeSyncing provides the state of the node's synchronization with the chain.
@@ -353,6 +432,35 @@ type SpecProvider interface {
 	Spec(ctx context.Context) (map[string]interface{}, error)
 }
 
+// SyncCommitteeContributionsSubmitter is the interface for submitting sync committee contributions.
+type SyncCommitteeContributionsSubmitter interface {
+	// SubmitSyncCommitteeContributions submits sync committee contributions.
+	SubmitSyncCommitteeContributions(ctx context.Context, contributionAndProofs []*altair.SignedContributionAndProof) error
+}
+
+// SyncCommitteeRewardsProvider is the interface for providing sync committee rewards.
+type SyncCommitteeRewardsProvider interface {
+	// SyncCommitteeRewards provides the rewards received by sync committee members for the given block.
+	// blockID can be a slot number or block root, or one of the special values "genesis", "head" or "finalized".
+	// If validatorIndices is empty rewards for all sync committee members are returned, otherwise only matching rewards are returned.
+	SyncCommitteeRewards(ctx context.Context, blockID string, validatorIndices []spec.ValidatorIndex) (*api.SyncCommitteeRewards, error)
+}
+
+// SyncCommitteeSubscriptionsSubmitter is the interface for submitting sync committee subnet subscription requests.
+type SyncCommitteeSubscriptionsSubmitter interface {
+	// SubmitSyncCommitteeSubscriptions subscribes to sync committees.
+	SubmitSyncCommitteeSubscriptions(ctx context.Context, subscriptions []*api.SyncCommitteeSubscription) error
+}
+
+// SyncCommitteesProvider is the interface for providing sync committees.
+type SyncCommitteesProvider interface {
+	// SyncCommittee fetches the sync committee for the epoch at the given state.
+	SyncCommittee(ctx context.Context, stateID string) (*api.SyncCommittee, error)
+
+	// SyncCommitteeAtEpoch fetches the sync committee for the given epoch at the given state.
+	SyncCommitteeAtEpoch(ctx context.Context, stateID string, epoch spec.Epoch) (*api.SyncCommittee, error)
+}
+
 // SyncStateProvider is the interface for providing synchronization state.
 type SyncStateProvider interface {
 	// SyncState provides the state of the node's synchronization with the chain.
@@ -368,12 +476,21 @@ type ValidatorBalancesProvider interface {
 	ValidatorBalances(ctx context.Context, stateID string, validatorIndices []spec.ValidatorIndex) (map[spec.ValidatorIndex]spec.Gwei, error)
 }
 
+// ValidatorLivenessProvider is the interface for providing validator liveness.
+type ValidatorLivenessProvider interface {
+	// ValidatorLiveness provides the liveness of the given validators at the given epoch, indicating if they were
+	// seen to be active by the node.
+	ValidatorLiveness(ctx context.Context, epoch spec.Epoch, validatorIndices []spec.ValidatorIndex) (map[spec.ValidatorIndex]bool, error)
+}
+
 // ValidatorsProvider is the interface for providing validator information.
 type ValidatorsProvider interface {
 	// Validators provides the validators, with their balance and status, for a given state.
 	// stateID can be a slot number or state root, or one of the special values "genesis", "head", "justified" or "finalized".
 	// validatorIndices is a list of validator indices to restrict the returned values.  If no validators IDs are supplied no filter
 	// will be applied.
+	// Implementations that split large requests may return the validators that could be obtained along with a
+	// *ItemsError listing those that could not.
 	Validators(ctx context.Context, stateID string, validatorIndices []spec.ValidatorIndex) (map[spec.ValidatorIndex]*api.Validator, error)
 
 	// ValidatorsByPubKey provides the validators, with their balance and status, for a given state.
@@ -383,6 +500,12 @@ type ValidatorsProvider interface {
 	ValidatorsByPubKey(ctx context.Context, stateID string, validatorPubKeys []spec.BLSPubKey) (map[spec.ValidatorIndex]*api.Validator, error)
 }
 
+// ValidatorRegistrationsSubmitter is the interface for submitting validator registrations.
+type ValidatorRegistrationsSubmitter interface {
+	// SubmitValidatorRegistrations submits validator registrations for block builders.
+	SubmitValidatorRegistrations(ctx context.Context, registrations []*api.SignedValidatorRegistration) error
+}
+
 // VoluntaryExitSubmitter is the interface for submitting voluntary exits.
 type VoluntaryExitSubmitter interface {
 	// SubmitVoluntaryExit submits a voluntary exit.
@@ -398,14 +521,298 @@ type VoluntaryExitSubmitter interface {
 // Local extensions
 //
 
+// ActiveValidatorCountProvider is the interface for providing the number of active validators.
+type ActiveValidatorCountProvider interface {
+	// ActiveValidatorCount provides the number of active validators in a given state.
+	// stateID can be a slot number or state root, or one of the special values "genesis", "head", "justified" or "finalized".
+	ActiveValidatorCount(ctx context.Context, stateID string) (uint64, error)
+}
+
+// BeaconBlockProposalV2Provider is the interface for providing beacon block proposals with options.
+type BeaconBlockProposalV2Provider interface {
+	// BeaconBlockProposalV2 fetches a proposed beacon block for signing, with the supplied options.
+	BeaconBlockProposalV2(ctx context.Context, slot spec.Slot, randaoReveal spec.BLSSignature, graffiti []byte, opts ...BeaconBlockProposalOption) (*spec.BeaconBlock, error)
+}
+
+// BeaconStateWithMetadataProvider is the interface for providing beacon state with response metadata.
+type BeaconStateWithMetadataProvider interface {
+	// BeaconStateWithMetadata fetches a beacon state, along with the response metadata.
+	BeaconStateWithMetadata(ctx context.Context, stateID string) (*spec.BeaconState, *api.ResponseMetadata, error)
+}
+
+// BeaconStateWithRawProvider is the interface for providing beacon state with the raw response.
+type BeaconStateWithRawProvider interface {
+	// BeaconStateWithRaw fetches a beacon state, along with the raw response body.
+	BeaconStateWithRaw(ctx context.Context, stateID string) (*spec.BeaconState, []byte, error)
+}
+
+// BlockWithdrawalsProvider is the interface for providing the withdrawals in a block.
+type BlockWithdrawalsProvider interface {
+	// BlockWithdrawals provides the withdrawals in the execution payload of the given block.
+	// blockID can be a slot number or block root, or one of the special values "genesis", "head" or "finalized".
+	BlockWithdrawals(ctx context.Context, blockID string) ([]*capella.Withdrawal, error)
+}
+
+// ChainConfigProvider is the interface for providing the typed configuration of the chain.
+type ChainConfigProvider interface {
+	// ChainConfig provides the typed configuration of the chain.
+	ChainConfig(ctx context.Context) (*api.ChainConfig, error)
+}
+
+// ClockSyncChecker is the interface for checking the local clock against the node.
+type ClockSyncChecker interface {
+	// CheckClockSync provides the estimated skew between the local clock and the node's head slot.
+	// A positive value means that the local clock is ahead of the node.
+	CheckClockSync(ctx context.Context) (time.Duration, error)
+}
+
+// CommitteeAssignmentComputer is the interface for computing committee assignments from a beacon state.
+type CommitteeAssignmentComputer interface {
+	// ComputeCommitteeAssignment computes the beacon committee assignment of a validator for an epoch from a beacon state,
+	// without requesting duties from the node.
+	ComputeCommitteeAssignment(ctx context.Context, state *spec.BeaconState, epoch spec.Epoch, validatorIndex spec.ValidatorIndex) (*api.CommitteeAssignment, error)
+}
+
+// CommitteeCountProvider is the interface for providing the number of beacon committees in an epoch.
+type CommitteeCountProvider interface {
+	// CommitteeCount provides the number of beacon committees at the given epoch, across all of its slots.
+	CommitteeCount(ctx context.Context, stateID string, epoch spec.Epoch) (uint64, error)
+}
+
+// CommitteesPerSlotProvider is the interface for providing the number of beacon committees in each slot of an epoch.
+type CommitteesPerSlotProvider interface {
+	// CommitteesPerSlot provides the number of beacon committees in each slot of the given epoch, as defined by
+	// get_committee_count_per_slot in the specification.
+	CommitteesPerSlot(ctx context.Context, stateID string, epoch spec.Epoch) (uint64, error)
+}
+
 // DomainProvider provides a domain for a given domain type at an epoch.
 type DomainProvider interface {
 	// Domain provides a domain for a given domain type at a given epoch.
 	Domain(ctx context.Context, domainType spec.DomainType, epoch spec.Epoch) (spec.Domain, error)
 }
 
+// DutiesForEpochsProvider is the interface for providing duties across a range of epochs.
+type DutiesForEpochsProvider interface {
+	// DutiesForEpochs provides the proposer, attester and sync committee duties for the given validators for count
+	// epochs starting at startEpoch.
+	// Duties are returned for each epoch for which they could be obtained, along with an error listing the epochs
+	// for which they could not.
+	DutiesForEpochs(ctx context.Context, startEpoch spec.Epoch, count uint64, validatorIndices []spec.ValidatorIndex) ([]*api.EpochDuties, error)
+}
+
+// DutiesValidityProvider is the interface for checking the validity of previously obtained duties.
+type DutiesValidityProvider interface {
+	// DutiesDependentRoot provides the dependent root for attester duties at the given epoch.
+	DutiesDependentRoot(ctx context.Context, epoch spec.Epoch) (spec.Root, error)
+
+	// DutiesValid returns true if attester duties for the given epoch obtained with the previous dependent root remain valid.
+	DutiesValid(ctx context.Context, epoch spec.Epoch, previousDependentRoot spec.Root) (bool, error)
+}
+
+// ForkChoiceProvider is the interface for providing the node's fork choice.
+type ForkChoiceProvider interface {
+	// ForkChoice fetches the node's current fork choice context.
+	ForkChoice(ctx context.Context) (*api.ForkChoice, error)
+}
+
+// ForksProvider is the interface for providing the history of forks.
+type ForksProvider interface {
+	// Forks provides the complete history of forks known to the node, sorted by epoch.
+	Forks(ctx context.Context) ([]*spec.Fork, error)
+
+	// ForkAtEpoch provides the fork active at the given epoch.
+	ForkAtEpoch(ctx context.Context, epoch spec.Epoch) (*spec.Fork, error)
+}
+
 // GenesisTimeProvider is the interface for providing the genesis time of a chain.
 type GenesisTimeProvider interface {
 	// GenesisTime provides the genesis time of the chain.
 	GenesisTime(ctx context.Context) (time.Time, error)
 }
+
+// HeadProvider is the interface for providing a summary of the head of the chain.
+type HeadProvider interface {
+	// Head provides a summary of the current head of the chain.
+	Head(ctx context.Context) (*api.HeadSummary, error)
+}
+
+// HeadSlotProvider is the interface for providing the slot of the head of the chain.
+type HeadSlotProvider interface {
+	// HeadSlot provides the slot of the head of the chain.
+	HeadSlot(ctx context.Context) (spec.Slot, error)
+}
+
+// NetworkProvider is the interface for providing the name of the network.
+type NetworkProvider interface {
+	// Network provides the name of the network to which the node is connected, for example "mainnet" or "holesky".
+	Network(ctx context.Context) (string, error)
+}
+
+// ObjectSigner is the interface for signing objects with a Signer.
+type ObjectSigner interface {
+	// SignedAttestationData signs attestation data with the signer and provides the resultant attestation.
+	SignedAttestationData(ctx context.Context, signer Signer, pubKey spec.BLSPubKey, data *spec.AttestationData, aggregationBits bitfield.Bitlist) (*spec.Attestation, error)
+
+	// SignAggregateAndProof signs an aggregate and proof with the signer and provides the resultant signed aggregate and proof.
+	SignAggregateAndProof(ctx context.Context, signer Signer, pubKey spec.BLSPubKey, aggregateAndProof *spec.AggregateAndProof) (*spec.SignedAggregateAndProof, error)
+
+	// SignBeaconBlock signs a beacon block with the signer and provides the resultant signed beacon block.
+	SignBeaconBlock(ctx context.Context, signer Signer, pubKey spec.BLSPubKey, block *spec.BeaconBlock) (*spec.SignedBeaconBlock, error)
+
+	// SignVoluntaryExit signs a voluntary exit with the signer and provides the resultant signed voluntary exit.
+	SignVoluntaryExit(ctx context.Context, signer Signer, pubKey spec.BLSPubKey, voluntaryExit *spec.VoluntaryExit) (*spec.SignedVoluntaryExit, error)
+}
+
+// ProposerForSlotProvider is the interface for providing the proposer of a slot.
+type ProposerForSlotProvider interface {
+	// ProposerForSlot provides the index of the validator assigned to propose at the given slot.
+	ProposerForSlot(ctx context.Context, slot spec.Slot) (spec.ValidatorIndex, error)
+}
+
+// RANDAOProvider is the interface for providing RANDAO mixes.
+type RANDAOProvider interface {
+	// RANDAO provides the RANDAO mix for an epoch given a state ID.
+	// If epoch is nil the RANDAO mix for the epoch of the state is returned.
+	RANDAO(ctx context.Context, stateID string, epoch *spec.Epoch) (spec.Root, error)
+}
+
+// SelfTester is the interface for carrying out a diagnostic self-test against the node.
+type SelfTester interface {
+	// SelfTest exercises a representative set of endpoints on the node and reports the outcome of each.
+	SelfTest(ctx context.Context) (*api.SelfTestReport, error)
+}
+
+// Signer is the interface for signing data, for example with a remote signer.
+type Signer interface {
+	// Sign signs the signing root with the private key for the given public key.
+	Sign(ctx context.Context, pubKey spec.BLSPubKey, signingRoot spec.Root) (spec.BLSSignature, error)
+}
+
+// SignedBeaconBlockWithMetadataProvider is the interface for providing beacon blocks with response metadata.
+type SignedBeaconBlockWithMetadataProvider interface {
+	// SignedBeaconBlockWithMetadata fetches a signed beacon block given a block ID, along with the response metadata.
+	SignedBeaconBlockWithMetadata(ctx context.Context, blockID string) (*spec.SignedBeaconBlock, *api.ResponseMetadata, error)
+}
+
+// SignedBeaconBlockWithRawProvider is the interface for providing signed beacon blocks with the raw response.
+type SignedBeaconBlockWithRawProvider interface {
+	// SignedBeaconBlockWithRaw fetches a signed beacon block given a block ID, along with the raw response body.
+	SignedBeaconBlockWithRaw(ctx context.Context, blockID string) (*spec.SignedBeaconBlock, []byte, error)
+}
+
+// SlotTickerProvider is the interface for providing a per-slot tick.
+type SlotTickerProvider interface {
+	// SlotTicker provides a channel that receives the current slot at the start of each slot.  The channel is
+	// closed when the context is done.
+	SlotTicker(ctx context.Context) (<-chan spec.Slot, error)
+}
+
+// SlotWaiter is the interface for waiting for a slot or epoch to start.
+type SlotWaiter interface {
+	// WaitForSlot blocks until the start of the given slot or the context is done.
+	WaitForSlot(ctx context.Context, slot spec.Slot) error
+
+	// WaitForEpoch blocks until the start of the given epoch or the context is done.
+	WaitForEpoch(ctx context.Context, epoch spec.Epoch) error
+}
+
+// StateRootVerifier is the interface for verifying that a node's state matches its blocks.
+type StateRootVerifier interface {
+	// VerifyStateRoot confirms that the state root in the given block matches the root of the state at the block's
+	// slot.  If they differ this returns false along with a StateRootMismatchError.
+	// Only blocks on the canonical chain can be verified; for other blocks this returns an error that wraps
+	// ErrNotCanonical.
+	VerifyStateRoot(ctx context.Context, blockID string) (bool, error)
+}
+
+// StreamErrorsProvider is the interface for providing errors from abandoned event streams.
+type StreamErrorsProvider interface {
+	// StreamErrors provides a channel that receives an error each time an event stream is abandoned.
+	StreamErrors() <-chan error
+}
+
+// SupportedEventTopicsProvider is the interface for providing the event topics supported by the node.
+type SupportedEventTopicsProvider interface {
+	// SupportedEventTopics provides the event topics supported by the node.
+	SupportedEventTopics(ctx context.Context) ([]string, error)
+}
+
+// SyncWaiter is the interface for waiting for a node to sync.
+type SyncWaiter interface {
+	// WaitForSync blocks until the node is synced or the context is done, checking every poll interval.
+	WaitForSync(ctx context.Context, pollInterval time.Duration) error
+}
+
+// ValidatorBalancesAtSlotsProvider is the interface for providing validator balances across a number of slots.
+type ValidatorBalancesAtSlotsProvider interface {
+	// ValidatorBalancesAtSlots provides the balances of the given validators at each of the given slots.
+	// Balances are returned for each slot for which they could be obtained, along with an error listing the slots
+	// for which they could not.
+	ValidatorBalancesAtSlots(ctx context.Context, slots []spec.Slot, validatorIndices []spec.ValidatorIndex) (map[spec.Slot]map[spec.ValidatorIndex]spec.Gwei, error)
+}
+
+// ValidatorEffectiveBalanceProvider is the interface for providing validator effective balances.
+type ValidatorEffectiveBalanceProvider interface {
+	// ValidatorEffectiveBalance provides the validator effective balances for a given state.
+	// stateID can be a slot number or state root, or one of the special values "genesis", "head", "justified" or "finalized".
+	// validatorIndices is a list of validator indices to restrict the returned values.  If no validators are supplied no filter
+	// will be applied.
+	ValidatorEffectiveBalance(ctx context.Context, stateID string, validatorIndices []spec.ValidatorIndex) (map[spec.ValidatorIndex]spec.Gwei, error)
+}
+
+// ValidatorIndicesResolver is the interface for resolving validator public keys to indices.
+type ValidatorIndicesResolver interface {
+	// ResolveValidatorIndices resolves validator public keys to indices for a given state.
+	// stateID can be a slot number or state root, or one of the special values "genesis", "head", "justified" or "finalized".
+	// If any of the public keys are unknown the indices of the known validators are returned along with an error
+	// that wraps UnknownValidatorsError.
+	ResolveValidatorIndices(ctx context.Context, stateID string, pubKeys []spec.BLSPubKey) (map[spec.BLSPubKey]spec.ValidatorIndex, error)
+}
+
+// ValidatorProvider is the interface for providing information about a single validator.
+type ValidatorProvider interface {
+	// Validator provides a single validator, with its balance and status, for a given state.
+	// stateID can be a slot number or state root, or one of the special values "genesis", "head", "justified" or "finalized".
+	// validatorID is either the decimal index of the validator or its 0x-prefixed public key.
+	Validator(ctx context.Context, stateID string, validatorID string) (*api.Validator, error)
+}
+
+// ValidatorWithdrawalCredentialsProvider is the interface for providing validator withdrawal credentials.
+type ValidatorWithdrawalCredentialsProvider interface {
+	// ValidatorWithdrawalCredentials provides the validator withdrawal credentials for a given state.
+	// stateID can be a slot number or state root, or one of the special values "genesis", "head", "justified" or "finalized".
+	// validatorIndices is a list of validator indices to restrict the returned values.  If no validators are supplied no filter
+	// will be applied.
+	ValidatorWithdrawalCredentials(ctx context.Context, stateID string, validatorIndices []spec.ValidatorIndex) (map[spec.ValidatorIndex][]byte, error)
+}
+
+// ValidatorsAtEpochProvider is the interface for providing validator information at the start of an epoch.
+type ValidatorsAtEpochProvider interface {
+	// ValidatorsAtEpoch provides the validators, with their balance and status, for the state at the first slot of the given epoch.
+	// validatorIndices is a list of validator indices to restrict the returned values.  If no validators are supplied no filter
+	// will be applied.
+	ValidatorsAtEpoch(ctx context.Context, epoch spec.Epoch, validatorIndices []spec.ValidatorIndex) (map[spec.ValidatorIndex]*api.Validator, error)
+}
+
+// ValidatorsWithFieldsProvider is the interface for providing selected validator information.
+type ValidatorsWithFieldsProvider interface {
+	// ValidatorsWithFields provides the validators for a given state, populating only the requested fields.
+	// stateID can be a slot number or state root, or one of the special values "genesis", "head", "justified" or "finalized".
+	// validatorIndices is a list of validator indices to restrict the returned values.  If no validators are supplied no filter
+	// will be applied.
+	// fields is a list of the fields to populate, from "balance", "status" and "validator".  The index is always populated.
+	ValidatorsWithFields(ctx context.Context, stateID string, validatorIndices []spec.ValidatorIndex, fields []string) (map[spec.ValidatorIndex]*api.Validator, error)
+}
+
+// ValidatorsWithStatusesProvider is the interface for providing validator information filtered by status.
+type ValidatorsWithStatusesProvider interface {
+	// ValidatorsWithStatuses provides the validators, with their balance and status, for a given state, restricted to
+	// those with the given statuses.
+	// stateID can be a slot number or state root, or one of the special values "genesis", "head", "justified" or "finalized".
+	// validatorIndices is a list of validator indices to restrict the returned values.  If no validators are supplied no filter
+	// will be applied.
+	// statuses is a list of statuses to restrict the returned values.  These can be individual states such as "active_ongoing"
+	// or the general states "pending", "active", "exited" and "withdrawal".  If no statuses are supplied no filter will be applied.
+	ValidatorsWithStatuses(ctx context.Context, stateID string, validatorIndices []spec.ValidatorIndex, statuses []string) (map[spec.ValidatorIndex]*api.Validator, error)
+}
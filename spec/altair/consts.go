@@ -0,0 +1,29 @@
+// Copyright © 2020 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package altair
+
+// SyncCommitteeContributionAggregationBitsLength is the number of bytes in the aggregation bits of a sync committee contribution.
+const SyncCommitteeContributionAggregationBitsLength = 16
+
+// SyncCommitteeBitsLength is the number of bytes in the sync committee bits of a sync aggregate.
+const SyncCommitteeBitsLength = 64
+
+// FinalityBranchLength is the number of roots in the finality branch of a light client update.
+const FinalityBranchLength = 6
+
+// SyncCommitteeSize is the number of validators in a sync committee.
+const SyncCommitteeSize = 512
+
+// SyncCommitteeBranchLength is the number of roots in the sync committee branch of a light client update.
+const SyncCommitteeBranchLength = 5
@@ -0,0 +1,126 @@
+// Copyright © 2020 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package altair
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/goccy/go-yaml"
+	"github.com/pkg/errors"
+)
+
+// ContributionAndProof is the Ethereum 2 contribution and proof structure.
+type ContributionAndProof struct {
+	AggregatorIndex phase0.ValidatorIndex
+	Contribution    *SyncCommitteeContribution
+	SelectionProof  phase0.BLSSignature `ssz-size:"96"`
+}
+
+// contributionAndProofJSON is the spec representation of the struct.
+type contributionAndProofJSON struct {
+	AggregatorIndex string                     `json:"aggregator_index"`
+	Contribution    *SyncCommitteeContribution `json:"contribution"`
+	SelectionProof  string                     `json:"selection_proof"`
+}
+
+// contributionAndProofYAML is the spec representation of the struct.
+type contributionAndProofYAML struct {
+	AggregatorIndex uint64                     `yaml:"aggregator_index"`
+	Contribution    *SyncCommitteeContribution `yaml:"contribution"`
+	SelectionProof  string                     `yaml:"selection_proof"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (c *ContributionAndProof) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&contributionAndProofJSON{
+		AggregatorIndex: fmt.Sprintf("%d", c.AggregatorIndex),
+		Contribution:    c.Contribution,
+		SelectionProof:  fmt.Sprintf("%#x", c.SelectionProof),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (c *ContributionAndProof) UnmarshalJSON(input []byte) error {
+	var contributionAndProofJSON contributionAndProofJSON
+	if err := json.Unmarshal(input, &contributionAndProofJSON); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+	return c.unpack(&contributionAndProofJSON)
+}
+
+func (c *ContributionAndProof) unpack(contributionAndProofJSON *contributionAndProofJSON) error {
+	if contributionAndProofJSON.AggregatorIndex == "" {
+		return errors.New("aggregator index missing")
+	}
+	aggregatorIndex, err := strconv.ParseUint(contributionAndProofJSON.AggregatorIndex, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for aggregator index")
+	}
+	c.AggregatorIndex = phase0.ValidatorIndex(aggregatorIndex)
+	if contributionAndProofJSON.Contribution == nil {
+		return errors.New("contribution missing")
+	}
+	c.Contribution = contributionAndProofJSON.Contribution
+	if contributionAndProofJSON.SelectionProof == "" {
+		return errors.New("selection proof missing")
+	}
+	selectionProof, err := hex.DecodeString(strings.TrimPrefix(contributionAndProofJSON.SelectionProof, "0x"))
+	if err != nil {
+		return errors.Wrap(err, "invalid value for selection proof")
+	}
+	if len(selectionProof) != phase0.SignatureLength {
+		return errors.New("incorrect length for selection proof")
+	}
+	copy(c.SelectionProof[:], selectionProof)
+
+	return nil
+}
+
+// MarshalYAML implements yaml.Marshaler.
+func (c *ContributionAndProof) MarshalYAML() ([]byte, error) {
+	yamlBytes, err := yaml.MarshalWithOptions(&contributionAndProofYAML{
+		AggregatorIndex: uint64(c.AggregatorIndex),
+		Contribution:    c.Contribution,
+		SelectionProof:  fmt.Sprintf("%#x", c.SelectionProof),
+	}, yaml.Flow(true))
+	if err != nil {
+		return nil, err
+	}
+	return bytes.ReplaceAll(yamlBytes, []byte(`"`), []byte(`'`)), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (c *ContributionAndProof) UnmarshalYAML(input []byte) error {
+	// We unmarshal to the JSON struct to save on duplicate code.
+	var contributionAndProofJSON contributionAndProofJSON
+	if err := yaml.Unmarshal(input, &contributionAndProofJSON); err != nil {
+		return err
+	}
+	return c.unpack(&contributionAndProofJSON)
+}
+
+// String returns a string version of the structure.
+func (c *ContributionAndProof) String() string {
+	data, err := yaml.Marshal(c)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	return string(data)
+}
@@ -0,0 +1,88 @@
+// Code generated by fastssz. DO NOT EDIT.
+package altair
+
+import (
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// MarshalSSZ ssz marshals the ContributionAndProof object
+func (c *ContributionAndProof) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(c)
+}
+
+// MarshalSSZTo ssz marshals the ContributionAndProof object to a target array
+func (c *ContributionAndProof) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'AggregatorIndex'
+	dst = ssz.MarshalUint64(dst, uint64(c.AggregatorIndex))
+
+	// Field (1) 'Contribution'
+	if c.Contribution == nil {
+		c.Contribution = new(SyncCommitteeContribution)
+	}
+	if dst, err = c.Contribution.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (2) 'SelectionProof'
+	dst = append(dst, c.SelectionProof[:]...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the ContributionAndProof object
+func (c *ContributionAndProof) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 264 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'AggregatorIndex'
+	c.AggregatorIndex = phase0.ValidatorIndex(ssz.UnmarshallUint64(buf[0:8]))
+
+	// Field (1) 'Contribution'
+	if c.Contribution == nil {
+		c.Contribution = new(SyncCommitteeContribution)
+	}
+	if err = c.Contribution.UnmarshalSSZ(buf[8:168]); err != nil {
+		return err
+	}
+
+	// Field (2) 'SelectionProof'
+	copy(c.SelectionProof[:], buf[168:264])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the ContributionAndProof object
+func (c *ContributionAndProof) SizeSSZ() (size int) {
+	size = 264
+	return
+}
+
+// HashTreeRoot ssz hashes the ContributionAndProof object
+func (c *ContributionAndProof) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(c)
+}
+
+// HashTreeRootWith ssz hashes the ContributionAndProof object with a hasher
+func (c *ContributionAndProof) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'AggregatorIndex'
+	hh.PutUint64(uint64(c.AggregatorIndex))
+
+	// Field (1) 'Contribution'
+	if err = c.Contribution.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (2) 'SelectionProof'
+	hh.PutBytes(c.SelectionProof[:])
+
+	hh.Merkleize(indx)
+	return
+}
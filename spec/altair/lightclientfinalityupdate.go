@@ -0,0 +1,163 @@
+// Copyright © 2020 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package altair
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/goccy/go-yaml"
+	"github.com/pkg/errors"
+)
+
+// LightClientFinalityUpdate is the Ethereum 2 light client finality update structure.
+type LightClientFinalityUpdate struct {
+	AttestedHeader  *LightClientHeader
+	FinalizedHeader *LightClientHeader
+	FinalityBranch  [][]byte `ssz-size:"6,32"`
+	SyncAggregate   *SyncAggregate
+	SignatureSlot   phase0.Slot
+}
+
+// lightClientFinalityUpdateJSON is the spec representation of the struct.
+type lightClientFinalityUpdateJSON struct {
+	AttestedHeader  *LightClientHeader `json:"attested_header"`
+	FinalizedHeader *LightClientHeader `json:"finalized_header"`
+	FinalityBranch  []string           `json:"finality_branch"`
+	SyncAggregate   *SyncAggregate     `json:"sync_aggregate"`
+	SignatureSlot   string             `json:"signature_slot"`
+}
+
+// lightClientFinalityUpdateYAML is the spec representation of the struct.
+type lightClientFinalityUpdateYAML struct {
+	AttestedHeader  *LightClientHeader `yaml:"attested_header"`
+	FinalizedHeader *LightClientHeader `yaml:"finalized_header"`
+	FinalityBranch  []string           `yaml:"finality_branch"`
+	SyncAggregate   *SyncAggregate     `yaml:"sync_aggregate"`
+	SignatureSlot   uint64             `yaml:"signature_slot"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (l *LightClientFinalityUpdate) MarshalJSON() ([]byte, error) {
+	finalityBranch := make([]string, len(l.FinalityBranch))
+	for i := range l.FinalityBranch {
+		finalityBranch[i] = fmt.Sprintf("%#x", l.FinalityBranch[i])
+	}
+
+	return json.Marshal(&lightClientFinalityUpdateJSON{
+		AttestedHeader:  l.AttestedHeader,
+		FinalizedHeader: l.FinalizedHeader,
+		FinalityBranch:  finalityBranch,
+		SyncAggregate:   l.SyncAggregate,
+		SignatureSlot:   fmt.Sprintf("%d", l.SignatureSlot),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (l *LightClientFinalityUpdate) UnmarshalJSON(input []byte) error {
+	var lightClientFinalityUpdateJSON lightClientFinalityUpdateJSON
+	if err := json.Unmarshal(input, &lightClientFinalityUpdateJSON); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+	return l.unpack(&lightClientFinalityUpdateJSON)
+}
+
+func (l *LightClientFinalityUpdate) unpack(lightClientFinalityUpdateJSON *lightClientFinalityUpdateJSON) error {
+	if lightClientFinalityUpdateJSON.AttestedHeader == nil {
+		return errors.New("attested header missing")
+	}
+	l.AttestedHeader = lightClientFinalityUpdateJSON.AttestedHeader
+	if lightClientFinalityUpdateJSON.FinalizedHeader == nil {
+		return errors.New("finalized header missing")
+	}
+	l.FinalizedHeader = lightClientFinalityUpdateJSON.FinalizedHeader
+	if lightClientFinalityUpdateJSON.FinalityBranch == nil {
+		return errors.New("finality branch missing")
+	}
+	if len(lightClientFinalityUpdateJSON.FinalityBranch) != FinalityBranchLength {
+		return errors.New("incorrect length for finality branch")
+	}
+	l.FinalityBranch = make([][]byte, len(lightClientFinalityUpdateJSON.FinalityBranch))
+	for i := range lightClientFinalityUpdateJSON.FinalityBranch {
+		if lightClientFinalityUpdateJSON.FinalityBranch[i] == "" {
+			return errors.New("finality branch component missing")
+		}
+		finalityBranch, err := hex.DecodeString(strings.TrimPrefix(lightClientFinalityUpdateJSON.FinalityBranch[i], "0x"))
+		if err != nil {
+			return errors.Wrap(err, "invalid value for finality branch")
+		}
+		if len(finalityBranch) != phase0.RootLength {
+			return errors.New("incorrect length for finality branch component")
+		}
+		l.FinalityBranch[i] = finalityBranch
+	}
+	if lightClientFinalityUpdateJSON.SyncAggregate == nil {
+		return errors.New("sync aggregate missing")
+	}
+	l.SyncAggregate = lightClientFinalityUpdateJSON.SyncAggregate
+	if lightClientFinalityUpdateJSON.SignatureSlot == "" {
+		return errors.New("signature slot missing")
+	}
+	signatureSlot, err := strconv.ParseUint(lightClientFinalityUpdateJSON.SignatureSlot, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for signature slot")
+	}
+	l.SignatureSlot = phase0.Slot(signatureSlot)
+
+	return nil
+}
+
+// MarshalYAML implements yaml.Marshaler.
+func (l *LightClientFinalityUpdate) MarshalYAML() ([]byte, error) {
+	finalityBranch := make([]string, len(l.FinalityBranch))
+	for i := range l.FinalityBranch {
+		finalityBranch[i] = fmt.Sprintf("%#x", l.FinalityBranch[i])
+	}
+
+	yamlBytes, err := yaml.MarshalWithOptions(&lightClientFinalityUpdateYAML{
+		AttestedHeader:  l.AttestedHeader,
+		FinalizedHeader: l.FinalizedHeader,
+		FinalityBranch:  finalityBranch,
+		SyncAggregate:   l.SyncAggregate,
+		SignatureSlot:   uint64(l.SignatureSlot),
+	}, yaml.Flow(true))
+	if err != nil {
+		return nil, err
+	}
+	return bytes.ReplaceAll(yamlBytes, []byte(`"`), []byte(`'`)), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (l *LightClientFinalityUpdate) UnmarshalYAML(input []byte) error {
+	// We unmarshal to the JSON struct to save on duplicate code.
+	var lightClientFinalityUpdateJSON lightClientFinalityUpdateJSON
+	if err := yaml.Unmarshal(input, &lightClientFinalityUpdateJSON); err != nil {
+		return err
+	}
+	return l.unpack(&lightClientFinalityUpdateJSON)
+}
+
+// String returns a string version of the structure.
+func (l *LightClientFinalityUpdate) String() string {
+	data, err := yaml.Marshal(l)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	return string(data)
+}
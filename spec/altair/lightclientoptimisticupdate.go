@@ -0,0 +1,117 @@
+// Copyright © 2020 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package altair
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/goccy/go-yaml"
+	"github.com/pkg/errors"
+)
+
+// LightClientOptimisticUpdate is the Ethereum 2 light client optimistic update structure.
+type LightClientOptimisticUpdate struct {
+	AttestedHeader *LightClientHeader
+	SyncAggregate  *SyncAggregate
+	SignatureSlot  phase0.Slot
+}
+
+// lightClientOptimisticUpdateJSON is the spec representation of the struct.
+type lightClientOptimisticUpdateJSON struct {
+	AttestedHeader *LightClientHeader `json:"attested_header"`
+	SyncAggregate  *SyncAggregate     `json:"sync_aggregate"`
+	SignatureSlot  string             `json:"signature_slot"`
+}
+
+// lightClientOptimisticUpdateYAML is the spec representation of the struct.
+type lightClientOptimisticUpdateYAML struct {
+	AttestedHeader *LightClientHeader `yaml:"attested_header"`
+	SyncAggregate  *SyncAggregate     `yaml:"sync_aggregate"`
+	SignatureSlot  uint64             `yaml:"signature_slot"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (l *LightClientOptimisticUpdate) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&lightClientOptimisticUpdateJSON{
+		AttestedHeader: l.AttestedHeader,
+		SyncAggregate:  l.SyncAggregate,
+		SignatureSlot:  fmt.Sprintf("%d", l.SignatureSlot),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (l *LightClientOptimisticUpdate) UnmarshalJSON(input []byte) error {
+	var lightClientOptimisticUpdateJSON lightClientOptimisticUpdateJSON
+	if err := json.Unmarshal(input, &lightClientOptimisticUpdateJSON); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+	return l.unpack(&lightClientOptimisticUpdateJSON)
+}
+
+func (l *LightClientOptimisticUpdate) unpack(lightClientOptimisticUpdateJSON *lightClientOptimisticUpdateJSON) error {
+	if lightClientOptimisticUpdateJSON.AttestedHeader == nil {
+		return errors.New("attested header missing")
+	}
+	l.AttestedHeader = lightClientOptimisticUpdateJSON.AttestedHeader
+	if lightClientOptimisticUpdateJSON.SyncAggregate == nil {
+		return errors.New("sync aggregate missing")
+	}
+	l.SyncAggregate = lightClientOptimisticUpdateJSON.SyncAggregate
+	if lightClientOptimisticUpdateJSON.SignatureSlot == "" {
+		return errors.New("signature slot missing")
+	}
+	signatureSlot, err := strconv.ParseUint(lightClientOptimisticUpdateJSON.SignatureSlot, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for signature slot")
+	}
+	l.SignatureSlot = phase0.Slot(signatureSlot)
+
+	return nil
+}
+
+// MarshalYAML implements yaml.Marshaler.
+func (l *LightClientOptimisticUpdate) MarshalYAML() ([]byte, error) {
+	yamlBytes, err := yaml.MarshalWithOptions(&lightClientOptimisticUpdateYAML{
+		AttestedHeader: l.AttestedHeader,
+		SyncAggregate:  l.SyncAggregate,
+		SignatureSlot:  uint64(l.SignatureSlot),
+	}, yaml.Flow(true))
+	if err != nil {
+		return nil, err
+	}
+	return bytes.ReplaceAll(yamlBytes, []byte(`"`), []byte(`'`)), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (l *LightClientOptimisticUpdate) UnmarshalYAML(input []byte) error {
+	// We unmarshal to the JSON struct to save on duplicate code.
+	var lightClientOptimisticUpdateJSON lightClientOptimisticUpdateJSON
+	if err := yaml.Unmarshal(input, &lightClientOptimisticUpdateJSON); err != nil {
+		return err
+	}
+	return l.unpack(&lightClientOptimisticUpdateJSON)
+}
+
+// String returns a string version of the structure.
+func (l *LightClientOptimisticUpdate) String() string {
+	data, err := yaml.Marshal(l)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	return string(data)
+}
@@ -0,0 +1,78 @@
+// Code generated by fastssz. DO NOT EDIT.
+package altair
+
+import (
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// MarshalSSZ ssz marshals the SignedContributionAndProof object
+func (s *SignedContributionAndProof) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the SignedContributionAndProof object to a target array
+func (s *SignedContributionAndProof) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Message'
+	if s.Message == nil {
+		s.Message = new(ContributionAndProof)
+	}
+	if dst, err = s.Message.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'Signature'
+	dst = append(dst, s.Signature[:]...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the SignedContributionAndProof object
+func (s *SignedContributionAndProof) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 360 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Message'
+	if s.Message == nil {
+		s.Message = new(ContributionAndProof)
+	}
+	if err = s.Message.UnmarshalSSZ(buf[0:264]); err != nil {
+		return err
+	}
+
+	// Field (1) 'Signature'
+	copy(s.Signature[:], buf[264:360])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the SignedContributionAndProof object
+func (s *SignedContributionAndProof) SizeSSZ() (size int) {
+	size = 360
+	return
+}
+
+// HashTreeRoot ssz hashes the SignedContributionAndProof object
+func (s *SignedContributionAndProof) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the SignedContributionAndProof object with a hasher
+func (s *SignedContributionAndProof) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Message'
+	if err = s.Message.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'Signature'
+	hh.PutBytes(s.Signature[:])
+
+	hh.Merkleize(indx)
+	return
+}
@@ -0,0 +1,77 @@
+// Code generated by fastssz. DO NOT EDIT.
+package altair
+
+import (
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// MarshalSSZ ssz marshals the SyncAggregate object
+func (s *SyncAggregate) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the SyncAggregate object to a target array
+func (s *SyncAggregate) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'SyncCommitteeBits'
+	if len(s.SyncCommitteeBits) != 64 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	dst = append(dst, s.SyncCommitteeBits...)
+
+	// Field (1) 'SyncCommitteeSignature'
+	dst = append(dst, s.SyncCommitteeSignature[:]...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the SyncAggregate object
+func (s *SyncAggregate) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 160 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'SyncCommitteeBits'
+	if cap(s.SyncCommitteeBits) == 0 {
+		s.SyncCommitteeBits = make([]byte, 0, len(buf[0:64]))
+	}
+	s.SyncCommitteeBits = append(s.SyncCommitteeBits, buf[0:64]...)
+
+	// Field (1) 'SyncCommitteeSignature'
+	copy(s.SyncCommitteeSignature[:], buf[64:160])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the SyncAggregate object
+func (s *SyncAggregate) SizeSSZ() (size int) {
+	size = 160
+	return
+}
+
+// HashTreeRoot ssz hashes the SyncAggregate object
+func (s *SyncAggregate) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the SyncAggregate object with a hasher
+func (s *SyncAggregate) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'SyncCommitteeBits'
+	if len(s.SyncCommitteeBits) != 64 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	hh.PutBytes(s.SyncCommitteeBits)
+
+	// Field (1) 'SyncCommitteeSignature'
+	hh.PutBytes(s.SyncCommitteeSignature[:])
+
+	hh.Merkleize(indx)
+	return
+}
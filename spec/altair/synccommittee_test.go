@@ -0,0 +1,199 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package altair_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/goccy/go-yaml"
+	require "github.com/stretchr/testify/require"
+	"gotest.tools/assert"
+)
+
+// pubkeysJSON returns a JSON list of count public keys.
+func pubkeysJSON(count int) string {
+	pubkeys := make([]string, count)
+	for i := range pubkeys {
+		pubkeys[i] = fmt.Sprintf(`"0x%096x"`, i)
+	}
+	return fmt.Sprintf("[%s]", strings.Join(pubkeys, ","))
+}
+
+func TestSyncCommitteeJSON(t *testing.T) {
+	aggregatePubkey := `"0xa9a30b6d2fc6a2f5f0c3ddf3ec2e7e1b2c26c8b5fc5e09c0c6b2f36f1c36e5d1f1c5bc1a3f5ce1b29a5d0a5bd2fc1aa4"`
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte("[]"),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type altair.syncCommitteeJSON",
+		},
+		{
+			name:  "PubkeysMissing",
+			input: []byte(fmt.Sprintf(`{"aggregate_pubkey":%s}`, aggregatePubkey)),
+			err:   "pubkeys missing",
+		},
+		{
+			name:  "PubkeysWrongType",
+			input: []byte(fmt.Sprintf(`{"pubkeys":true,"aggregate_pubkey":%s}`, aggregatePubkey)),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field syncCommitteeJSON.pubkeys of type []string",
+		},
+		{
+			name:  "PubkeysShort",
+			input: []byte(fmt.Sprintf(`{"pubkeys":%s,"aggregate_pubkey":%s}`, pubkeysJSON(511), aggregatePubkey)),
+			err:   "incorrect length for pubkeys",
+		},
+		{
+			name:  "PubkeysLong",
+			input: []byte(fmt.Sprintf(`{"pubkeys":%s,"aggregate_pubkey":%s}`, pubkeysJSON(513), aggregatePubkey)),
+			err:   "incorrect length for pubkeys",
+		},
+		{
+			name:  "PubkeyInvalid",
+			input: []byte(fmt.Sprintf(`{"pubkeys":%s,"aggregate_pubkey":%s}`, strings.Replace(pubkeysJSON(512), "0x", "invalid", 1), aggregatePubkey)),
+			err:   "invalid value for pubkey: encoding/hex: invalid byte: U+0069 'i'",
+		},
+		{
+			name:  "PubkeyShort",
+			input: []byte(fmt.Sprintf(`{"pubkeys":%s,"aggregate_pubkey":%s}`, strings.Replace(pubkeysJSON(512), "0x00", "0x", 1), aggregatePubkey)),
+			err:   "incorrect length for pubkey",
+		},
+		{
+			name:  "AggregatePubkeyMissing",
+			input: []byte(fmt.Sprintf(`{"pubkeys":%s}`, pubkeysJSON(512))),
+			err:   "aggregate pubkey missing",
+		},
+		{
+			name:  "AggregatePubkeyWrongType",
+			input: []byte(fmt.Sprintf(`{"pubkeys":%s,"aggregate_pubkey":true}`, pubkeysJSON(512))),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field syncCommitteeJSON.aggregate_pubkey of type string",
+		},
+		{
+			name:  "AggregatePubkeyInvalid",
+			input: []byte(fmt.Sprintf(`{"pubkeys":%s,"aggregate_pubkey":"invalid"}`, pubkeysJSON(512))),
+			err:   "invalid value for aggregate pubkey: encoding/hex: invalid byte: U+0069 'i'",
+		},
+		{
+			name:  "AggregatePubkeyShort",
+			input: []byte(fmt.Sprintf(`{"pubkeys":%s,"aggregate_pubkey":"0xa30b6d2fc6a2f5f0c3ddf3ec2e7e1b2c26c8b5fc5e09c0c6b2f36f1c36e5d1f1c5bc1a3f5ce1b29a5d0a5bd2fc1aa4"}`, pubkeysJSON(512))),
+			err:   "incorrect length for aggregate pubkey",
+		},
+		{
+			name:  "AggregatePubkeyLong",
+			input: []byte(fmt.Sprintf(`{"pubkeys":%s,"aggregate_pubkey":"0xa9a9a30b6d2fc6a2f5f0c3ddf3ec2e7e1b2c26c8b5fc5e09c0c6b2f36f1c36e5d1f1c5bc1a3f5ce1b29a5d0a5bd2fc1aa4"}`, pubkeysJSON(512))),
+			err:   "incorrect length for aggregate pubkey",
+		},
+		{
+			name:  "Good",
+			input: []byte(fmt.Sprintf(`{"pubkeys":%s,"aggregate_pubkey":%s}`, pubkeysJSON(512), aggregatePubkey)),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res altair.SyncCommittee
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+			}
+		})
+	}
+}
+
+func TestSyncCommitteeYAML(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		root  []byte
+		err   string
+	}{
+		{
+			name:  "Good",
+			input: []byte(fmt.Sprintf(`{pubkeys: %s, aggregate_pubkey: '0xa9a30b6d2fc6a2f5f0c3ddf3ec2e7e1b2c26c8b5fc5e09c0c6b2f36f1c36e5d1f1c5bc1a3f5ce1b29a5d0a5bd2fc1aa4'}`, strings.ReplaceAll(strings.ReplaceAll(pubkeysJSON(512), `"`, `'`), ",", ", "))),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res altair.SyncCommittee
+			err := yaml.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := yaml.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(rt), res.String())
+				rt = bytes.TrimSuffix(rt, []byte("\n"))
+				assert.Equal(t, string(test.input), string(rt))
+			}
+		})
+	}
+}
+
+func TestSyncCommitteeSpec(t *testing.T) {
+	if os.Getenv("ETH2_SPEC_TESTS_DIR") == "" {
+		t.Skip("ETH2_SPEC_TESTS_DIR not suppplied, not running spec tests")
+	}
+	baseDir := filepath.Join(os.Getenv("ETH2_SPEC_TESTS_DIR"), "tests", "mainnet", "altair", "ssz_static", "SyncCommittee", "ssz_random")
+	require.NoError(t, filepath.Walk(baseDir, func(path string, info os.FileInfo, err error) error {
+		if path == baseDir {
+			// Only interested in subdirectories.
+			return nil
+		}
+		require.NoError(t, err)
+		if info.IsDir() {
+			t.Run(info.Name(), func(t *testing.T) {
+				specYAML, err := ioutil.ReadFile(filepath.Join(path, "value.yaml"))
+				require.NoError(t, err)
+				var res altair.SyncCommittee
+				require.NoError(t, yaml.Unmarshal(specYAML, &res))
+
+				specSSZ, err := ioutil.ReadFile(filepath.Join(path, "serialized.ssz"))
+				require.NoError(t, err)
+
+				ssz, err := res.MarshalSSZ()
+				require.NoError(t, err)
+				require.Equal(t, specSSZ, ssz)
+
+				root, err := res.HashTreeRoot()
+				require.NoError(t, err)
+				rootsYAML, err := ioutil.ReadFile(filepath.Join(path, "roots.yaml"))
+				require.NoError(t, err)
+				require.Equal(t, string(rootsYAML), fmt.Sprintf("{root: '%#x'}\n", root))
+			})
+		}
+		return nil
+	}))
+}
@@ -0,0 +1,238 @@
+// Copyright © 2020 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package altair_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/goccy/go-yaml"
+	require "github.com/stretchr/testify/require"
+	"gotest.tools/assert"
+)
+
+func TestSyncCommitteeContributionJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte(`[]`),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type altair.syncCommitteeContributionJSON",
+		},
+		{
+			name:  "SlotMissing",
+			input: []byte(`{"beacon_block_root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","subcommittee_index":"2","aggregation_bits":"0xffffffffffffffffffffffffffffff7f","signature":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f"}`),
+			err:   "slot missing",
+		},
+		{
+			name:  "SlotWrongType",
+			input: []byte(`{"slot":true,"beacon_block_root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","subcommittee_index":"2","aggregation_bits":"0xffffffffffffffffffffffffffffff7f","signature":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f"}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field syncCommitteeContributionJSON.slot of type string",
+		},
+		{
+			name:  "SlotInvalid",
+			input: []byte(`{"slot":"-1","beacon_block_root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","subcommittee_index":"2","aggregation_bits":"0xffffffffffffffffffffffffffffff7f","signature":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f"}`),
+			err:   "invalid value for slot: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "BeaconBlockRootMissing",
+			input: []byte(`{"slot":"1","subcommittee_index":"2","aggregation_bits":"0xffffffffffffffffffffffffffffff7f","signature":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f"}`),
+			err:   "beacon block root missing",
+		},
+		{
+			name:  "BeaconBlockRootWrongType",
+			input: []byte(`{"slot":"1","beacon_block_root":true,"subcommittee_index":"2","aggregation_bits":"0xffffffffffffffffffffffffffffff7f","signature":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f"}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field syncCommitteeContributionJSON.beacon_block_root of type string",
+		},
+		{
+			name:  "BeaconBlockRootInvalid",
+			input: []byte(`{"slot":"1","beacon_block_root":"invalid","subcommittee_index":"2","aggregation_bits":"0xffffffffffffffffffffffffffffff7f","signature":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f"}`),
+			err:   "invalid value for beacon block root: encoding/hex: invalid byte: U+0069 'i'",
+		},
+		{
+			name:  "BeaconBlockRootShort",
+			input: []byte(`{"slot":"1","beacon_block_root":"0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","subcommittee_index":"2","aggregation_bits":"0xffffffffffffffffffffffffffffff7f","signature":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f"}`),
+			err:   "incorrect length for beacon block root",
+		},
+		{
+			name:  "BeaconBlockRootLong",
+			input: []byte(`{"slot":"1","beacon_block_root":"0x00000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","subcommittee_index":"2","aggregation_bits":"0xffffffffffffffffffffffffffffff7f","signature":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f"}`),
+			err:   "incorrect length for beacon block root",
+		},
+		{
+			name:  "SubcommitteeIndexMissing",
+			input: []byte(`{"slot":"1","beacon_block_root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","aggregation_bits":"0xffffffffffffffffffffffffffffff7f","signature":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f"}`),
+			err:   "subcommittee index missing",
+		},
+		{
+			name:  "SubcommitteeIndexWrongType",
+			input: []byte(`{"slot":"1","beacon_block_root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","subcommittee_index":true,"aggregation_bits":"0xffffffffffffffffffffffffffffff7f","signature":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f"}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field syncCommitteeContributionJSON.subcommittee_index of type string",
+		},
+		{
+			name:  "SubcommitteeIndexInvalid",
+			input: []byte(`{"slot":"1","beacon_block_root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","subcommittee_index":"-1","aggregation_bits":"0xffffffffffffffffffffffffffffff7f","signature":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f"}`),
+			err:   "invalid value for subcommittee index: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "AggregationBitsMissing",
+			input: []byte(`{"slot":"1","beacon_block_root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","subcommittee_index":"2","signature":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f"}`),
+			err:   "aggregation bits missing",
+		},
+		{
+			name:  "AggregationBitsWrongType",
+			input: []byte(`{"slot":"1","beacon_block_root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","subcommittee_index":"2","aggregation_bits":true,"signature":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f"}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field syncCommitteeContributionJSON.aggregation_bits of type string",
+		},
+		{
+			name:  "AggregationBitsInvalid",
+			input: []byte(`{"slot":"1","beacon_block_root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","subcommittee_index":"2","aggregation_bits":"invalid","signature":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f"}`),
+			err:   "invalid value for aggregation bits: encoding/hex: invalid byte: U+0069 'i'",
+		},
+		{
+			name:  "AggregationBitsShort",
+			input: []byte(`{"slot":"1","beacon_block_root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","subcommittee_index":"2","aggregation_bits":"0xffffffffffffffffffffffffffff7f","signature":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f"}`),
+			err:   "incorrect length for aggregation bits",
+		},
+		{
+			name:  "AggregationBitsLong",
+			input: []byte(`{"slot":"1","beacon_block_root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","subcommittee_index":"2","aggregation_bits":"0xffffffffffffffffffffffffffffffff7f","signature":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f"}`),
+			err:   "incorrect length for aggregation bits",
+		},
+		{
+			name:  "SignatureMissing",
+			input: []byte(`{"slot":"1","beacon_block_root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","subcommittee_index":"2","aggregation_bits":"0xffffffffffffffffffffffffffffff7f"}`),
+			err:   "signature missing",
+		},
+		{
+			name:  "SignatureWrongType",
+			input: []byte(`{"slot":"1","beacon_block_root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","subcommittee_index":"2","aggregation_bits":"0xffffffffffffffffffffffffffffff7f","signature":true}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field syncCommitteeContributionJSON.signature of type string",
+		},
+		{
+			name:  "SignatureInvalid",
+			input: []byte(`{"slot":"1","beacon_block_root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","subcommittee_index":"2","aggregation_bits":"0xffffffffffffffffffffffffffffff7f","signature":"invalid"}`),
+			err:   "invalid value for signature: encoding/hex: invalid byte: U+0069 'i'",
+		},
+		{
+			name:  "SignatureShort",
+			input: []byte(`{"slot":"1","beacon_block_root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","subcommittee_index":"2","aggregation_bits":"0xffffffffffffffffffffffffffffff7f","signature":"0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f"}`),
+			err:   "incorrect length for signature",
+		},
+		{
+			name:  "SignatureLong",
+			input: []byte(`{"slot":"1","beacon_block_root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","subcommittee_index":"2","aggregation_bits":"0xffffffffffffffffffffffffffffff7f","signature":"0x00000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f"}`),
+			err:   "incorrect length for signature",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"slot":"1","beacon_block_root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","subcommittee_index":"2","aggregation_bits":"0xffffffffffffffffffffffffffffff7f","signature":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f"}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res altair.SyncCommitteeContribution
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+			}
+		})
+	}
+}
+
+func TestSyncCommitteeContributionYAML(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		root  []byte
+		err   string
+	}{
+		{
+			name:  "Good",
+			input: []byte(`{slot: 1, beacon_block_root: '0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f', subcommittee_index: 2, aggregation_bits: '0xffffffffffffffffffffffffffffff7f', signature: '0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f'}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res altair.SyncCommitteeContribution
+			err := yaml.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := yaml.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(rt), res.String())
+				rt = bytes.TrimSuffix(rt, []byte("\n"))
+				assert.Equal(t, string(test.input), string(rt))
+			}
+		})
+	}
+}
+
+func TestSyncCommitteeContributionSpec(t *testing.T) {
+	if os.Getenv("ETH2_SPEC_TESTS_DIR") == "" {
+		t.Skip("ETH2_SPEC_TESTS_DIR not suppplied, not running spec tests")
+	}
+	baseDir := filepath.Join(os.Getenv("ETH2_SPEC_TESTS_DIR"), "tests", "mainnet", "altair", "ssz_static", "SyncCommitteeContribution", "ssz_random")
+	require.NoError(t, filepath.Walk(baseDir, func(path string, info os.FileInfo, err error) error {
+		if path == baseDir {
+			// Only interested in subdirectories.
+			return nil
+		}
+		require.NoError(t, err)
+		if info.IsDir() {
+			t.Run(info.Name(), func(t *testing.T) {
+				specYAML, err := ioutil.ReadFile(filepath.Join(path, "value.yaml"))
+				require.NoError(t, err)
+				var res altair.SyncCommitteeContribution
+				require.NoError(t, yaml.Unmarshal(specYAML, &res))
+
+				specSSZ, err := ioutil.ReadFile(filepath.Join(path, "serialized.ssz"))
+				require.NoError(t, err)
+
+				ssz, err := res.MarshalSSZ()
+				require.NoError(t, err)
+				require.Equal(t, specSSZ, ssz)
+
+				root, err := res.HashTreeRoot()
+				require.NoError(t, err)
+				rootsYAML, err := ioutil.ReadFile(filepath.Join(path, "roots.yaml"))
+				require.NoError(t, err)
+				require.Equal(t, string(rootsYAML), fmt.Sprintf("{root: '%#x'}\n", root))
+			})
+		}
+		return nil
+	}))
+}
@@ -0,0 +1,193 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deneb
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/goccy/go-yaml"
+	"github.com/pkg/errors"
+)
+
+// BlobSidecar is the Ethereum 2 blob sidecar structure.
+type BlobSidecar struct {
+	Index                       BlobIndex
+	Blob                        Blob          `ssz-size:"131072"`
+	KZGCommitment               KZGCommitment `ssz-size:"48"`
+	KZGProof                    KZGProof      `ssz-size:"48"`
+	SignedBlockHeader           *phase0.SignedBeaconBlockHeader
+	KZGCommitmentInclusionProof [][]byte `ssz-size:"17,32"`
+}
+
+// blobSidecarJSON is the spec representation of the struct.
+type blobSidecarJSON struct {
+	Index                       string                          `json:"index"`
+	Blob                        string                          `json:"blob"`
+	KZGCommitment               string                          `json:"kzg_commitment"`
+	KZGProof                    string                          `json:"kzg_proof"`
+	SignedBlockHeader           *phase0.SignedBeaconBlockHeader `json:"signed_block_header"`
+	KZGCommitmentInclusionProof []string                        `json:"kzg_commitment_inclusion_proof"`
+}
+
+// blobSidecarYAML is the spec representation of the struct.
+type blobSidecarYAML struct {
+	Index                       uint64                          `yaml:"index"`
+	Blob                        string                          `yaml:"blob"`
+	KZGCommitment               string                          `yaml:"kzg_commitment"`
+	KZGProof                    string                          `yaml:"kzg_proof"`
+	SignedBlockHeader           *phase0.SignedBeaconBlockHeader `yaml:"signed_block_header"`
+	KZGCommitmentInclusionProof []string                        `yaml:"kzg_commitment_inclusion_proof"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (b *BlobSidecar) MarshalJSON() ([]byte, error) {
+	kzgCommitmentInclusionProof := make([]string, len(b.KZGCommitmentInclusionProof))
+	for i := range b.KZGCommitmentInclusionProof {
+		kzgCommitmentInclusionProof[i] = fmt.Sprintf("%#x", b.KZGCommitmentInclusionProof[i])
+	}
+
+	return json.Marshal(&blobSidecarJSON{
+		Index:                       fmt.Sprintf("%d", b.Index),
+		Blob:                        fmt.Sprintf("%#x", b.Blob),
+		KZGCommitment:               fmt.Sprintf("%#x", b.KZGCommitment),
+		KZGProof:                    fmt.Sprintf("%#x", b.KZGProof),
+		SignedBlockHeader:           b.SignedBlockHeader,
+		KZGCommitmentInclusionProof: kzgCommitmentInclusionProof,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *BlobSidecar) UnmarshalJSON(input []byte) error {
+	var blobSidecarJSON blobSidecarJSON
+	if err := json.Unmarshal(input, &blobSidecarJSON); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+	return b.unpack(&blobSidecarJSON)
+}
+
+func (b *BlobSidecar) unpack(blobSidecarJSON *blobSidecarJSON) error {
+	if blobSidecarJSON.Index == "" {
+		return errors.New("index missing")
+	}
+	index, err := strconv.ParseUint(blobSidecarJSON.Index, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for index")
+	}
+	b.Index = BlobIndex(index)
+	if blobSidecarJSON.Blob == "" {
+		return errors.New("blob missing")
+	}
+	blob, err := hex.DecodeString(strings.TrimPrefix(blobSidecarJSON.Blob, "0x"))
+	if err != nil {
+		return errors.Wrap(err, "invalid value for blob")
+	}
+	if len(blob) != BlobLength {
+		return errors.New("incorrect length for blob")
+	}
+	copy(b.Blob[:], blob)
+	if blobSidecarJSON.KZGCommitment == "" {
+		return errors.New("kzg commitment missing")
+	}
+	kzgCommitment, err := hex.DecodeString(strings.TrimPrefix(blobSidecarJSON.KZGCommitment, "0x"))
+	if err != nil {
+		return errors.Wrap(err, "invalid value for kzg commitment")
+	}
+	if len(kzgCommitment) != KZGCommitmentLength {
+		return errors.New("incorrect length for kzg commitment")
+	}
+	copy(b.KZGCommitment[:], kzgCommitment)
+	if blobSidecarJSON.KZGProof == "" {
+		return errors.New("kzg proof missing")
+	}
+	kzgProof, err := hex.DecodeString(strings.TrimPrefix(blobSidecarJSON.KZGProof, "0x"))
+	if err != nil {
+		return errors.Wrap(err, "invalid value for kzg proof")
+	}
+	if len(kzgProof) != KZGProofLength {
+		return errors.New("incorrect length for kzg proof")
+	}
+	copy(b.KZGProof[:], kzgProof)
+	if blobSidecarJSON.SignedBlockHeader == nil {
+		return errors.New("signed block header missing")
+	}
+	b.SignedBlockHeader = blobSidecarJSON.SignedBlockHeader
+	if blobSidecarJSON.KZGCommitmentInclusionProof == nil {
+		return errors.New("kzg commitment inclusion proof missing")
+	}
+	if len(blobSidecarJSON.KZGCommitmentInclusionProof) != KZGCommitmentInclusionProofLength {
+		return errors.New("incorrect length for kzg commitment inclusion proof")
+	}
+	b.KZGCommitmentInclusionProof = make([][]byte, len(blobSidecarJSON.KZGCommitmentInclusionProof))
+	for i := range blobSidecarJSON.KZGCommitmentInclusionProof {
+		if blobSidecarJSON.KZGCommitmentInclusionProof[i] == "" {
+			return errors.New("kzg commitment inclusion proof component missing")
+		}
+		component, err := hex.DecodeString(strings.TrimPrefix(blobSidecarJSON.KZGCommitmentInclusionProof[i], "0x"))
+		if err != nil {
+			return errors.Wrap(err, "invalid value for kzg commitment inclusion proof")
+		}
+		if len(component) != phase0.RootLength {
+			return errors.New("incorrect length for kzg commitment inclusion proof component")
+		}
+		b.KZGCommitmentInclusionProof[i] = component
+	}
+
+	return nil
+}
+
+// MarshalYAML implements yaml.Marshaler.
+func (b *BlobSidecar) MarshalYAML() ([]byte, error) {
+	kzgCommitmentInclusionProof := make([]string, len(b.KZGCommitmentInclusionProof))
+	for i := range b.KZGCommitmentInclusionProof {
+		kzgCommitmentInclusionProof[i] = fmt.Sprintf("%#x", b.KZGCommitmentInclusionProof[i])
+	}
+
+	yamlBytes, err := yaml.MarshalWithOptions(&blobSidecarYAML{
+		Index:                       uint64(b.Index),
+		Blob:                        fmt.Sprintf("%#x", b.Blob),
+		KZGCommitment:               fmt.Sprintf("%#x", b.KZGCommitment),
+		KZGProof:                    fmt.Sprintf("%#x", b.KZGProof),
+		SignedBlockHeader:           b.SignedBlockHeader,
+		KZGCommitmentInclusionProof: kzgCommitmentInclusionProof,
+	}, yaml.Flow(true))
+	if err != nil {
+		return nil, err
+	}
+	return bytes.ReplaceAll(yamlBytes, []byte(`"`), []byte(`'`)), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (b *BlobSidecar) UnmarshalYAML(input []byte) error {
+	// We unmarshal to the JSON struct to save on duplicate code.
+	var blobSidecarJSON blobSidecarJSON
+	if err := yaml.Unmarshal(input, &blobSidecarJSON); err != nil {
+		return err
+	}
+	return b.unpack(&blobSidecarJSON)
+}
+
+// String returns a string version of the structure.
+func (b *BlobSidecar) String() string {
+	data, err := yaml.Marshal(b)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	return string(data)
+}
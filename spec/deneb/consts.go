@@ -0,0 +1,26 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deneb
+
+// BlobLength is the number of bytes in a blob.
+const BlobLength = 131072
+
+// KZGCommitmentLength is the number of bytes in a KZG commitment.
+const KZGCommitmentLength = 48
+
+// KZGProofLength is the number of bytes in a KZG proof.
+const KZGProofLength = 48
+
+// KZGCommitmentInclusionProofLength is the number of roots in the KZG commitment inclusion proof of a blob sidecar.
+const KZGCommitmentInclusionProofLength = 17
@@ -0,0 +1,26 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deneb
+
+// BlobIndex is the index of a blob in a block.
+type BlobIndex uint64
+
+// Blob is a blob of data.
+type Blob [131072]byte
+
+// KZGCommitment is a KZG commitment to a blob.
+type KZGCommitment [48]byte
+
+// KZGProof is a KZG proof for a blob.
+type KZGProof [48]byte
@@ -36,3 +36,6 @@ const DomainTypeLength = 4
 
 // DomainLength is the number of bytes in a domain.
 const DomainLength = 32
+
+// MinSeedLookahead is the number of epochs of lookahead for the seed, MIN_SEED_LOOKAHEAD.
+const MinSeedLookahead = 1
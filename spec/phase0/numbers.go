@@ -0,0 +1,102 @@
+// Copyright © 2020 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package phase0
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// The beacon API represents 64-bit numbers as quoted decimal strings, to avoid loss of precision
+// in JSON decoders that store numbers as floating point values.  The types below marshal to this
+// form, and unmarshal from either the quoted or the unquoted form.
+
+// MarshalJSON implements json.Marshaler.
+func (s Slot) MarshalJSON() ([]byte, error) {
+	return marshalUint64JSON(uint64(s)), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (s *Slot) UnmarshalJSON(input []byte) error {
+	return unmarshalUint64JSON(input, (*uint64)(s), "slot")
+}
+
+// MarshalJSON implements json.Marshaler.
+func (e Epoch) MarshalJSON() ([]byte, error) {
+	return marshalUint64JSON(uint64(e)), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *Epoch) UnmarshalJSON(input []byte) error {
+	return unmarshalUint64JSON(input, (*uint64)(e), "epoch")
+}
+
+// MarshalJSON implements json.Marshaler.
+func (c CommitteeIndex) MarshalJSON() ([]byte, error) {
+	return marshalUint64JSON(uint64(c)), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (c *CommitteeIndex) UnmarshalJSON(input []byte) error {
+	return unmarshalUint64JSON(input, (*uint64)(c), "committee index")
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v ValidatorIndex) MarshalJSON() ([]byte, error) {
+	return marshalUint64JSON(uint64(v)), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *ValidatorIndex) UnmarshalJSON(input []byte) error {
+	return unmarshalUint64JSON(input, (*uint64)(v), "validator index")
+}
+
+// MarshalJSON implements json.Marshaler.
+func (g Gwei) MarshalJSON() ([]byte, error) {
+	return marshalUint64JSON(uint64(g)), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (g *Gwei) UnmarshalJSON(input []byte) error {
+	return unmarshalUint64JSON(input, (*uint64)(g), "gwei")
+}
+
+// marshalUint64JSON marshals a value as a quoted decimal string.
+func marshalUint64JSON(val uint64) []byte {
+	return []byte(fmt.Sprintf(`"%d"`, val))
+}
+
+// unmarshalUint64JSON unmarshals a value from either a quoted or unquoted decimal string.
+func unmarshalUint64JSON(input []byte, val *uint64, name string) error {
+	if bytes.Equal(input, []byte("null")) {
+		// Leave the value untouched, as per encoding/json.
+		return nil
+	}
+	if len(input) >= 2 && input[0] == '"' && input[len(input)-1] == '"' {
+		input = input[1 : len(input)-1]
+	}
+	if len(input) == 0 {
+		return fmt.Errorf("%s missing", name)
+	}
+	res, err := strconv.ParseUint(string(input), 10, 64)
+	if err != nil {
+		return errors.Wrap(err, fmt.Sprintf("invalid value for %s", name))
+	}
+	*val = res
+
+	return nil
+}
@@ -0,0 +1,114 @@
+// Copyright © 2020 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package phase0_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	require "github.com/stretchr/testify/require"
+)
+
+func TestNumbersJSON(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  []byte
+		output []byte
+		err    string
+	}{
+		{
+			name:  "Empty",
+			input: []byte(`""`),
+			err:   "gwei missing",
+		},
+		{
+			name:  "WrongType",
+			input: []byte(`true`),
+			err:   "invalid value for gwei: strconv.ParseUint: parsing \"true\": invalid syntax",
+		},
+		{
+			name:  "Negative",
+			input: []byte(`"-1"`),
+			err:   "invalid value for gwei: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "Overflow",
+			input: []byte(`"18446744073709551616"`),
+			err:   "invalid value for gwei: strconv.ParseUint: parsing \"18446744073709551616\": value out of range",
+		},
+		{
+			name:  "Quoted",
+			input: []byte(`"32000000000"`),
+		},
+		{
+			name:   "Unquoted",
+			input:  []byte(`32000000000`),
+			output: []byte(`"32000000000"`),
+		},
+		{
+			name:  "Above2To53",
+			input: []byte(`"9007199254740993"`),
+		},
+		{
+			name:   "Above2To53Unquoted",
+			input:  []byte(`9007199254740993`),
+			output: []byte(`"9007199254740993"`),
+		},
+		{
+			name:  "Max",
+			input: []byte(`"18446744073709551615"`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res spec.Gwei
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			rt, err := json.Marshal(res)
+			require.NoError(t, err)
+			if test.output == nil {
+				test.output = test.input
+			}
+			require.Equal(t, string(test.output), string(rt))
+		})
+	}
+}
+
+func TestNumbersStructJSON(t *testing.T) {
+	type numbers struct {
+		Slot           spec.Slot           `json:"slot"`
+		Epoch          spec.Epoch          `json:"epoch"`
+		CommitteeIndex spec.CommitteeIndex `json:"committee_index"`
+		ValidatorIndex spec.ValidatorIndex `json:"validator_index"`
+		Gwei           spec.Gwei           `json:"gwei"`
+	}
+
+	input := []byte(`{"slot":18446744073709551615,"epoch":"9007199254740993","committee_index":3,"validator_index":"4","gwei":null}`)
+	var res numbers
+	require.NoError(t, json.Unmarshal(input, &res))
+	require.Equal(t, spec.Slot(18446744073709551615), res.Slot)
+	require.Equal(t, spec.Epoch(9007199254740993), res.Epoch)
+
+	rt, err := json.Marshal(&res)
+	require.NoError(t, err)
+	require.Equal(t, `{"slot":"18446744073709551615","epoch":"9007199254740993","committee_index":"3","validator_index":"4","gwei":"0"}`, string(rt))
+
+	require.EqualError(t, json.Unmarshal([]byte(`{"validator_index":"x"}`), &res), "invalid value for validator index: strconv.ParseUint: parsing \"x\": invalid syntax")
+}
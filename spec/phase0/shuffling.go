@@ -0,0 +1,129 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package phase0
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+// GetSeed computes the seed for an epoch and domain type from a state, as per get_seed.
+// The length of the state's RANDAO mixes is used as EPOCHS_PER_HISTORICAL_VECTOR.
+func GetSeed(state *BeaconState, epoch Epoch, domainType DomainType) ([32]byte, error) {
+	if state == nil {
+		return [32]byte{}, errors.New("state missing")
+	}
+	epochsPerHistoricalVector := uint64(len(state.RANDAOMixes))
+	if epochsPerHistoricalVector == 0 {
+		return [32]byte{}, errors.New("RANDAO mixes missing")
+	}
+
+	mixIndex := (uint64(epoch) + epochsPerHistoricalVector - MinSeedLookahead - 1) % epochsPerHistoricalVector
+	mix := state.RANDAOMixes[mixIndex]
+	if len(mix) != RootLength {
+		return [32]byte{}, fmt.Errorf("incorrect length %d for RANDAO mix %d", len(mix), mixIndex)
+	}
+
+	data := make([]byte, DomainTypeLength+8+RootLength)
+	copy(data, domainType[:])
+	binary.LittleEndian.PutUint64(data[DomainTypeLength:], uint64(epoch))
+	copy(data[DomainTypeLength+8:], mix)
+
+	return sha256.Sum256(data), nil
+}
+
+// ComputeShuffledIndex computes the shuffled index of an item in a list of indexCount items, as per
+// compute_shuffled_index.
+func ComputeShuffledIndex(index uint64, indexCount uint64, seed [32]byte, shuffleRoundCount uint64) (uint64, error) {
+	if index >= indexCount {
+		return 0, fmt.Errorf("index %d out of range for %d items", index, indexCount)
+	}
+
+	buf := make([]byte, 32+1+4)
+	copy(buf, seed[:])
+	for round := uint64(0); round < shuffleRoundCount; round++ {
+		index = shuffleRound(buf, round, index, indexCount)
+	}
+
+	return index, nil
+}
+
+// ComputeUnshuffledIndex computes the index of an item in a list of indexCount items given its shuffled index.
+// It is the inverse of ComputeShuffledIndex, obtained by applying its rounds in reverse order, and allows the position
+// of a single item in the shuffled list to be found without shuffling the entire list.
+func ComputeUnshuffledIndex(index uint64, indexCount uint64, seed [32]byte, shuffleRoundCount uint64) (uint64, error) {
+	if index >= indexCount {
+		return 0, fmt.Errorf("index %d out of range for %d items", index, indexCount)
+	}
+
+	buf := make([]byte, 32+1+4)
+	copy(buf, seed[:])
+	for round := shuffleRoundCount; round > 0; round-- {
+		index = shuffleRound(buf, round-1, index, indexCount)
+	}
+
+	return index, nil
+}
+
+// ComputeCommittee computes a committee from a list of indices, as per compute_committee.
+func ComputeCommittee(indices []ValidatorIndex,
+	seed [32]byte,
+	index uint64,
+	count uint64,
+	shuffleRoundCount uint64,
+) (
+	[]ValidatorIndex,
+	error,
+) {
+	if index >= count {
+		return nil, fmt.Errorf("committee %d out of range for %d committees", index, count)
+	}
+
+	indexCount := uint64(len(indices))
+	start := indexCount * index / count
+	end := indexCount * (index + 1) / count
+	committee := make([]ValidatorIndex, 0, end-start)
+	for i := start; i < end; i++ {
+		shuffledIndex, err := ComputeShuffledIndex(i, indexCount, seed, shuffleRoundCount)
+		if err != nil {
+			return nil, err
+		}
+		committee = append(committee, indices[shuffledIndex])
+	}
+
+	return committee, nil
+}
+
+// shuffleRound carries out a single round of the swap-or-not shuffle.
+// buf holds the seed in its first 32 bytes, and has space for the round and position.
+func shuffleRound(buf []byte, round uint64, index uint64, indexCount uint64) uint64 {
+	buf[32] = byte(round)
+	pivotHash := sha256.Sum256(buf[:33])
+	pivot := binary.LittleEndian.Uint64(pivotHash[:8]) % indexCount
+	flip := (pivot + indexCount - index) % indexCount
+	position := index
+	if flip > position {
+		position = flip
+	}
+	binary.LittleEndian.PutUint32(buf[33:], uint32(position/256))
+	source := sha256.Sum256(buf)
+	if (source[(position%256)/8]>>(position%8))&1 == 1 {
+		return flip
+	}
+
+	return index
+}
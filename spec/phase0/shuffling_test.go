@@ -0,0 +1,252 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package phase0_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	require "github.com/stretchr/testify/require"
+)
+
+// Expected values in these tests are from the consensus specification's reference implementation.
+
+// shufflingSeed is the seed used for shuffling tests.
+var shufflingSeed = sha256.Sum256([]byte("seed"))
+
+func TestGetSeed(t *testing.T) {
+	state := &spec.BeaconState{
+		RANDAOMixes: make([][]byte, 64),
+	}
+	for i := range state.RANDAOMixes {
+		mix := sha256.Sum256([]byte{byte(i)})
+		state.RANDAOMixes[i] = mix[:]
+	}
+	domainType := spec.DomainType{0x01, 0x00, 0x00, 0x00}
+
+	tests := []struct {
+		name  string
+		state *spec.BeaconState
+		epoch spec.Epoch
+		res   string
+		err   string
+	}{
+		{
+			name: "Nil",
+			err:  "state missing",
+		},
+		{
+			name:  "MixesMissing",
+			state: &spec.BeaconState{},
+			err:   "RANDAO mixes missing",
+		},
+		{
+			name:  "MixShort",
+			state: &spec.BeaconState{RANDAOMixes: [][]byte{{0x01}}},
+			err:   "incorrect length 1 for RANDAO mix 0",
+		},
+		{
+			name:  "Epoch0",
+			state: state,
+			epoch: 0,
+			res:   "25de7933477e3ac6757d9a4a1fe0c0cde31c6fc0afe46f4cb2e354acb011f7b3",
+		},
+		{
+			name:  "Epoch1",
+			state: state,
+			epoch: 1,
+			res:   "988282903201aa4ba2b33a22cce19fa9504aa22da9b5b04baaf6e74bc839f3b3",
+		},
+		{
+			name:  "Epoch3",
+			state: state,
+			epoch: 3,
+			res:   "f6a70413c63dc9439df29ab9fb081925c5c9be0550fe4f2a15db7de051a6d48b",
+		},
+		{
+			name:  "Wrapped",
+			state: state,
+			epoch: 100,
+			res:   "7e97f5d8d29d401032659b2967725af071b1f77a7545157109f90bb791c34269",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			seed, err := spec.GetSeed(test.state, test.epoch, domainType)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.res, hex.EncodeToString(seed[:]))
+		})
+	}
+}
+
+func TestComputeShuffledIndex(t *testing.T) {
+	tests := []struct {
+		name       string
+		index      uint64
+		indexCount uint64
+		rounds     uint64
+		res        uint64
+		err        string
+	}{
+		{
+			name:       "OutOfRange",
+			index:      10,
+			indexCount: 10,
+			rounds:     90,
+			err:        "index 10 out of range for 10 items",
+		},
+		{
+			name:       "Single",
+			index:      0,
+			indexCount: 1,
+			rounds:     90,
+			res:        0,
+		},
+		{
+			name:       "SmallFirst",
+			index:      0,
+			indexCount: 10,
+			rounds:     90,
+			res:        5,
+		},
+		{
+			name:       "SmallLast",
+			index:      9,
+			indexCount: 10,
+			rounds:     90,
+			res:        2,
+		},
+		{
+			name:       "SmallMinimalRounds",
+			index:      5,
+			indexCount: 10,
+			rounds:     10,
+			res:        1,
+		},
+		{
+			name:       "Medium",
+			index:      123,
+			indexCount: 1000,
+			rounds:     90,
+			res:        448,
+		},
+		{
+			name:       "MediumLast",
+			index:      999,
+			indexCount: 1000,
+			rounds:     90,
+			res:        938,
+		},
+		{
+			name:       "MediumMinimalRounds",
+			index:      300,
+			indexCount: 1000,
+			rounds:     10,
+			res:        711,
+		},
+		{
+			name:       "Large",
+			index:      12345,
+			indexCount: 100000,
+			rounds:     90,
+			res:        60521,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := spec.ComputeShuffledIndex(test.index, test.indexCount, shufflingSeed, test.rounds)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.res, res)
+
+			// Unshuffling returns the original index.
+			unshuffled, err := spec.ComputeUnshuffledIndex(res, test.indexCount, shufflingSeed, test.rounds)
+			require.NoError(t, err)
+			require.Equal(t, test.index, unshuffled)
+		})
+	}
+}
+
+func TestComputeShuffledIndexPermutation(t *testing.T) {
+	seen := make(map[uint64]bool)
+	for i := uint64(0); i < 500; i++ {
+		res, err := spec.ComputeShuffledIndex(i, 500, shufflingSeed, 90)
+		require.NoError(t, err)
+		require.False(t, seen[res])
+		seen[res] = true
+	}
+}
+
+func TestComputeCommittee(t *testing.T) {
+	indices := make([]spec.ValidatorIndex, 20)
+	for i := range indices {
+		indices[i] = spec.ValidatorIndex(100 + i)
+	}
+
+	tests := []struct {
+		name  string
+		index uint64
+		count uint64
+		res   []spec.ValidatorIndex
+		err   string
+	}{
+		{
+			name:  "OutOfRange",
+			index: 4,
+			count: 4,
+			err:   "committee 4 out of range for 4 committees",
+		},
+		{
+			name:  "First",
+			index: 0,
+			count: 4,
+			res:   []spec.ValidatorIndex{114, 112, 109, 104, 106},
+		},
+		{
+			name:  "Last",
+			index: 3,
+			count: 4,
+			res:   []spec.ValidatorIndex{110, 108, 118, 116, 103},
+		},
+		{
+			name:  "Uneven",
+			index: 1,
+			count: 3,
+			res:   []spec.ValidatorIndex{100, 107, 117, 101, 111, 113, 115},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := spec.ComputeCommittee(indices, shufflingSeed, test.index, test.count, 90)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.res, res)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright © 2020 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package phase0
+
+import (
+	"encoding/binary"
+
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/pkg/errors"
+)
+
+// SigningRoot computes the signing root of an object for the given domain, as per compute_signing_root.
+func SigningRoot(object ssz.HashRoot, domain Domain) (Root, error) {
+	objectRoot, err := object.HashTreeRoot()
+	if err != nil {
+		return Root{}, errors.Wrap(err, "failed to obtain object root")
+	}
+	return signingRoot(objectRoot, domain)
+}
+
+// AggregateAndProofSigningRoot computes the signing root of an aggregate and proof for the given domain.
+// The domain should be that supplied by AggregateAndProofDomainProvider.
+func AggregateAndProofSigningRoot(aggregateAndProof *AggregateAndProof, domain Domain) (Root, error) {
+	if aggregateAndProof == nil {
+		return Root{}, errors.New("aggregate and proof missing")
+	}
+	return SigningRoot(aggregateAndProof, domain)
+}
+
+// SelectionProofSigningRoot computes the signing root of a selection proof for the given slot and domain.
+// The domain should be that supplied by SelectionProofDomainProvider.
+func SelectionProofSigningRoot(slot Slot, domain Domain) (Root, error) {
+	// The hash tree root of a uint64 is its little-endian representation, padded to 32 bytes.
+	var slotRoot Root
+	binary.LittleEndian.PutUint64(slotRoot[:8], uint64(slot))
+	return signingRoot(slotRoot, domain)
+}
+
+// signingRoot computes the signing root of an object root for the given domain.
+func signingRoot(objectRoot Root, domain Domain) (Root, error) {
+	signingData := &SigningData{
+		ObjectRoot: objectRoot,
+		Domain:     domain,
+	}
+	root, err := signingData.HashTreeRoot()
+	if err != nil {
+		return Root{}, errors.Wrap(err, "failed to obtain signing root")
+	}
+	return root, nil
+}
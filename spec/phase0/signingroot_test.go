@@ -0,0 +1,66 @@
+// Copyright © 2020 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package phase0_test
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/prysmaticlabs/go-bitfield"
+	require "github.com/stretchr/testify/require"
+)
+
+// expectedSigningRoot computes the signing root manually, as the hash of the object root and domain.
+func expectedSigningRoot(objectRoot [32]byte, domain spec.Domain) spec.Root {
+	return sha256.Sum256(append(objectRoot[:], domain[:]...))
+}
+
+func TestSigningRoot(t *testing.T) {
+	domain := spec.Domain{0x06, 0x00, 0x00, 0x00, 0x01, 0x02, 0x03, 0x04}
+	aggregateAndProof := &spec.AggregateAndProof{
+		AggregatorIndex: 1,
+		Aggregate: &spec.Attestation{
+			AggregationBits: bitfield.NewBitlist(8),
+			Data: &spec.AttestationData{
+				Slot:   2,
+				Index:  3,
+				Source: &spec.Checkpoint{},
+				Target: &spec.Checkpoint{},
+			},
+		},
+	}
+	objectRoot, err := aggregateAndProof.HashTreeRoot()
+	require.NoError(t, err)
+
+	root, err := spec.SigningRoot(aggregateAndProof, domain)
+	require.NoError(t, err)
+	require.Equal(t, expectedSigningRoot(objectRoot, domain), root)
+
+	root, err = spec.AggregateAndProofSigningRoot(aggregateAndProof, domain)
+	require.NoError(t, err)
+	require.Equal(t, expectedSigningRoot(objectRoot, domain), root)
+
+	_, err = spec.AggregateAndProofSigningRoot(nil, domain)
+	require.EqualError(t, err, "aggregate and proof missing")
+}
+
+func TestSelectionProofSigningRoot(t *testing.T) {
+	domain := spec.Domain{0x05, 0x00, 0x00, 0x00, 0x01, 0x02, 0x03, 0x04}
+	slotRoot := [32]byte{0x02, 0x01}
+
+	root, err := spec.SelectionProofSigningRoot(258, domain)
+	require.NoError(t, err)
+	require.Equal(t, expectedSigningRoot(slotRoot, domain), root)
+}
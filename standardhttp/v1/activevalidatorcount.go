@@ -0,0 +1,125 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/pkg/errors"
+)
+
+// activeValidatorStatuses are the statuses counted as active by the validator count endpoint.
+var activeValidatorStatuses = []string{"active_ongoing", "active_exiting", "active_slashed"}
+
+type validatorCountJSON struct {
+	Data map[string]json.RawMessage `json:"data"`
+}
+
+// ActiveValidatorCount provides the number of active validators in a given state.
+// stateID can be a slot number or state root, or one of the special values "genesis", "head", "justified" or "finalized".
+// For the head state this uses the node's validator count endpoint where available.  Otherwise, or for other states, it
+// counts the validators returned by the status-filtered validators endpoint without decoding them.
+func (s *Service) ActiveValidatorCount(ctx context.Context, stateID string) (uint64, error) {
+	if stateID == "" {
+		return 0, errors.New("no state ID specified")
+	}
+
+	if stateID == "head" && atomic.LoadInt32(&s.validatorCountUnsupported) == 0 {
+		count, supported, err := s.headActiveValidatorCount(ctx)
+		if err != nil {
+			return 0, err
+		}
+		if supported {
+			return count, nil
+		}
+		s.log.Debug().Msg("Validator count endpoint not supported; counting validators")
+		atomic.StoreInt32(&s.validatorCountUnsupported, 1)
+	}
+
+	respBodyReader, err := s.get(ctx, fmt.Sprintf("/eth/v1/beacon/states/%s/validators?status=active", stateID))
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to request active validators")
+	}
+	if respBodyReader == nil {
+		return 0, errors.Wrap(client.ErrNotFound, "failed to obtain active validators")
+	}
+
+	count, err := countDataItems(respBodyReader)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to parse active validators")
+	}
+
+	return count, nil
+}
+
+// headActiveValidatorCount obtains the number of active validators in the head state from the node's validator
+// count endpoint.  It returns false if the node does not provide the endpoint.
+func (s *Service) headActiveValidatorCount(ctx context.Context) (uint64, bool, error) {
+	respBodyReader, err := s.get(ctx, "/lighthouse/ui/validator_count")
+	if (err == nil && respBodyReader == nil) || (err != nil && versionUnsupported(err)) {
+		// Node does not provide the endpoint.
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, errors.Wrap(err, "failed to request validator count")
+	}
+
+	var resp validatorCountJSON
+	if err := s.decodeJSON(respBodyReader, &resp); err != nil {
+		return 0, false, errors.Wrap(err, "failed to parse validator count")
+	}
+	if resp.Data == nil {
+		return 0, false, errors.New("no validator count returned")
+	}
+
+	count := uint64(0)
+	for _, status := range activeValidatorStatuses {
+		raw, exists := resp.Data[status]
+		if !exists {
+			return 0, false, fmt.Errorf("validator count for %s missing", status)
+		}
+		statusCount, err := strconv.ParseUint(strings.Trim(string(raw), `"`), 10, 64)
+		if err != nil {
+			return 0, false, errors.Wrap(err, fmt.Sprintf("invalid value for validator count for %s", status))
+		}
+		count += statusCount
+	}
+
+	return count, true, nil
+}
+
+// countDataItems counts the items in the data list of a response without fully decoding them.
+func countDataItems(reader io.Reader) (uint64, error) {
+	count := uint64(0)
+	err := forEachDataItem(reader, func(decoder *json.Decoder) error {
+		var item json.RawMessage
+		if err := decoder.Decode(&item); err != nil {
+			return errors.Wrap(err, "invalid JSON")
+		}
+		count++
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
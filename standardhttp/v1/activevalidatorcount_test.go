@@ -0,0 +1,95 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestActiveValidatorCount(t *testing.T) {
+	validators := `{"data":[{"index":"1"},{"index":"2"},{"index":"3"}],"execution_optimistic":false}`
+	tests := []struct {
+		name       string
+		stateID    string
+		countRoute bool
+		expected   uint64
+		requests   int
+		err        string
+	}{
+		{
+			name: "StateIDMissing",
+			err:  "no state ID specified",
+		},
+		{
+			name:       "HeadCountEndpoint",
+			stateID:    "head",
+			countRoute: true,
+			expected:   6,
+		},
+		{
+			name:     "HeadFallback",
+			stateID:  "head",
+			expected: 3,
+			requests: 1,
+		},
+		{
+			name:       "Finalized",
+			stateID:    "finalized",
+			countRoute: true,
+			expected:   3,
+			requests:   1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			requests := 0
+			handlers := map[string]http.HandlerFunc{
+				"/eth/v1/beacon/states/head/validators": func(w http.ResponseWriter, r *http.Request) {
+					require.Equal(t, "active", r.URL.Query().Get("status"))
+					requests++
+					respondWith(validators)(w, r)
+				},
+				"/eth/v1/beacon/states/finalized/validators": func(w http.ResponseWriter, r *http.Request) {
+					require.Equal(t, "active", r.URL.Query().Get("status"))
+					requests++
+					respondWith(validators)(w, r)
+				},
+			}
+			if test.countRoute {
+				handlers["/lighthouse/ui/validator_count"] = respondWith(`{"data":{"active_ongoing":4,"active_exiting":1,"active_slashed":1,"pending_queued":10}}`)
+			}
+			server := newTestServerWithHandlers(t, handlers)
+			service, err := standardhttp.New(context.Background(),
+				standardhttp.WithTimeout(timeout),
+				standardhttp.WithAddress(server.URL),
+			)
+			require.NoError(t, err)
+
+			count, err := service.ActiveValidatorCount(context.Background(), test.stateID)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, count)
+				require.Equal(t, test.requests, requests)
+			}
+		})
+	}
+}
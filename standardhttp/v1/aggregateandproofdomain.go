@@ -21,5 +21,5 @@ import (
 
 // AggregateAndProofDomain provides the aggregate and proof domain of the chain.
 func (s *Service) AggregateAndProofDomain(ctx context.Context) (spec.DomainType, error) {
-	return s.spec["DOMAIN_AGGREGATE_AND_PROOF"].(spec.DomainType), nil
+	return s.chainConfig.DomainAggregateAndProof, nil
 }
@@ -24,6 +24,8 @@ import (
 )
 
 func TestAggregateAndProofDomain(t *testing.T) {
+	skipWithoutNode(t)
+
 	tests := []struct {
 		name string
 	}{
@@ -16,9 +16,9 @@ package v1
 import (
 	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 
+	client "github.com/attestantio/go-eth2-client"
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 )
@@ -28,18 +28,18 @@ type aggregateAttestationDataJSON struct {
 }
 
 // AggregateAttestation fetches the aggregate attestation given an attestation.
-// N.B if an aggregate attestation for the attestation is not available this will return nil without an error.
+// N.B if an aggregate attestation for the attestation is not available this will return an error that wraps client.ErrNotFound.
 func (s *Service) AggregateAttestation(ctx context.Context, slot spec.Slot, attestationDataRoot spec.Root) (*spec.Attestation, error) {
-	respBodyReader, err := s.get(ctx, fmt.Sprintf("/eth/v1/validator/aggregate_attestation?slot=%d&attestation_data_root=%#x", slot, attestationDataRoot))
+	respBodyReader, err := s.get(ctx, fmt.Sprintf("/eth/v1/validator/aggregate_attestation?slot=%d&attestation_data_root=%s", slot, hexParam(attestationDataRoot[:])))
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to request aggregate attestation")
 	}
 	if respBodyReader == nil {
-		return nil, nil
+		return nil, errors.Wrap(client.ErrNotFound, "failed to obtain aggregate attestation")
 	}
 
 	var aggregateAttestationDataJSON aggregateAttestationDataJSON
-	if err := json.NewDecoder(respBodyReader).Decode(&aggregateAttestationDataJSON); err != nil {
+	if err := s.decodeJSON(respBodyReader, &aggregateAttestationDataJSON); err != nil {
 		return nil, errors.Wrap(err, "failed to parse aggregate attestation")
 	}
 
@@ -15,16 +15,20 @@ package v1_test
 
 import (
 	"context"
+	"errors"
 	"os"
 	"testing"
 	"time"
 
+	client "github.com/attestantio/go-eth2-client"
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
 	"github.com/stretchr/testify/require"
 )
 
 func TestAggregateAttestation(t *testing.T) {
+	skipWithoutNode(t)
+
 	tests := []struct {
 		name           string
 		committeeIndex spec.CommitteeIndex
@@ -58,9 +62,9 @@ func TestAggregateAttestation(t *testing.T) {
 			require.NoError(t, err)
 
 			// Fetch aggregate attestation.
-			// Note that this will not be present, so expect nil as a response but no error.
+			// Note that this will not be present, so expect a not found error.
 			aggregateAttestation, err := service.AggregateAttestation(context.Background(), slot, dataRoot)
-			require.NoError(t, err)
+			require.True(t, errors.Is(err, client.ErrNotFound))
 			require.Nil(t, aggregateAttestation)
 		})
 	}
@@ -0,0 +1,105 @@
+// Copyright © 2020 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// apiVersions are the versions of versioned endpoints, in order of preference.
+var apiVersions = []string{"v2", "v1"}
+
+// getVersioned sends an HTTP get request to an endpoint that is available in multiple versions of the API.
+// path is the endpoint without the leading /eth/{version}, and name identifies the endpoint when caching the
+// version the node supports.  If no API version has been forced, newer versions are tried first and the first
+// version to respond successfully is used for subsequent requests.
+// If the response from the server is a 404 this will return nil for both the reader and the error.
+func (s *Service) getVersioned(ctx context.Context, name string, path string, accept string) (io.Reader, error) {
+	respBodyReader, err := s.getVersionedRaw(ctx, name, path, accept)
+	if err != nil || respBodyReader == nil {
+		return nil, err
+	}
+
+	return s.lenientResponse(respBodyReader, accept)
+}
+
+// getVersionedRaw is as getVersioned, but returns the body as sent by the node.
+func (s *Service) getVersionedRaw(ctx context.Context, name string, path string, accept string) (io.Reader, error) {
+	versions := s.endpointVersions(name)
+
+	var respBodyReader io.Reader
+	var err error
+	for i, version := range versions {
+		respBodyReader, err = s.getRawWithAccept(ctx, fmt.Sprintf("/eth/%s%s", version, path), accept)
+		if err == nil && respBodyReader != nil {
+			if len(versions) > 1 {
+				s.log.Trace().Str("endpoint", name).Str("version", version).Msg("Negotiated API version")
+				s.setEndpointVersion(name, version)
+			}
+			return respBodyReader, nil
+		}
+		if i < len(versions)-1 && !versionUnsupported(err) {
+			// This is not an indication that the version is unsupported, so do not try others.
+			break
+		}
+	}
+
+	return respBodyReader, err
+}
+
+// endpointVersions returns the API versions to try for the named endpoint.
+func (s *Service) endpointVersions(name string) []string {
+	if s.apiVersion != "" {
+		return []string{s.apiVersion}
+	}
+
+	s.negotiatedVersionsMutex.RLock()
+	version, exists := s.negotiatedVersions[name]
+	s.negotiatedVersionsMutex.RUnlock()
+	if exists {
+		return []string{version}
+	}
+
+	return apiVersions
+}
+
+// setEndpointVersion caches the API version for the named endpoint.
+func (s *Service) setEndpointVersion(name string, version string) {
+	s.negotiatedVersionsMutex.Lock()
+	s.negotiatedVersions[name] = version
+	s.negotiatedVersionsMutex.Unlock()
+}
+
+// versionUnsupported returns true if the error from a request suggests the node does not support
+// the requested version of an endpoint.  A nil error is a 404, which may be a missing route or a missing item.
+func versionUnsupported(err error) bool {
+	if err == nil {
+		return true
+	}
+	var httpErr *httpError
+	if !errors.As(err, &httpErr) {
+		return false
+	}
+	switch httpErr.statusCode {
+	case http.StatusBadRequest, http.StatusMethodNotAllowed, http.StatusNotImplemented:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright © 2020 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIVersion(t *testing.T) {
+	response := fmt.Sprintf(`{"data":%s}`, metadataBlock)
+
+	tests := []struct {
+		name       string
+		apiVersion string
+		versions   []string
+		requests   []string
+		err        string
+	}{
+		{
+			name:     "NegotiatedV2",
+			versions: []string{"v1", "v2"},
+			requests: []string{"v2", "v2"},
+		},
+		{
+			name:     "NegotiatedV1",
+			versions: []string{"v1"},
+			requests: []string{"v2", "v1", "v1"},
+		},
+		{
+			name:     "NotFound",
+			versions: []string{},
+			requests: []string{"v2", "v1", "v2", "v1"},
+			err:      "failed to obtain signed beacon block: not found",
+		},
+		{
+			name:       "ForcedV1",
+			apiVersion: "v1",
+			versions:   []string{"v1", "v2"},
+			requests:   []string{"v1", "v1"},
+		},
+		{
+			name:       "ForcedV2",
+			apiVersion: "v2",
+			versions:   []string{"v1"},
+			requests:   []string{"v2", "v2"},
+			err:        "failed to obtain signed beacon block: not found",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			requests := make([]string, 0)
+			handlers := make(map[string]http.HandlerFunc)
+			for _, version := range []string{"v1", "v2"} {
+				version := version
+				supported := false
+				for i := range test.versions {
+					if test.versions[i] == version {
+						supported = true
+					}
+				}
+				handlers[fmt.Sprintf("/eth/%s/beacon/blocks/head", version)] = func(w http.ResponseWriter, r *http.Request) {
+					requests = append(requests, version)
+					if !supported {
+						http.NotFound(w, r)
+						return
+					}
+					respondWith(response)(w, r)
+				}
+			}
+			server := newTestServerWithHandlers(t, handlers)
+			service, err := standardhttp.New(context.Background(),
+				standardhttp.WithTimeout(timeout),
+				standardhttp.WithAddress(server.URL),
+				standardhttp.WithAPIVersion(test.apiVersion),
+			)
+			require.NoError(t, err)
+
+			// Request twice, to ensure that the negotiated version is cached.
+			for i := 0; i < 2; i++ {
+				block, err := service.SignedBeaconBlock(context.Background(), "head")
+				if test.err != "" {
+					require.EqualError(t, err, test.err)
+				} else {
+					require.NoError(t, err)
+					require.NotNil(t, block)
+				}
+			}
+			require.Equal(t, test.requests, requests)
+		})
+	}
+}
+
+func TestAPIVersionInvalid(t *testing.T) {
+	server := newTestServer(t, nil)
+	_, err := standardhttp.New(context.Background(),
+		standardhttp.WithTimeout(timeout),
+		standardhttp.WithAddress(server.URL),
+		standardhttp.WithAPIVersion("v3"),
+	)
+	require.EqualError(t, err, "problem with parameters: invalid API version specified")
+}
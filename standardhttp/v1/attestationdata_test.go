@@ -25,6 +25,8 @@ import (
 )
 
 func TestAttestationData(t *testing.T) {
+	skipWithoutNode(t)
+
 	tests := []struct {
 		name           string
 		committeeIndex spec.CommitteeIndex
@@ -0,0 +1,62 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+type attestationRewardsJSON struct {
+	ExecutionOptimistic bool                    `json:"execution_optimistic"`
+	Finalized           bool                    `json:"finalized"`
+	Data                *api.AttestationRewards `json:"data"`
+}
+
+// AttestationRewards provides the attestation rewards for the given epoch.
+// If validatorIndices is empty rewards for all validators are returned, otherwise only matching rewards are returned.
+func (s *Service) AttestationRewards(ctx context.Context,
+	epoch spec.Epoch,
+	validatorIndices []spec.ValidatorIndex,
+) (*api.AttestationRewards, error) {
+	indices := make([]string, len(validatorIndices))
+	for i := range validatorIndices {
+		indices[i] = fmt.Sprintf("%d", validatorIndices[i])
+	}
+	var reqBodyReader bytes.Buffer
+	if err := json.NewEncoder(&reqBodyReader).Encode(indices); err != nil {
+		return nil, errors.Wrap(err, "failed to encode validator indices")
+	}
+
+	respBodyReader, err := s.post(ctx, fmt.Sprintf("/eth/v1/beacon/rewards/attestations/%d", epoch), &reqBodyReader)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request attestation rewards")
+	}
+
+	var resp attestationRewardsJSON
+	if err := s.decodeJSON(respBodyReader, &resp); err != nil {
+		return nil, errors.Wrap(err, "failed to parse attestation rewards")
+	}
+	if resp.Data == nil {
+		return nil, errors.New("no attestation rewards returned")
+	}
+
+	return resp.Data, nil
+}
@@ -0,0 +1,96 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttestationRewards(t *testing.T) {
+	tests := []struct {
+		name     string
+		indices  []spec.ValidatorIndex
+		body     string
+		response string
+		status   int
+		err      string
+	}{
+		{
+			name:     "Good",
+			indices:  []spec.ValidatorIndex{1, 2},
+			body:     `["1","2"]`,
+			response: `{"execution_optimistic":false,"finalized":true,"data":{"ideal_rewards":[{"effective_balance":"32000000000","head":"2500","target":"5000","source":"4500","inactivity":"0"}],"total_rewards":[{"validator_index":"1","head":"2500","target":"5000","source":"4500","inactivity":"0"},{"validator_index":"2","head":"0","target":"-5000","source":"-4500","inactivity":"0"}]}}`,
+		},
+		{
+			name:     "AllValidators",
+			body:     `[]`,
+			response: `{"execution_optimistic":false,"finalized":true,"data":{"ideal_rewards":[],"total_rewards":[]}}`,
+		},
+		{
+			name:     "NoData",
+			body:     `[]`,
+			response: `{"execution_optimistic":false,"finalized":true}`,
+			err:      "no attestation rewards returned",
+		},
+		{
+			name:   "Error",
+			body:   `[]`,
+			status: http.StatusBadRequest,
+			err:    `failed to request attestation rewards: POST failed with status 400: Invalid epoch`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := newTestServerWithHandlers(t, map[string]http.HandlerFunc{
+				"/eth/v1/beacon/rewards/attestations/10": func(w http.ResponseWriter, r *http.Request) {
+					require.Equal(t, http.MethodPost, r.Method)
+					body, err := ioutil.ReadAll(r.Body)
+					require.NoError(t, err)
+					require.JSONEq(t, test.body, string(body))
+					if test.status != 0 {
+						w.WriteHeader(test.status)
+						fmt.Fprint(w, `{"code":400,"message":"Invalid epoch"}`)
+						return
+					}
+					fmt.Fprint(w, test.response)
+				},
+			})
+			service, err := standardhttp.New(context.Background(),
+				standardhttp.WithTimeout(timeout),
+				standardhttp.WithAddress(server.URL),
+			)
+			require.NoError(t, err)
+
+			rewards, err := service.AttestationRewards(context.Background(), 10, test.indices)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, rewards.TotalRewards, len(test.indices))
+			for i, reward := range rewards.TotalRewards {
+				require.Equal(t, test.indices[i], reward.ValidatorIndex)
+			}
+		})
+	}
+}
@@ -16,7 +16,6 @@ package v1
 import (
 	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -26,11 +25,30 @@ import (
 )
 
 type attesterDutiesJSON struct {
-	Data []*api.AttesterDuty `json:"data"`
+	DependentRoot       string              `json:"dependent_root"`
+	ExecutionOptimistic bool                `json:"execution_optimistic"`
+	Data                []*api.AttesterDuty `json:"data"`
 }
 
 // AttesterDuties obtains attester duties.
+// If the duties cache is enabled, duties previously obtained for the epoch are served from the cache until they
+// expire, or until a change of dependent root for the epoch is noticed by a request for uncached validators, by
+// DutiesDependentRoot or by the duties refresh.
 func (s *Service) AttesterDuties(ctx context.Context, epoch spec.Epoch, validatorIndices []spec.ValidatorIndex) ([]*api.AttesterDuty, error) {
+	if s.dutiesCacheTTL > 0 {
+		return s.cachedAttesterDuties(ctx, epoch, validatorIndices)
+	}
+
+	resp, err := s.attesterDuties(ctx, epoch, validatorIndices)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Data, nil
+}
+
+// attesterDuties obtains the full attester duties response.
+func (s *Service) attesterDuties(ctx context.Context, epoch spec.Epoch, validatorIndices []spec.ValidatorIndex) (*attesterDutiesJSON, error) {
 	// Try a POST request.
 	var reqBodyReader bytes.Buffer
 	if _, err := reqBodyReader.WriteString(`[`); err != nil {
@@ -68,9 +86,12 @@ func (s *Service) AttesterDuties(ctx context.Context, epoch spec.Epoch, validato
 	}
 
 	var resp attesterDutiesJSON
-	if err := json.NewDecoder(respBodyReader).Decode(&resp); err != nil {
+	if err := s.decodeJSON(respBodyReader, &resp); err != nil {
 		return nil, errors.Wrap(err, "failed to parse attester duties response")
 	}
+	for _, duty := range resp.Data {
+		duty.ExecutionOptimistic = resp.ExecutionOptimistic
+	}
 
-	return resp.Data, nil
+	return &resp, nil
 }
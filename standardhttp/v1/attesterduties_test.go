@@ -24,6 +24,8 @@ import (
 )
 
 func TestAttesterDuties(t *testing.T) {
+	skipWithoutNode(t)
+
 	tests := []struct {
 		name         string
 		epoch        spec.Epoch
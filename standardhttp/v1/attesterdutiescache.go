@@ -0,0 +1,197 @@
+// Copyright © 2020 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// attesterDutiesCacheEntry holds the attester duties obtained for an epoch with a given dependent root.
+type attesterDutiesCacheEntry struct {
+	// expiry is set when the entry is created, and does not change.
+	expiry time.Time
+
+	// mutex protects the fields below, and is held whilst duties for the epoch are requested so that concurrent
+	// callers for the same epoch do not request the same duties.
+	mutex         sync.Mutex
+	dependentRoot string
+	// fetched are the validators for which duties have been requested.  Validators without
+	// duties, for example because they are not active, are present with a nil duty.
+	fetched map[spec.ValidatorIndex]*api.AttesterDuty
+}
+
+// newAttesterDutiesCacheEntry creates a new, empty, cache entry.
+func (s *Service) newAttesterDutiesCacheEntry() *attesterDutiesCacheEntry {
+	return &attesterDutiesCacheEntry{
+		expiry:  time.Now().Add(s.dutiesCacheTTL),
+		fetched: make(map[spec.ValidatorIndex]*api.AttesterDuty),
+	}
+}
+
+// attesterDutiesCacheEntryForEpoch provides the cache entry for the epoch, replacing it if it has expired.
+func (s *Service) attesterDutiesCacheEntryForEpoch(epoch spec.Epoch) *attesterDutiesCacheEntry {
+	s.attesterDutiesCacheMutex.Lock()
+	defer s.attesterDutiesCacheMutex.Unlock()
+
+	entry, exists := s.attesterDutiesCache[epoch]
+	if !exists || time.Now().After(entry.expiry) {
+		s.pruneAttesterDutiesCache()
+		entry = s.newAttesterDutiesCacheEntry()
+		s.attesterDutiesCache[epoch] = entry
+	}
+
+	return entry
+}
+
+// cachedAttesterDuties obtains attester duties, serving those previously obtained for the epoch from the cache
+// and only requesting duties for validators that are not present.
+// Duties served entirely from the cache are not checked against the current dependent root for the epoch.  Cached
+// duties are discarded when a request for validators that are not present, a call to DutiesDependentRoot, or the
+// duties refresh finds that the dependent root has changed.
+// Callers receive copies of the cached duties.
+func (s *Service) cachedAttesterDuties(ctx context.Context, epoch spec.Epoch, validatorIndices []spec.ValidatorIndex) ([]*api.AttesterDuty, error) {
+	entry := s.attesterDutiesCacheEntryForEpoch(epoch)
+	entry.mutex.Lock()
+	defer entry.mutex.Unlock()
+
+	missing := make([]spec.ValidatorIndex, 0)
+	for _, index := range validatorIndices {
+		if _, fetched := entry.fetched[index]; !fetched {
+			missing = append(missing, index)
+		}
+	}
+
+	if len(missing) > 0 {
+		resp, err := s.attesterDuties(ctx, epoch, missing)
+		if err != nil {
+			return nil, err
+		}
+		if len(entry.fetched) > 0 && resp.DependentRoot != entry.dependentRoot {
+			// Dependent root has changed so cached duties are no longer valid; obtain all duties afresh.
+			s.log.Trace().Uint64("epoch", uint64(epoch)).Msg("Dependent root changed; discarding cached attester duties")
+			entry.fetched = make(map[spec.ValidatorIndex]*api.AttesterDuty)
+			missing = validatorIndices
+			resp, err = s.attesterDuties(ctx, epoch, missing)
+			if err != nil {
+				return nil, err
+			}
+		}
+		entry.dependentRoot = resp.DependentRoot
+		for _, index := range missing {
+			entry.fetched[index] = nil
+		}
+		for _, duty := range resp.Data {
+			entry.fetched[duty.ValidatorIndex] = duty
+		}
+	}
+
+	duties := make([]*api.AttesterDuty, 0, len(validatorIndices))
+	for _, index := range validatorIndices {
+		if duty := entry.fetched[index]; duty != nil {
+			// Copy the duty, so that callers cannot alter the cache.
+			dutyCopy := *duty
+			duties = append(duties, &dutyCopy)
+		}
+	}
+
+	return duties, nil
+}
+
+// expireAttesterDuties removes cached attester duties for the epoch if they were obtained with a different dependent root.
+func (s *Service) expireAttesterDuties(epoch spec.Epoch, dependentRoot string) {
+	s.attesterDutiesCacheMutex.Lock()
+	entry, exists := s.attesterDutiesCache[epoch]
+	s.attesterDutiesCacheMutex.Unlock()
+	if !exists {
+		return
+	}
+
+	entry.mutex.Lock()
+	expired := len(entry.fetched) > 0 && entry.dependentRoot != dependentRoot
+	entry.mutex.Unlock()
+	if !expired {
+		return
+	}
+
+	s.attesterDutiesCacheMutex.Lock()
+	defer s.attesterDutiesCacheMutex.Unlock()
+	// Only remove the entry if it has not already been replaced.
+	if s.attesterDutiesCache[epoch] == entry {
+		delete(s.attesterDutiesCache, epoch)
+	}
+}
+
+// pruneAttesterDutiesCache removes expired entries from the cache.
+// It must be called with the cache mutex held.
+func (s *Service) pruneAttesterDutiesCache() {
+	now := time.Now()
+	for epoch, entry := range s.attesterDutiesCache {
+		if now.After(entry.expiry) {
+			delete(s.attesterDutiesCache, epoch)
+		}
+	}
+}
+
+// refreshAttesterDuties obtains attester duties for an epoch from the node for all validators present in the cache,
+// replacing any cached duties for the epoch.
+func (s *Service) refreshAttesterDuties(ctx context.Context, epoch spec.Epoch) error {
+	s.attesterDutiesCacheMutex.Lock()
+	entries := make([]*attesterDutiesCacheEntry, 0, len(s.attesterDutiesCache))
+	for _, entry := range s.attesterDutiesCache {
+		entries = append(entries, entry)
+	}
+	s.attesterDutiesCacheMutex.Unlock()
+	known := make(map[spec.ValidatorIndex]bool)
+	for _, entry := range entries {
+		entry.mutex.Lock()
+		for index := range entry.fetched {
+			known[index] = true
+		}
+		entry.mutex.Unlock()
+	}
+	if len(known) == 0 {
+		// No validators of interest.
+		return nil
+	}
+	validatorIndices := make([]spec.ValidatorIndex, 0, len(known))
+	for index := range known {
+		validatorIndices = append(validatorIndices, index)
+	}
+
+	resp, err := s.attesterDuties(ctx, epoch, validatorIndices)
+	if err != nil {
+		return err
+	}
+
+	entry := s.newAttesterDutiesCacheEntry()
+	entry.dependentRoot = resp.DependentRoot
+	for _, index := range validatorIndices {
+		entry.fetched[index] = nil
+	}
+	for _, duty := range resp.Data {
+		entry.fetched[duty.ValidatorIndex] = duty
+	}
+
+	s.attesterDutiesCacheMutex.Lock()
+	defer s.attesterDutiesCacheMutex.Unlock()
+	s.pruneAttesterDutiesCache()
+	s.attesterDutiesCache[epoch] = entry
+
+	return nil
+}
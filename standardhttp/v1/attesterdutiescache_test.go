@@ -0,0 +1,176 @@
+// Copyright © 2020 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttesterDutiesCache(t *testing.T) {
+	dutyJSON := `{"pubkey":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f","slot":"32","validator_index":"%s","committee_index":"0","committee_length":"128","committees_at_slot":"1","validator_committee_index":"0"}`
+	dependentRoot := "0x0101010101010101010101010101010101010101010101010101010101010101"
+	requests := make([][]string, 0)
+	server := newTestServerWithHandlers(t, map[string]http.HandlerFunc{
+		"/eth/v1/validator/duties/attester/1": func(w http.ResponseWriter, r *http.Request) {
+			var indices []string
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&indices))
+			requests = append(requests, indices)
+			duties := make([]string, 0, len(indices))
+			for _, index := range indices {
+				// Validator 3 is not active, so has no duties.
+				if index != "3" {
+					duties = append(duties, fmt.Sprintf(dutyJSON, index))
+				}
+			}
+			fmt.Fprintf(w, `{"dependent_root":"%s","data":[%s]}`, dependentRoot, strings.Join(duties, ","))
+		},
+	})
+	service, err := standardhttp.New(context.Background(),
+		standardhttp.WithTimeout(timeout),
+		standardhttp.WithAddress(server.URL),
+		standardhttp.WithDutiesCacheTTL(time.Minute),
+	)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	duties, err := service.AttesterDuties(ctx, 1, []spec.ValidatorIndex{1, 2, 3})
+	require.NoError(t, err)
+	require.Len(t, duties, 2)
+	require.Equal(t, [][]string{{"1", "2", "3"}}, requests)
+
+	// Overlapping request only fetches missing validators.
+	duties, err = service.AttesterDuties(ctx, 1, []spec.ValidatorIndex{2, 3, 4})
+	require.NoError(t, err)
+	require.Len(t, duties, 2)
+	require.Equal(t, spec.ValidatorIndex(2), duties[0].ValidatorIndex)
+	require.Equal(t, spec.ValidatorIndex(4), duties[1].ValidatorIndex)
+	require.Equal(t, [][]string{{"1", "2", "3"}, {"4"}}, requests)
+
+	// Fully cached request does not fetch.
+	_, err = service.AttesterDuties(ctx, 1, []spec.ValidatorIndex{1, 4})
+	require.NoError(t, err)
+	require.Len(t, requests, 2)
+
+	// Change of dependent root discards the cache.
+	dependentRoot = "0x0202020202020202020202020202020202020202020202020202020202020202"
+	duties, err = service.AttesterDuties(ctx, 1, []spec.ValidatorIndex{1, 5})
+	require.NoError(t, err)
+	require.Len(t, duties, 2)
+	require.Equal(t, [][]string{{"1", "2", "3"}, {"4"}, {"5"}, {"1", "5"}}, requests)
+
+	// Checking the dependent root also discards the cache if it has changed.
+	dependentRoot = "0x0303030303030303030303030303030303030303030303030303030303030303"
+	_, err = service.DutiesDependentRoot(ctx, 1)
+	require.NoError(t, err)
+	_, err = service.AttesterDuties(ctx, 1, []spec.ValidatorIndex{1})
+	require.NoError(t, err)
+	require.Equal(t, []string{"1"}, requests[len(requests)-1])
+}
+
+func TestAttesterDutiesCacheExpiry(t *testing.T) {
+	requests := 0
+	server := newTestServerWithHandlers(t, map[string]http.HandlerFunc{
+		"/eth/v1/validator/duties/attester/1": func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			_, _ = w.Write([]byte(`{"dependent_root":"0x0101010101010101010101010101010101010101010101010101010101010101","data":[]}`))
+		},
+	})
+	service, err := standardhttp.New(context.Background(),
+		standardhttp.WithTimeout(timeout),
+		standardhttp.WithAddress(server.URL),
+		standardhttp.WithDutiesCacheTTL(100*time.Millisecond),
+	)
+	require.NoError(t, err)
+
+	_, err = service.AttesterDuties(context.Background(), 1, []spec.ValidatorIndex{1})
+	require.NoError(t, err)
+	_, err = service.AttesterDuties(context.Background(), 1, []spec.ValidatorIndex{1})
+	require.NoError(t, err)
+	require.Equal(t, 1, requests)
+
+	time.Sleep(200 * time.Millisecond)
+	_, err = service.AttesterDuties(context.Background(), 1, []spec.ValidatorIndex{1})
+	require.NoError(t, err)
+	require.Equal(t, 2, requests)
+}
+
+func TestAttesterDutiesCacheCopies(t *testing.T) {
+	server := newTestServerWithHandlers(t, map[string]http.HandlerFunc{
+		"/eth/v1/validator/duties/attester/1": func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"dependent_root":"0x0101010101010101010101010101010101010101010101010101010101010101","data":[{"pubkey":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f","slot":"32","validator_index":"1","committee_index":"0","committee_length":"128","committees_at_slot":"1","validator_committee_index":"0"}]}`))
+		},
+	})
+	service, err := standardhttp.New(context.Background(),
+		standardhttp.WithTimeout(timeout),
+		standardhttp.WithAddress(server.URL),
+		standardhttp.WithDutiesCacheTTL(time.Minute),
+	)
+	require.NoError(t, err)
+
+	// Altering returned duties does not alter the cache.
+	duties, err := service.AttesterDuties(context.Background(), 1, []spec.ValidatorIndex{1})
+	require.NoError(t, err)
+	require.Len(t, duties, 1)
+	duties[0].Slot = 99
+	duties, err = service.AttesterDuties(context.Background(), 1, []spec.ValidatorIndex{1})
+	require.NoError(t, err)
+	require.Equal(t, spec.Slot(32), duties[0].Slot)
+}
+
+func TestAttesterDutiesCacheConcurrentEpochs(t *testing.T) {
+	epoch2Requested := make(chan struct{})
+	server := newTestServerWithHandlers(t, map[string]http.HandlerFunc{
+		"/eth/v1/validator/duties/attester/1": func(w http.ResponseWriter, r *http.Request) {
+			// Only respond once duties for epoch 2 have also been requested.
+			select {
+			case <-epoch2Requested:
+			case <-time.After(5 * time.Second):
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			_, _ = w.Write([]byte(`{"dependent_root":"0x0101010101010101010101010101010101010101010101010101010101010101","data":[]}`))
+		},
+		"/eth/v1/validator/duties/attester/2": func(w http.ResponseWriter, r *http.Request) {
+			close(epoch2Requested)
+			_, _ = w.Write([]byte(`{"dependent_root":"0x0101010101010101010101010101010101010101010101010101010101010101","data":[]}`))
+		},
+	})
+	service, err := standardhttp.New(context.Background(),
+		standardhttp.WithTimeout(timeout),
+		standardhttp.WithAddress(server.URL),
+		standardhttp.WithDutiesCacheTTL(time.Minute),
+	)
+	require.NoError(t, err)
+
+	// Requests for different epochs are not serialised by the cache.
+	errs := make(chan error, 1)
+	go func() {
+		_, err := service.AttesterDuties(context.Background(), 1, []spec.ValidatorIndex{1})
+		errs <- err
+	}()
+	time.Sleep(50 * time.Millisecond)
+	_, err = service.AttesterDuties(context.Background(), 2, []spec.ValidatorIndex{1})
+	require.NoError(t, err)
+	require.NoError(t, <-errs)
+}
@@ -0,0 +1,133 @@
+// Copyright © 2020 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"math/rand"
+	"time"
+
+	"gopkg.in/cenkalti/backoff.v1"
+)
+
+const (
+	// reconnectInitialInterval is the delay before the first reconnection attempt, before jitter.
+	reconnectInitialInterval = 500 * time.Millisecond
+	// reconnectMaxInterval is the longest delay between reconnection attempts, before jitter.
+	reconnectMaxInterval = time.Minute
+)
+
+// Backoff is a strategy for the delays between successive attempts, used when reconnecting.
+type Backoff interface {
+	// NextDelay returns the delay before the given attempt, where the first attempt after a failure is 1.
+	NextDelay(attempt int) time.Duration
+}
+
+// ExponentialBackoff is a backoff that doubles the delay with each attempt, up to a maximum, with optional full jitter.
+type ExponentialBackoff struct {
+	initialInterval time.Duration
+	maxInterval     time.Duration
+	jitter          bool
+}
+
+// NewExponentialBackoff creates a new exponential backoff.
+// The delay starts at initialInterval and doubles with each attempt, up to maxInterval.  With jitter the delay is
+// chosen uniformly between 0 and the current interval.
+func NewExponentialBackoff(initialInterval time.Duration, maxInterval time.Duration, jitter bool) *ExponentialBackoff {
+	return &ExponentialBackoff{
+		initialInterval: initialInterval,
+		maxInterval:     maxInterval,
+		jitter:          jitter,
+	}
+}
+
+// NextDelay returns the delay before the given attempt.
+func (b *ExponentialBackoff) NextDelay(attempt int) time.Duration {
+	interval := b.initialInterval
+	for i := 1; i < attempt && interval < b.maxInterval; i++ {
+		if interval < b.maxInterval/2 {
+			interval *= 2
+		} else {
+			interval = b.maxInterval
+		}
+	}
+
+	if !b.jitter {
+		return interval
+	}
+	return time.Duration(rand.Int63n(int64(interval) + 1))
+}
+
+// ConstantBackoff is a backoff that has the same delay for every attempt.
+type ConstantBackoff struct {
+	interval time.Duration
+}
+
+// NewConstantBackoff creates a new constant backoff.
+func NewConstantBackoff(interval time.Duration) *ConstantBackoff {
+	return &ConstantBackoff{
+		interval: interval,
+	}
+}
+
+// NextDelay returns the delay before the given attempt.
+func (b *ConstantBackoff) NextDelay(_ int) time.Duration {
+	return b.interval
+}
+
+// reconnectBackOff adapts a Backoff to track its own attempts, resetting them as required.
+// It implements backoff.BackOff.
+type reconnectBackOff struct {
+	strategy Backoff
+	attempt  int
+}
+
+// newReconnectBackOff creates a new reconnection backoff using the service's backoff strategy.
+// If no strategy has been supplied this is exponential, with jitter if requested.
+func (s *Service) newReconnectBackOff() *reconnectBackOff {
+	strategy := s.backoff
+	if strategy == nil {
+		strategy = NewExponentialBackoff(reconnectInitialInterval, reconnectMaxInterval, s.retryJitter)
+	}
+
+	return &reconnectBackOff{
+		strategy: strategy,
+	}
+}
+
+// Reset resets the backoff to its first attempt.
+func (b *reconnectBackOff) Reset() {
+	b.attempt = 0
+}
+
+// NextBackOff returns the delay before the next attempt.
+func (b *reconnectBackOff) NextBackOff() time.Duration {
+	b.attempt++
+	return b.strategy.NextDelay(b.attempt)
+}
+
+// budgetBackOff wraps a backoff, stopping once a shared count of attempts reaches a maximum.
+// The count is maintained by the caller, so that it can be reset when a connection succeeds.
+type budgetBackOff struct {
+	backoff.BackOff
+	maxAttempts int
+	attempts    *int
+}
+
+// NextBackOff returns the delay before the next attempt, or backoff.Stop if the budget is exhausted.
+func (b *budgetBackOff) NextBackOff() time.Duration {
+	if *b.attempts >= b.maxAttempts {
+		return backoff.Stop
+	}
+	return b.BackOff.NextBackOff()
+}
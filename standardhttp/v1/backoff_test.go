@@ -0,0 +1,114 @@
+// Copyright © 2020 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReconnectBackOff(t *testing.T) {
+	tests := []struct {
+		name   string
+		jitter bool
+	}{
+		{
+			name: "NoJitter",
+		},
+		{
+			name:   "Jitter",
+			jitter: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := &Service{retryJitter: test.jitter}
+			b := s.newReconnectBackOff()
+
+			// Check successive delays are within bounds.
+			bound := reconnectInitialInterval
+			for i := 0; i < 20; i++ {
+				delay := b.NextBackOff()
+				if test.jitter {
+					require.True(t, delay >= 0 && delay <= bound, "delay %v outside of [0,%v]", delay, bound)
+				} else {
+					require.Equal(t, bound, delay)
+				}
+				bound *= 2
+				if bound > reconnectMaxInterval {
+					bound = reconnectMaxInterval
+				}
+			}
+			if test.jitter {
+				// Delays at the maximum interval should vary.
+				maxDelays := make(map[time.Duration]bool)
+				for i := 0; i < 10; i++ {
+					maxDelays[b.NextBackOff()] = true
+				}
+				require.Greater(t, len(maxDelays), 1)
+			}
+
+			// Reset returns to the initial interval.
+			b.Reset()
+			delay := b.NextBackOff()
+			require.True(t, delay <= reconnectInitialInterval)
+		})
+	}
+}
+
+func TestExponentialBackoff(t *testing.T) {
+	b := NewExponentialBackoff(time.Second, 10*time.Second, false)
+	require.Equal(t, time.Second, b.NextDelay(1))
+	require.Equal(t, 2*time.Second, b.NextDelay(2))
+	require.Equal(t, 8*time.Second, b.NextDelay(4))
+	require.Equal(t, 10*time.Second, b.NextDelay(5))
+	require.Equal(t, 10*time.Second, b.NextDelay(1000))
+
+	b = NewExponentialBackoff(time.Second, 10*time.Second, true)
+	for attempt := 1; attempt < 10; attempt++ {
+		delay := b.NextDelay(attempt)
+		require.True(t, delay >= 0 && delay <= 10*time.Second, "delay %v outside of [0,%v]", delay, 10*time.Second)
+	}
+}
+
+func TestConstantBackoff(t *testing.T) {
+	b := NewConstantBackoff(3 * time.Second)
+	require.Equal(t, 3*time.Second, b.NextDelay(1))
+	require.Equal(t, 3*time.Second, b.NextDelay(100))
+}
+
+// decorrelatedBackoff is a custom backoff for testing, with a delay based on the attempt.
+type decorrelatedBackoff struct {
+	attempts []int
+}
+
+func (b *decorrelatedBackoff) NextDelay(attempt int) time.Duration {
+	b.attempts = append(b.attempts, attempt)
+	return time.Duration(attempt) * time.Millisecond
+}
+
+func TestReconnectBackOffCustom(t *testing.T) {
+	strategy := &decorrelatedBackoff{}
+	s := &Service{backoff: strategy}
+	b := s.newReconnectBackOff()
+
+	require.Equal(t, time.Millisecond, b.NextBackOff())
+	require.Equal(t, 2*time.Millisecond, b.NextBackOff())
+	b.Reset()
+	require.Equal(t, time.Millisecond, b.NextBackOff())
+	require.Equal(t, []int{1, 2, 1}, strategy.attempts)
+}
@@ -21,5 +21,5 @@ import (
 
 // BeaconAttesterDomain provides the beacon attester domain of the chain.
 func (s *Service) BeaconAttesterDomain(ctx context.Context) (spec.DomainType, error) {
-	return s.spec["DOMAIN_BEACON_ATTESTER"].(spec.DomainType), nil
+	return s.chainConfig.DomainBeaconAttester, nil
 }
@@ -23,6 +23,8 @@ import (
 )
 
 func TestBeaconAttesterDomain(t *testing.T) {
+	skipWithoutNode(t)
+
 	tests := []struct {
 		name string
 	}{
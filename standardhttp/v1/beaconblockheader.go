@@ -15,15 +15,17 @@ package v1
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
+	client "github.com/attestantio/go-eth2-client"
 	api "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/pkg/errors"
 )
 
 type beaconBlockHeaderJSON struct {
-	Data *api.BeaconBlockHeader `json:"data"`
+	ExecutionOptimistic bool                   `json:"execution_optimistic"`
+	Finalized           bool                   `json:"finalized"`
+	Data                *api.BeaconBlockHeader `json:"data"`
 }
 
 // BeaconBlockHeader provides the block header of a given block ID.
@@ -33,13 +35,17 @@ func (s *Service) BeaconBlockHeader(ctx context.Context, blockID string) (*api.B
 		return nil, errors.Wrap(err, "failed to request beacon block header")
 	}
 	if respBodyReader == nil {
-		return nil, errors.New("failed to obtain beacon block header")
+		return nil, errors.Wrap(client.ErrNotFound, "failed to obtain beacon block header")
 	}
 
 	var resp beaconBlockHeaderJSON
-	if err := json.NewDecoder(respBodyReader).Decode(&resp); err != nil {
+	if err := s.decodeJSON(respBodyReader, &resp); err != nil {
 		return nil, errors.Wrap(err, "failed to parse beacon block header")
 	}
+	if resp.Data != nil {
+		resp.Data.ExecutionOptimistic = resp.ExecutionOptimistic
+		resp.Data.Finalized = resp.Finalized
+	}
 
 	return resp.Data, nil
 }
@@ -23,6 +23,8 @@ import (
 )
 
 func TestBeaconBlockHeader(t *testing.T) {
+	skipWithoutNode(t)
+
 	tests := []struct {
 		name    string
 		stateID string
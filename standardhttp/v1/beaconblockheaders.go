@@ -0,0 +1,72 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+type beaconBlockHeadersJSON struct {
+	ExecutionOptimistic bool                     `json:"execution_optimistic"`
+	Finalized           bool                     `json:"finalized"`
+	Data                []*api.BeaconBlockHeader `json:"data"`
+}
+
+// BeaconBlockHeaders provides the block headers matching the optional slot and parent root filters.  This
+// includes non-canonical headers.  If no filters are supplied the node returns the header of the head block.
+func (s *Service) BeaconBlockHeaders(ctx context.Context, slot *spec.Slot, parentRoot *spec.Root) ([]*api.BeaconBlockHeader, error) {
+	endpoint := "/eth/v1/beacon/headers"
+	params := url.Values{}
+	if slot != nil {
+		params.Set("slot", fmt.Sprintf("%d", *slot))
+	}
+	if parentRoot != nil {
+		params.Set("parent_root", hexParam(parentRoot[:]))
+	}
+	if len(params) > 0 {
+		endpoint = fmt.Sprintf("%s?%s", endpoint, params.Encode())
+	}
+
+	respBodyReader, err := s.get(ctx, endpoint)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request beacon block headers")
+	}
+	if respBodyReader == nil {
+		// No headers match the filters.
+		return []*api.BeaconBlockHeader{}, nil
+	}
+
+	var resp beaconBlockHeadersJSON
+	if err := s.decodeJSON(respBodyReader, &resp); err != nil {
+		return nil, errors.Wrap(err, "failed to parse beacon block headers")
+	}
+	if resp.Data == nil {
+		return nil, errors.New("no beacon block headers returned")
+	}
+	for i := range resp.Data {
+		if resp.Data[i] == nil {
+			return nil, fmt.Errorf("beacon block header %d missing", i)
+		}
+		resp.Data[i].ExecutionOptimistic = resp.ExecutionOptimistic
+		resp.Data[i].Finalized = resp.Finalized
+	}
+
+	return resp.Data, nil
+}
@@ -0,0 +1,99 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBeaconBlockHeaders(t *testing.T) {
+	header := `{"root":"0xbc354f1a5f27f8d096eee9e6b6139e1b730385f9752513832a57c9849a149df7","canonical":%s,"header":{"message":{"slot":"585321","proposer_index":"29787","parent_root":"0xba4d784293df28bab771a14df58cdbed9d8d64afd0ddf1c52dff3e25fcdd51df","state_root":"0x4e405274abd4f59c6a2268b4e6ca93dba01e15ae6b56401fb20a1ad9701b036d","body_root":"0x57bb79520694c132a35dc887cac2e4dad9acc5ded58b5ae66b491644ab8835c8"},"signature":"0xa8d684242ee025ee96e877b28433d93176072b8c8e8295609501863147bb1d174b8a16aed661d001f30859c9e42c0f9d18ea35786a9bdf115dff1877980046e19e0e4c9310e281f8129f2692ddc4680673ab78b7f8db72f91be7863dd9fe1e55"}}`
+	slot := spec.Slot(585321)
+	parentRoot := spec.Root{0x01, 0x02}
+	tests := []struct {
+		name       string
+		slot       *spec.Slot
+		parentRoot *spec.Root
+		query      string
+		response   string
+		headers    int
+		err        string
+	}{
+		{
+			name:     "NoFilters",
+			response: fmt.Sprintf(`{"execution_optimistic":false,"data":[%s]}`, fmt.Sprintf(header, "true")),
+			headers:  1,
+		},
+		{
+			name:     "Slot",
+			slot:     &slot,
+			query:    "slot=585321",
+			response: fmt.Sprintf(`{"execution_optimistic":true,"data":[%s,%s]}`, fmt.Sprintf(header, "true"), fmt.Sprintf(header, "false")),
+			headers:  2,
+		},
+		{
+			name:       "SlotAndParentRoot",
+			slot:       &slot,
+			parentRoot: &parentRoot,
+			query:      "parent_root=0x0102000000000000000000000000000000000000000000000000000000000000&slot=585321",
+			response:   fmt.Sprintf(`{"data":[%s]}`, fmt.Sprintf(header, "true")),
+			headers:    1,
+		},
+		{
+			name:     "NoneFound",
+			slot:     &slot,
+			query:    "slot=585321",
+			response: `{"data":[]}`,
+		},
+		{
+			name:     "DataMissing",
+			response: `{}`,
+			err:      "no beacon block headers returned",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := newTestServerWithHandlers(t, map[string]http.HandlerFunc{
+				"/eth/v1/beacon/headers": func(w http.ResponseWriter, r *http.Request) {
+					require.Equal(t, test.query, r.URL.RawQuery)
+					respondWith(test.response)(w, r)
+				},
+			})
+			service, err := standardhttp.New(context.Background(),
+				standardhttp.WithTimeout(timeout),
+				standardhttp.WithAddress(server.URL),
+			)
+			require.NoError(t, err)
+
+			headers, err := service.BeaconBlockHeaders(context.Background(), test.slot, test.parentRoot)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Len(t, headers, test.headers)
+				for _, header := range headers {
+					require.Equal(t, slot, header.Header.Message.Slot)
+				}
+			}
+		})
+	}
+}
@@ -16,9 +16,9 @@ package v1
 import (
 	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 
+	client "github.com/attestantio/go-eth2-client"
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 )
@@ -29,14 +29,24 @@ type beaconBlockProposalJSON struct {
 
 // BeaconBlockProposal fetches a proposed beacon block for signing.
 func (s *Service) BeaconBlockProposal(ctx context.Context, slot spec.Slot, randaoReveal spec.BLSSignature, graffiti []byte) (*spec.BeaconBlock, error) {
+	return s.BeaconBlockProposalV2(ctx, slot, randaoReveal, graffiti)
+}
+
+// BeaconBlockProposalV2 fetches a proposed beacon block for signing, with the supplied options.
+func (s *Service) BeaconBlockProposalV2(ctx context.Context, slot spec.Slot, randaoReveal spec.BLSSignature, graffiti []byte, opts ...client.BeaconBlockProposalOption) (*spec.BeaconBlock, error) {
+	options := client.NewBeaconBlockProposalOpts(opts...)
+
 	// Graffiti should be 32 bytes.
 	fixedGraffiti := make([]byte, 32)
 	copy(fixedGraffiti, graffiti)
 
-	url := fmt.Sprintf("/eth/v1/validator/blocks/%d?randao_reveal=%#x&graffiti=%#x", slot, randaoReveal, fixedGraffiti)
+	url := fmt.Sprintf("/eth/v1/validator/blocks/%d?randao_reveal=%s&graffiti=%s", slot, hexParam(randaoReveal[:]), hexParam(fixedGraffiti))
+	if options.SkipRandaoVerification {
+		url = fmt.Sprintf("%s&skip_randao_verification", url)
+	}
 	respBodyReader, err := s.get(ctx, url)
 	if err != nil {
-		log.Trace().Str("url", url).Err(err).Msg("Request failed")
+		s.log.Trace().Str("url", url).Err(err).Msg("Request failed")
 		return nil, errors.Wrap(err, "failed to request beacon block proposal")
 	}
 	if respBodyReader == nil {
@@ -44,7 +54,7 @@ func (s *Service) BeaconBlockProposal(ctx context.Context, slot spec.Slot, randa
 	}
 
 	var resp beaconBlockProposalJSON
-	if err := json.NewDecoder(respBodyReader).Decode(&resp); err != nil {
+	if err := s.decodeJSON(respBodyReader, &resp); err != nil {
 		return nil, errors.Wrap(err, "failed to parse beacon block proposal")
 	}
 
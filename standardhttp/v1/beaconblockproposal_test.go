@@ -15,10 +15,14 @@ package v1_test
 
 import (
 	"context"
+	"fmt"
+	"net/http"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
+	client "github.com/attestantio/go-eth2-client"
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
 	"github.com/stretchr/testify/assert"
@@ -26,6 +30,8 @@ import (
 )
 
 func TestBeaconBlockProposal(t *testing.T) {
+	skipWithoutNode(t)
+
 	tests := []struct {
 		name         string
 		randaoReveal spec.BLSSignature
@@ -71,3 +77,51 @@ func TestBeaconBlockProposal(t *testing.T) {
 		})
 	}
 }
+
+func TestBeaconBlockProposalV2SkipRandaoVerification(t *testing.T) {
+	baseQuery := fmt.Sprintf("randao_reveal=0xc0%s&graffiti=0x%s", strings.Repeat("00", 95), strings.Repeat("00", 32))
+	tests := []struct {
+		name  string
+		opts  []client.BeaconBlockProposalOption
+		query string
+	}{
+		{
+			name:  "Default",
+			query: baseQuery,
+		},
+		{
+			name:  "SkipFalse",
+			opts:  []client.BeaconBlockProposalOption{client.WithSkipRandaoVerification(false)},
+			query: baseQuery,
+		},
+		{
+			name:  "SkipTrue",
+			opts:  []client.BeaconBlockProposalOption{client.WithSkipRandaoVerification(true)},
+			query: baseQuery + "&skip_randao_verification",
+		},
+	}
+
+	// Point at infinity, as required when skipping RANDAO verification.
+	randaoReveal := spec.BLSSignature{0xc0}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var query string
+			server := newTestServerWithHandlers(t, map[string]http.HandlerFunc{
+				"/eth/v1/validator/blocks/1": func(w http.ResponseWriter, r *http.Request) {
+					query = r.URL.RawQuery
+					http.Error(w, "no proposal", http.StatusInternalServerError)
+				},
+			})
+			service, err := standardhttp.New(context.Background(),
+				standardhttp.WithTimeout(timeout),
+				standardhttp.WithAddress(server.URL),
+			)
+			require.NoError(t, err)
+
+			_, err = service.BeaconBlockProposalV2(context.Background(), 1, randaoReveal, nil, test.opts...)
+			require.Error(t, err)
+			require.Equal(t, test.query, query)
+		})
+	}
+}
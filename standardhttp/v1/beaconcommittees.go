@@ -15,9 +15,9 @@ package v1
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
+	client "github.com/attestantio/go-eth2-client"
 	api "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/pkg/errors"
 )
@@ -31,15 +31,15 @@ func (s *Service) BeaconCommittees(ctx context.Context, stateID string) ([]*api.
 	url := fmt.Sprintf("/eth/v1/beacon/states/%s/committees", stateID)
 	respBodyReader, err := s.get(ctx, url)
 	if err != nil {
-		log.Trace().Str("url", url).Err(err).Msg("Request failed")
+		s.log.Trace().Str("url", url).Err(err).Msg("Request failed")
 		return nil, errors.Wrap(err, "failed to request beacon committees")
 	}
 	if respBodyReader == nil {
-		return nil, errors.New("failed to obtain beacon committees")
+		return nil, errors.Wrap(client.ErrNotFound, "failed to obtain beacon committees")
 	}
 
 	var resp beaconCommitteesJSON
-	if err := json.NewDecoder(respBodyReader).Decode(&resp); err != nil {
+	if err := s.decodeJSON(respBodyReader, &resp); err != nil {
 		return nil, errors.Wrap(err, "failed to parse beacon committees")
 	}
 
@@ -15,14 +15,18 @@ package v1_test
 
 import (
 	"context"
+	"errors"
 	"os"
 	"testing"
 
+	client "github.com/attestantio/go-eth2-client"
 	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
 	"github.com/stretchr/testify/require"
 )
 
 func TestBeaconCommittees(t *testing.T) {
+	skipWithoutNode(t)
+
 	tests := []struct {
 		name    string
 		stateID string
@@ -47,3 +51,15 @@ func TestBeaconCommittees(t *testing.T) {
 		})
 	}
 }
+
+func TestBeaconCommitteesNotFound(t *testing.T) {
+	server := newTestServer(t, map[string]string{})
+	service, err := standardhttp.New(context.Background(),
+		standardhttp.WithTimeout(timeout),
+		standardhttp.WithAddress(server.URL),
+	)
+	require.NoError(t, err)
+
+	_, err = service.BeaconCommittees(context.Background(), "1000")
+	require.True(t, errors.Is(err, client.ErrNotFound))
+}
@@ -21,5 +21,5 @@ import (
 
 // BeaconProposerDomain provides the beacon proposer domain of the chain.
 func (s *Service) BeaconProposerDomain(ctx context.Context) (spec.DomainType, error) {
-	return s.spec["DOMAIN_BEACON_PROPOSER"].(spec.DomainType), nil
+	return s.chainConfig.DomainBeaconProposer, nil
 }
@@ -15,34 +15,93 @@ package v1
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
+	"io/ioutil"
 
+	client "github.com/attestantio/go-eth2-client"
+	api "github.com/attestantio/go-eth2-client/api/v1"
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 )
 
 type beaconStateJSON struct {
-	Data *spec.BeaconState `json:"data"`
+	Version             string            `json:"version"`
+	ExecutionOptimistic bool              `json:"execution_optimistic"`
+	Finalized           bool              `json:"finalized"`
+	Data                *spec.BeaconState `json:"data"`
 }
 
 // BeaconState fetches a beacon state.
-// N.B if the requested beacon state is not available this will return nil without an error.
+// If SSZ is preferred it is requested as SSZ, with a single retry as JSON if the SSZ cannot be decoded and fallback is enabled.
+// N.B if the requested beacon state is not available this will return an error that wraps client.ErrNotFound.
 func (s *Service) BeaconState(ctx context.Context, stateID string) (*spec.BeaconState, error) {
-	url := fmt.Sprintf("/eth/v1/debug/beacon/states/%s", stateID)
-	respBodyReader, err := s.get(ctx, url)
+	if s.preferSSZ {
+		var beaconState spec.BeaconState
+		decoded, err := s.getSSZObject(ctx, "states", fmt.Sprintf("/debug/beacon/states/%s", stateID), "beacon state", &beaconState)
+		if err != nil {
+			return nil, err
+		}
+		if decoded {
+			return &beaconState, nil
+		}
+	}
+
+	beaconState, _, err := s.BeaconStateWithMetadata(ctx, stateID)
+	return beaconState, err
+}
+
+// BeaconStateWithMetadata fetches a beacon state, along with the response metadata.
+// N.B if the requested beacon state is not available this will return an error that wraps client.ErrNotFound.
+func (s *Service) BeaconStateWithMetadata(ctx context.Context, stateID string) (*spec.BeaconState, *api.ResponseMetadata, error) {
+	resp, _, err := s.beaconState(ctx, stateID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resp.Data, &api.ResponseMetadata{
+		ExecutionOptimistic: resp.ExecutionOptimistic,
+		Finalized:           resp.Finalized,
+	}, nil
+}
+
+// BeaconStateWithRaw fetches a beacon state, along with the raw response body.
+// N.B if the requested beacon state is not available this will return an error that wraps client.ErrNotFound.
+func (s *Service) BeaconStateWithRaw(ctx context.Context, stateID string) (*spec.BeaconState, []byte, error) {
+	resp, raw, err := s.beaconState(ctx, stateID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resp.Data, raw, nil
+}
+
+// beaconState fetches and parses a beacon state, returning the parsed response and the raw response body.
+func (s *Service) beaconState(ctx context.Context, stateID string) (*beaconStateJSON, []byte, error) {
+	url := fmt.Sprintf("/debug/beacon/states/%s", stateID)
+	respBodyReader, err := s.getVersionedRaw(ctx, "states", url, "")
 	if err != nil {
-		log.Trace().Str("url", url).Err(err).Msg("Request failed")
-		return nil, errors.Wrap(err, "failed to request beacon state")
+		s.log.Trace().Str("url", url).Err(err).Msg("Request failed")
+		return nil, nil, errors.Wrap(err, "failed to request beacon state")
 	}
 	if respBodyReader == nil {
-		return nil, nil
+		return nil, nil, errors.Wrap(client.ErrNotFound, "failed to obtain beacon state")
+	}
+
+	raw, err := ioutil.ReadAll(respBodyReader)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to read beacon state")
+	}
+
+	// The raw body is returned as sent by the node, so any lenient parsing is applied to a copy.
+	data, err := s.lenientData(raw)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	var resp beaconStateJSON
-	if err := json.NewDecoder(respBodyReader).Decode(&resp); err != nil {
-		return nil, errors.Wrap(err, "failed to parse beacon state")
+	if err := s.unmarshalJSON(data, &resp); err != nil {
+		return nil, nil, errors.Wrap(err, "failed to parse beacon state")
 	}
 
-	return resp.Data, nil
+	return &resp, raw, nil
 }
@@ -0,0 +1,125 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/pkg/errors"
+)
+
+type blobSidecarsJSON struct {
+	Data []*deneb.BlobSidecar `json:"data"`
+}
+
+// BlobSidecars fetches the blob sidecars for a given block ID.
+// blockID can be a slot number or block root, or one of the special values "genesis", "head" or "finalized".
+// indices is a list of blob indices to restrict the returned values.  If no indices are supplied no filter will be applied.
+// If SSZ is preferred it is requested as SSZ, with a single retry as JSON if the SSZ cannot be decoded and fallback is enabled.
+// N.B if the blob sidecars for the block ID are not available this will return an error that wraps client.ErrNotFound.
+func (s *Service) BlobSidecars(ctx context.Context, blockID string, indices []uint64) ([]*deneb.BlobSidecar, error) {
+	if blockID == "" {
+		return nil, errors.New("no block ID specified")
+	}
+
+	endpoint := fmt.Sprintf("/eth/v1/beacon/blob_sidecars/%s", blockID)
+	if len(indices) != 0 {
+		ids := make([]string, len(indices))
+		for i := range indices {
+			ids[i] = fmt.Sprintf("%d", indices[i])
+		}
+		endpoint = fmt.Sprintf("%s?indices=%s", endpoint, strings.Join(ids, "&indices="))
+	}
+
+	if s.preferSSZ {
+		blobSidecars, err := s.blobSidecarsSSZ(ctx, endpoint)
+		if err != nil {
+			return nil, err
+		}
+		if blobSidecars != nil {
+			return blobSidecars, nil
+		}
+	}
+
+	respBodyReader, err := s.get(ctx, endpoint)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request blob sidecars")
+	}
+	if respBodyReader == nil {
+		return nil, errors.Wrap(client.ErrNotFound, "failed to obtain blob sidecars")
+	}
+
+	var resp blobSidecarsJSON
+	if err := s.decodeJSON(respBodyReader, &resp); err != nil {
+		return nil, errors.Wrap(err, "failed to parse blob sidecars")
+	}
+	if resp.Data == nil {
+		return nil, errors.New("no blob sidecars returned")
+	}
+
+	return resp.Data, nil
+}
+
+// blobSidecarsSSZ fetches SSZ-encoded blob sidecars.
+// If the response cannot be decoded and SSZ fallback is enabled this returns nil with no
+// error, to allow the caller to retry the request with JSON.
+func (s *Service) blobSidecarsSSZ(ctx context.Context, endpoint string) ([]*deneb.BlobSidecar, error) {
+	respBodyReader, err := s.getWithAccept(ctx, endpoint, sszContentType)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request blob sidecars")
+	}
+	if respBodyReader == nil {
+		return nil, errors.Wrap(client.ErrNotFound, "failed to obtain blob sidecars")
+	}
+
+	blobSidecars, err := decodeBlobSidecarsSSZ(respBodyReader)
+	if err != nil {
+		if !s.sszFallback {
+			return nil, errors.Wrap(err, "failed to decode SSZ blob sidecars")
+		}
+		s.log.Warn().Str("endpoint", endpoint).Err(err).Msg("Failed to decode SSZ response; retrying with JSON")
+		return nil, nil
+	}
+
+	return blobSidecars, nil
+}
+
+// decodeBlobSidecarsSSZ decodes an SSZ list of blob sidecars.  Blob sidecars are of fixed size, so the list is
+// a simple concatenation of its items.
+func decodeBlobSidecarsSSZ(reader io.Reader) ([]*deneb.BlobSidecar, error) {
+	data, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read blob sidecars")
+	}
+
+	size := (&deneb.BlobSidecar{}).SizeSSZ()
+	if len(data)%size != 0 {
+		return nil, fmt.Errorf("length %d is not a multiple of blob sidecar size %d", len(data), size)
+	}
+	res := make([]*deneb.BlobSidecar, len(data)/size)
+	for i := range res {
+		res[i] = &deneb.BlobSidecar{}
+		if err := res[i].UnmarshalSSZ(data[i*size : (i+1)*size]); err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("failed to decode blob sidecar %d", i))
+		}
+	}
+
+	return res, nil
+}
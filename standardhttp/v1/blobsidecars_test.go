@@ -0,0 +1,155 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlobSidecars(t *testing.T) {
+	blobSidecars := make([]*deneb.BlobSidecar, 2)
+	var blobSidecarsSSZ []byte
+	for i := range blobSidecars {
+		blobSidecars[i] = &deneb.BlobSidecar{
+			Index: deneb.BlobIndex(i),
+			SignedBlockHeader: &spec.SignedBeaconBlockHeader{
+				Message: &spec.BeaconBlockHeader{
+					Slot: 1,
+				},
+			},
+			KZGCommitmentInclusionProof: make([][]byte, deneb.KZGCommitmentInclusionProofLength),
+		}
+		blobSidecars[i].Blob[0] = byte(i)
+		for j := range blobSidecars[i].KZGCommitmentInclusionProof {
+			blobSidecars[i].KZGCommitmentInclusionProof[j] = make([]byte, 32)
+		}
+		data, err := blobSidecars[i].MarshalSSZ()
+		require.NoError(t, err)
+		blobSidecarsSSZ = append(blobSidecarsSSZ, data...)
+	}
+	blobSidecarsJSON, err := json.Marshal(blobSidecars)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		blockID      string
+		indices      []uint64
+		query        string
+		preferSSZ    bool
+		sszResponse  []byte
+		jsonRequests int
+		expected     int
+		err          string
+	}{
+		{
+			name: "BlockIDMissing",
+			err:  "no block ID specified",
+		},
+		{
+			name:         "JSON",
+			blockID:      "head",
+			jsonRequests: 1,
+			expected:     2,
+		},
+		{
+			name:         "Indices",
+			blockID:      "head",
+			indices:      []uint64{0, 1},
+			query:        "indices=0&indices=1",
+			jsonRequests: 1,
+			expected:     2,
+		},
+		{
+			name:        "SSZ",
+			blockID:     "head",
+			preferSSZ:   true,
+			sszResponse: blobSidecarsSSZ,
+			expected:    2,
+		},
+		{
+			name:        "SSZEmpty",
+			blockID:     "head",
+			preferSSZ:   true,
+			sszResponse: []byte{},
+		},
+		{
+			name:         "SSZFallback",
+			blockID:      "head",
+			preferSSZ:    true,
+			sszResponse:  blobSidecarsSSZ[:100],
+			jsonRequests: 1,
+			expected:     2,
+		},
+		{
+			name:    "NotFound",
+			blockID: "finalized",
+			err:     "failed to obtain blob sidecars: not found",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			jsonRequests := 0
+			server := newTestServerWithHandlers(t, map[string]http.HandlerFunc{
+				"/eth/v1/beacon/blob_sidecars/head": func(w http.ResponseWriter, r *http.Request) {
+					require.Equal(t, test.query, r.URL.RawQuery)
+					if r.Header.Get("Accept") == "application/octet-stream" {
+						w.Header().Set("Content-Type", "application/octet-stream")
+						_, _ = w.Write(test.sszResponse)
+						return
+					}
+					jsonRequests++
+					respondWith(fmt.Sprintf(`{"data":%s}`, string(blobSidecarsJSON)))(w, r)
+				},
+			})
+			service, err := standardhttp.New(context.Background(),
+				standardhttp.WithTimeout(timeout),
+				standardhttp.WithAddress(server.URL),
+				standardhttp.WithPreferSSZ(test.preferSSZ),
+			)
+			require.NoError(t, err)
+
+			res, err := service.BlobSidecars(context.Background(), test.blockID, test.indices)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				if test.blockID != "" {
+					require.True(t, errors.Is(err, client.ErrNotFound))
+				}
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.jsonRequests, jsonRequests)
+			require.Len(t, res, test.expected)
+			var resSSZ []byte
+			for i := range res {
+				data, err := res[i].MarshalSSZ()
+				require.NoError(t, err)
+				resSSZ = append(resSSZ, data...)
+			}
+			require.True(t, bytes.Equal(blobSidecarsSSZ[:len(resSSZ)], resSSZ))
+		})
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	client "github.com/attestantio/go-eth2-client"
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/pkg/errors"
+)
+
+type blockRewardsJSON struct {
+	ExecutionOptimistic bool              `json:"execution_optimistic"`
+	Finalized           bool              `json:"finalized"`
+	Data                *api.BlockRewards `json:"data"`
+}
+
+// BlockRewards provides the rewards received by the proposer of the given block.
+// blockID can be a slot number or block root, or one of the special values "genesis", "head" or "finalized".
+func (s *Service) BlockRewards(ctx context.Context, blockID string) (*api.BlockRewards, error) {
+	if blockID == "" {
+		return nil, errors.New("no block ID specified")
+	}
+
+	respBodyReader, err := s.get(ctx, fmt.Sprintf("/eth/v1/beacon/rewards/blocks/%s", blockID))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request block rewards")
+	}
+	if respBodyReader == nil {
+		return nil, errors.Wrap(client.ErrNotFound, "failed to obtain block rewards")
+	}
+
+	var resp blockRewardsJSON
+	if err := s.decodeJSON(respBodyReader, &resp); err != nil {
+		return nil, errors.Wrap(err, "failed to parse block rewards")
+	}
+	if resp.Data == nil {
+		return nil, errors.New("no block rewards returned")
+	}
+
+	return resp.Data, nil
+}
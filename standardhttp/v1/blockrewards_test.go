@@ -0,0 +1,80 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"context"
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockRewards(t *testing.T) {
+	server := newTestServer(t, map[string]string{
+		"/eth/v1/beacon/rewards/blocks/head": `{"execution_optimistic":false,"finalized":false,"data":{"proposer_index":"123","total":"123456","attestations":"100000","sync_aggregate":"20000","proposer_slashings":"3456","attester_slashings":"0"}}`,
+		"/eth/v1/beacon/rewards/blocks/1":    `{"execution_optimistic":false,"finalized":true}`,
+	})
+	service, err := standardhttp.New(context.Background(),
+		standardhttp.WithTimeout(timeout),
+		standardhttp.WithAddress(server.URL),
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		blockID  string
+		expected *api.BlockRewards
+		err      string
+	}{
+		{
+			name: "NoBlockID",
+			err:  "no block ID specified",
+		},
+		{
+			name:    "Good",
+			blockID: "head",
+			expected: &api.BlockRewards{
+				ProposerIndex:     123,
+				Total:             123456,
+				Attestations:      100000,
+				SyncAggregate:     20000,
+				ProposerSlashings: 3456,
+			},
+		},
+		{
+			name:    "NoData",
+			blockID: "1",
+			err:     "no block rewards returned",
+		},
+		{
+			name:    "NotFound",
+			blockID: "2",
+			err:     "failed to obtain block rewards: not found",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rewards, err := service.BlockRewards(context.Background(), test.blockID)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expected, rewards)
+		})
+	}
+}
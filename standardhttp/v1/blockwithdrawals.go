@@ -0,0 +1,79 @@
+// Copyright © 2020 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/pkg/errors"
+)
+
+// blockWithdrawalsJSON is the subset of a versioned block response required to obtain its withdrawals.
+type blockWithdrawalsJSON struct {
+	Version string `json:"version"`
+	Data    *struct {
+		Message *struct {
+			Body *struct {
+				ExecutionPayload *struct {
+					Withdrawals json.RawMessage `json:"withdrawals"`
+				} `json:"execution_payload"`
+			} `json:"body"`
+		} `json:"message"`
+	} `json:"data"`
+}
+
+// BlockWithdrawals provides the withdrawals in the execution payload of the given block.
+// Only the withdrawals are decoded, so this does not require the full block to be understood.
+// N.B if a block for the block ID is not available this will return an error that wraps client.ErrNotFound.
+func (s *Service) BlockWithdrawals(ctx context.Context, blockID string) ([]*capella.Withdrawal, error) {
+	respBodyReader, err := s.get(ctx, fmt.Sprintf("/eth/v2/beacon/blocks/%s", blockID))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request block")
+	}
+	if respBodyReader == nil {
+		return nil, errors.Wrap(client.ErrNotFound, "failed to obtain block")
+	}
+
+	// Decoding of the block is always lenient, as only the withdrawals are of interest; strict JSON, if enabled,
+	// applies to the withdrawals themselves.
+	var resp blockWithdrawalsJSON
+	if err := json.NewDecoder(respBodyReader).Decode(&resp); err != nil {
+		return nil, errors.Wrap(err, "failed to parse block")
+	}
+	switch resp.Version {
+	case "phase0", "altair", "bellatrix":
+		return nil, fmt.Errorf("%s block does not contain withdrawals", resp.Version)
+	}
+	if resp.Data == nil || resp.Data.Message == nil || resp.Data.Message.Body == nil {
+		return nil, errors.New("block body missing")
+	}
+	if resp.Data.Message.Body.ExecutionPayload == nil {
+		return nil, errors.New("execution payload missing")
+	}
+	raw := resp.Data.Message.Body.ExecutionPayload.Withdrawals
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, errors.New("withdrawals missing")
+	}
+
+	var withdrawals []*capella.Withdrawal
+	if err := s.unmarshalJSON(raw, &withdrawals); err != nil {
+		return nil, errors.Wrap(err, "failed to parse withdrawals")
+	}
+
+	return withdrawals, nil
+}
@@ -0,0 +1,124 @@
+// Copyright © 2020 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockWithdrawals(t *testing.T) {
+	tests := []struct {
+		name     string
+		response string
+		expected []*capella.Withdrawal
+		strict   bool
+		err      string
+	}{
+		{
+			name:     "Good",
+			response: `{"version":"capella","data":{"message":{"slot":"1","body":{"execution_payload":{"block_number":"1","withdrawals":[{"index":"1","validator_index":"2","address":"0x000102030405060708090a0b0c0d0e0f10111213","amount":"3"}]}}}}}`,
+			expected: []*capella.Withdrawal{
+				{
+					Index:          1,
+					ValidatorIndex: 2,
+					Address:        capella.ExecutionAddress{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10, 0x11, 0x12, 0x13},
+					Amount:         3,
+				},
+			},
+		},
+		{
+			name:     "StrictGood",
+			response: `{"version":"capella","data":{"message":{"slot":"1","body":{"execution_payload":{"block_number":"1","withdrawals":[{"index":"1","validator_index":"2","address":"0x000102030405060708090a0b0c0d0e0f10111213","amount":"3"}]}}}}}`,
+			strict:   true,
+			expected: []*capella.Withdrawal{
+				{
+					Index:          1,
+					ValidatorIndex: 2,
+					Address:        capella.ExecutionAddress{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10, 0x11, 0x12, 0x13},
+					Amount:         3,
+				},
+			},
+		},
+		{
+			name:     "StrictUnknownField",
+			response: `{"version":"capella","data":{"message":{"body":{"execution_payload":{"withdrawals":[{"index":"1","validator_index":"2","address":"0x000102030405060708090a0b0c0d0e0f10111213","amount":"3","extra":"1"}]}}}}}`,
+			strict:   true,
+			err:      "failed to parse withdrawals: unknown field [0].extra",
+		},
+		{
+			name:     "NoWithdrawals",
+			response: `{"version":"capella","data":{"message":{"body":{"execution_payload":{"withdrawals":[]}}}}}`,
+			expected: []*capella.Withdrawal{},
+		},
+		{
+			name:     "PreCapella",
+			response: `{"version":"altair","data":{"message":{"body":{}}}}`,
+			err:      "altair block does not contain withdrawals",
+		},
+		{
+			name:     "ExecutionPayloadMissing",
+			response: `{"version":"capella","data":{"message":{"body":{}}}}`,
+			err:      "execution payload missing",
+		},
+		{
+			name:     "WithdrawalsMissing",
+			response: `{"version":"capella","data":{"message":{"body":{"execution_payload":{}}}}}`,
+			err:      "withdrawals missing",
+		},
+		{
+			name:     "WithdrawalInvalid",
+			response: `{"version":"capella","data":{"message":{"body":{"execution_payload":{"withdrawals":[{"index":"1"}]}}}}}`,
+			err:      "failed to parse withdrawals: validator index missing",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := newTestServer(t, map[string]string{
+				"/eth/v2/beacon/blocks/head": test.response,
+			})
+			service, err := standardhttp.New(context.Background(),
+				standardhttp.WithTimeout(timeout),
+				standardhttp.WithAddress(server.URL),
+				standardhttp.WithStrictJSON(test.strict),
+			)
+			require.NoError(t, err)
+
+			withdrawals, err := service.BlockWithdrawals(context.Background(), "head")
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expected, withdrawals)
+		})
+	}
+}
+
+func TestBlockWithdrawalsNotFound(t *testing.T) {
+	server := newTestServer(t, nil)
+	service, err := standardhttp.New(context.Background(),
+		standardhttp.WithTimeout(timeout),
+		standardhttp.WithAddress(server.URL),
+	)
+	require.NoError(t, err)
+
+	_, err = service.BlockWithdrawals(context.Background(), "head")
+	require.EqualError(t, err, "failed to obtain block: not found")
+}
@@ -0,0 +1,384 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// farFutureEpoch is the value used for fork epochs that are not scheduled.
+const farFutureEpoch = spec.Epoch(0xffffffffffffffff)
+
+// defaultAttestationPropagationSlotRange is the value used if the node does not supply ATTESTATION_PROPAGATION_SLOT_RANGE.
+const defaultAttestationPropagationSlotRange = 32
+
+// ChainConfig provides the typed configuration of the chain.
+func (s *Service) ChainConfig(ctx context.Context) (*api.ChainConfig, error) {
+	if s.chainConfig == nil {
+		data, err := s.Spec(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to obtain spec")
+		}
+		config, err := chainConfigFromSpec(data)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse chain configuration")
+		}
+		s.chainConfig = config
+	}
+	return s.chainConfig, nil
+}
+
+// chainConfigFromSpec creates a chain configuration from spec data.
+// Slot timings are required; all other values are optional.
+func chainConfigFromSpec(data map[string]interface{}) (*api.ChainConfig, error) {
+	if _, exists := data["SECONDS_PER_SLOT"]; !exists {
+		return nil, errors.New("SECONDS_PER_SLOT missing")
+	}
+	if _, exists := data["SLOTS_PER_EPOCH"]; !exists {
+		return nil, errors.New("SLOTS_PER_EPOCH missing")
+	}
+
+	config := &api.ChainConfig{}
+	var err error
+	if config.ConfigName, err = specString(data, "CONFIG_NAME"); err != nil {
+		return nil, err
+	}
+	if config.PresetBase, err = specString(data, "PRESET_BASE"); err != nil {
+		return nil, err
+	}
+	if config.MaxCommitteesPerSlot, err = specUint64(data, "MAX_COMMITTEES_PER_SLOT"); err != nil {
+		return nil, err
+	}
+	if config.TargetCommitteeSize, err = specUint64(data, "TARGET_COMMITTEE_SIZE"); err != nil {
+		return nil, err
+	}
+	if config.MaxValidatorsPerCommittee, err = specUint64(data, "MAX_VALIDATORS_PER_COMMITTEE"); err != nil {
+		return nil, err
+	}
+	if config.ShuffleRoundCount, err = specUint64(data, "SHUFFLE_ROUND_COUNT"); err != nil {
+		return nil, err
+	}
+	if config.TargetAggregatorsPerCommittee, err = specUint64(data, "TARGET_AGGREGATORS_PER_COMMITTEE"); err != nil {
+		return nil, err
+	}
+	if config.MinGenesisActiveValidatorCount, err = specUint64(data, "MIN_GENESIS_ACTIVE_VALIDATOR_COUNT"); err != nil {
+		return nil, err
+	}
+	if config.MinGenesisTime, err = specTime(data, "MIN_GENESIS_TIME"); err != nil {
+		return nil, err
+	}
+	if config.GenesisDelay, err = specDuration(data, "GENESIS_DELAY"); err != nil {
+		return nil, err
+	}
+	if config.SecondsPerSlot, err = specDuration(data, "SECONDS_PER_SLOT"); err != nil {
+		return nil, err
+	}
+	if config.SecondsPerETH1Block, err = specDuration(data, "SECONDS_PER_ETH1_BLOCK"); err != nil {
+		return nil, err
+	}
+	if config.SlotsPerEpoch, err = specUint64(data, "SLOTS_PER_EPOCH"); err != nil {
+		return nil, err
+	}
+	if config.MinAttestationInclusionDelay, err = specUint64(data, "MIN_ATTESTATION_INCLUSION_DELAY"); err != nil {
+		return nil, err
+	}
+	if config.MinSeedLookahead, err = specEpoch(data, "MIN_SEED_LOOKAHEAD"); err != nil {
+		return nil, err
+	}
+	if config.MaxSeedLookahead, err = specEpoch(data, "MAX_SEED_LOOKAHEAD"); err != nil {
+		return nil, err
+	}
+	if config.EpochsPerETH1VotingPeriod, err = specEpoch(data, "EPOCHS_PER_ETH1_VOTING_PERIOD"); err != nil {
+		return nil, err
+	}
+	if config.AttestationPropagationSlotRange, err = specUint64(data, "ATTESTATION_PROPAGATION_SLOT_RANGE"); err != nil {
+		return nil, err
+	}
+	if config.AttestationPropagationSlotRange == 0 {
+		// This is a networking value so not always provided; use the specification default.
+		config.AttestationPropagationSlotRange = defaultAttestationPropagationSlotRange
+	}
+	if config.SlotsPerHistoricalRoot, err = specUint64(data, "SLOTS_PER_HISTORICAL_ROOT"); err != nil {
+		return nil, err
+	}
+	if config.MinValidatorWithdrawabilityDelay, err = specEpoch(data, "MIN_VALIDATOR_WITHDRAWABILITY_DELAY"); err != nil {
+		return nil, err
+	}
+	if config.ShardCommitteePeriod, err = specEpoch(data, "SHARD_COMMITTEE_PERIOD"); err != nil {
+		return nil, err
+	}
+	if config.ETH1FollowDistance, err = specUint64(data, "ETH1_FOLLOW_DISTANCE"); err != nil {
+		return nil, err
+	}
+	if config.EpochsPerHistoricalVector, err = specEpoch(data, "EPOCHS_PER_HISTORICAL_VECTOR"); err != nil {
+		return nil, err
+	}
+	if config.EpochsPerSlashingsVector, err = specEpoch(data, "EPOCHS_PER_SLASHINGS_VECTOR"); err != nil {
+		return nil, err
+	}
+	if config.HistoricalRootsLimit, err = specUint64(data, "HISTORICAL_ROOTS_LIMIT"); err != nil {
+		return nil, err
+	}
+	if config.ValidatorRegistryLimit, err = specUint64(data, "VALIDATOR_REGISTRY_LIMIT"); err != nil {
+		return nil, err
+	}
+	if config.BaseRewardFactor, err = specUint64(data, "BASE_REWARD_FACTOR"); err != nil {
+		return nil, err
+	}
+	if config.WhistleblowerRewardQuotient, err = specUint64(data, "WHISTLEBLOWER_REWARD_QUOTIENT"); err != nil {
+		return nil, err
+	}
+	if config.ProposerRewardQuotient, err = specUint64(data, "PROPOSER_REWARD_QUOTIENT"); err != nil {
+		return nil, err
+	}
+	if config.InactivityPenaltyQuotient, err = specUint64(data, "INACTIVITY_PENALTY_QUOTIENT"); err != nil {
+		return nil, err
+	}
+	if config.MinSlashingPenaltyQuotient, err = specUint64(data, "MIN_SLASHING_PENALTY_QUOTIENT"); err != nil {
+		return nil, err
+	}
+	if config.ProportionalSlashingMultiplier, err = specUint64(data, "PROPORTIONAL_SLASHING_MULTIPLIER"); err != nil {
+		return nil, err
+	}
+	if config.MaxProposerSlashings, err = specUint64(data, "MAX_PROPOSER_SLASHINGS"); err != nil {
+		return nil, err
+	}
+	if config.MaxAttesterSlashings, err = specUint64(data, "MAX_ATTESTER_SLASHINGS"); err != nil {
+		return nil, err
+	}
+	if config.MaxAttestations, err = specUint64(data, "MAX_ATTESTATIONS"); err != nil {
+		return nil, err
+	}
+	if config.MaxDeposits, err = specUint64(data, "MAX_DEPOSITS"); err != nil {
+		return nil, err
+	}
+	if config.MaxVoluntaryExits, err = specUint64(data, "MAX_VOLUNTARY_EXITS"); err != nil {
+		return nil, err
+	}
+	if config.MinDepositAmount, err = specGwei(data, "MIN_DEPOSIT_AMOUNT"); err != nil {
+		return nil, err
+	}
+	if config.MaxEffectiveBalance, err = specGwei(data, "MAX_EFFECTIVE_BALANCE"); err != nil {
+		return nil, err
+	}
+	if config.EjectionBalance, err = specGwei(data, "EJECTION_BALANCE"); err != nil {
+		return nil, err
+	}
+	if config.EffectiveBalanceIncrement, err = specGwei(data, "EFFECTIVE_BALANCE_INCREMENT"); err != nil {
+		return nil, err
+	}
+	if config.MinPerEpochChurnLimit, err = specUint64(data, "MIN_PER_EPOCH_CHURN_LIMIT"); err != nil {
+		return nil, err
+	}
+	if config.ChurnLimitQuotient, err = specUint64(data, "CHURN_LIMIT_QUOTIENT"); err != nil {
+		return nil, err
+	}
+	if config.DepositChainID, err = specUint64(data, "DEPOSIT_CHAIN_ID"); err != nil {
+		return nil, err
+	}
+	if config.DepositNetworkID, err = specUint64(data, "DEPOSIT_NETWORK_ID"); err != nil {
+		return nil, err
+	}
+	if config.DepositContractAddress, err = specBytes(data, "DEPOSIT_CONTRACT_ADDRESS"); err != nil {
+		return nil, err
+	}
+	if config.GenesisForkVersion, err = specVersion(data, "GENESIS_FORK_VERSION"); err != nil {
+		return nil, err
+	}
+	if config.AltairForkVersion, err = specVersion(data, "ALTAIR_FORK_VERSION"); err != nil {
+		return nil, err
+	}
+	if config.AltairForkEpoch, err = specForkEpoch(data, "ALTAIR_FORK_EPOCH"); err != nil {
+		return nil, err
+	}
+	if config.BellatrixForkVersion, err = specVersion(data, "BELLATRIX_FORK_VERSION"); err != nil {
+		return nil, err
+	}
+	if config.BellatrixForkEpoch, err = specForkEpoch(data, "BELLATRIX_FORK_EPOCH"); err != nil {
+		return nil, err
+	}
+	if config.CapellaForkVersion, err = specVersion(data, "CAPELLA_FORK_VERSION"); err != nil {
+		return nil, err
+	}
+	if config.CapellaForkEpoch, err = specForkEpoch(data, "CAPELLA_FORK_EPOCH"); err != nil {
+		return nil, err
+	}
+	if config.DenebForkVersion, err = specVersion(data, "DENEB_FORK_VERSION"); err != nil {
+		return nil, err
+	}
+	if config.DenebForkEpoch, err = specForkEpoch(data, "DENEB_FORK_EPOCH"); err != nil {
+		return nil, err
+	}
+	if config.SyncCommitteeSize, err = specUint64(data, "SYNC_COMMITTEE_SIZE"); err != nil {
+		return nil, err
+	}
+	if config.EpochsPerSyncCommitteePeriod, err = specEpoch(data, "EPOCHS_PER_SYNC_COMMITTEE_PERIOD"); err != nil {
+		return nil, err
+	}
+	if config.DomainBeaconProposer, err = specDomainType(data, "DOMAIN_BEACON_PROPOSER"); err != nil {
+		return nil, err
+	}
+	if config.DomainBeaconAttester, err = specDomainType(data, "DOMAIN_BEACON_ATTESTER"); err != nil {
+		return nil, err
+	}
+	if config.DomainRANDAO, err = specDomainType(data, "DOMAIN_RANDAO"); err != nil {
+		return nil, err
+	}
+	if config.DomainDeposit, err = specDomainType(data, "DOMAIN_DEPOSIT"); err != nil {
+		return nil, err
+	}
+	if config.DomainVoluntaryExit, err = specDomainType(data, "DOMAIN_VOLUNTARY_EXIT"); err != nil {
+		return nil, err
+	}
+	if config.DomainSelectionProof, err = specDomainType(data, "DOMAIN_SELECTION_PROOF"); err != nil {
+		return nil, err
+	}
+	if config.DomainAggregateAndProof, err = specDomainType(data, "DOMAIN_AGGREGATE_AND_PROOF"); err != nil {
+		return nil, err
+	}
+	if config.DomainSyncCommittee, err = specDomainType(data, "DOMAIN_SYNC_COMMITTEE"); err != nil {
+		return nil, err
+	}
+	if config.DomainSyncCommitteeSelectionProof, err = specDomainType(data, "DOMAIN_SYNC_COMMITTEE_SELECTION_PROOF"); err != nil {
+		return nil, err
+	}
+	if config.DomainContributionAndProof, err = specDomainType(data, "DOMAIN_CONTRIBUTION_AND_PROOF"); err != nil {
+		return nil, err
+	}
+	if config.DomainApplicationMask, err = specDomainType(data, "DOMAIN_APPLICATION_MASK"); err != nil {
+		return nil, err
+	}
+	if config.DomainBLSToExecutionChange, err = specDomainType(data, "DOMAIN_BLS_TO_EXECUTION_CHANGE"); err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+func specString(data map[string]interface{}, key string) (string, error) {
+	val, exists := data[key]
+	if !exists {
+		return "", nil
+	}
+	res, isString := val.(string)
+	if !isString {
+		return "", fmt.Errorf("invalid type %T for %s", val, key)
+	}
+
+	return res, nil
+}
+
+func specUint64(data map[string]interface{}, key string) (uint64, error) {
+	val, exists := data[key]
+	if !exists {
+		return 0, nil
+	}
+	res, isUint64 := val.(uint64)
+	if !isUint64 {
+		return 0, fmt.Errorf("invalid type %T for %s", val, key)
+	}
+
+	return res, nil
+}
+
+func specTime(data map[string]interface{}, key string) (time.Time, error) {
+	val, err := specUint64(data, key)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return time.Unix(int64(val), 0), nil
+}
+
+func specDuration(data map[string]interface{}, key string) (time.Duration, error) {
+	val, exists := data[key]
+	if !exists {
+		return 0, nil
+	}
+	switch res := val.(type) {
+	case time.Duration:
+		return res, nil
+	case uint64:
+		// Durations are provided in seconds.
+		return time.Duration(res) * time.Second, nil
+	default:
+		return 0, fmt.Errorf("invalid type %T for %s", val, key)
+	}
+}
+
+func specEpoch(data map[string]interface{}, key string) (spec.Epoch, error) {
+	val, err := specUint64(data, key)
+
+	return spec.Epoch(val), err
+}
+
+func specForkEpoch(data map[string]interface{}, key string) (spec.Epoch, error) {
+	if _, exists := data[key]; !exists {
+		return farFutureEpoch, nil
+	}
+
+	return specEpoch(data, key)
+}
+
+func specGwei(data map[string]interface{}, key string) (spec.Gwei, error) {
+	val, err := specUint64(data, key)
+
+	return spec.Gwei(val), err
+}
+
+func specBytes(data map[string]interface{}, key string) ([]byte, error) {
+	val, exists := data[key]
+	if !exists {
+		return nil, nil
+	}
+	res, isBytes := val.([]byte)
+	if !isBytes {
+		return nil, fmt.Errorf("invalid type %T for %s", val, key)
+	}
+
+	return res, nil
+}
+
+func specVersion(data map[string]interface{}, key string) (spec.Version, error) {
+	var res spec.Version
+	val, err := specBytes(data, key)
+	if err != nil {
+		return res, err
+	}
+	if val != nil && len(val) != len(res) {
+		return res, fmt.Errorf("incorrect length %d for %s", len(val), key)
+	}
+	copy(res[:], val)
+
+	return res, nil
+}
+
+func specDomainType(data map[string]interface{}, key string) (spec.DomainType, error) {
+	val, exists := data[key]
+	if !exists {
+		return spec.DomainType{}, nil
+	}
+	res, isDomainType := val.(spec.DomainType)
+	if !isDomainType {
+		return spec.DomainType{}, fmt.Errorf("invalid type %T for %s", val, key)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,111 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChainConfig(t *testing.T) {
+	requests := 0
+	server := newTestServerWithHandlers(t, map[string]http.HandlerFunc{
+		"/eth/v1/config/spec": func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			respondWith(`{"data":{"CONFIG_NAME":"mainnet","SECONDS_PER_SLOT":"12","SLOTS_PER_EPOCH":"32","GENESIS_DELAY":"604800","MIN_GENESIS_TIME":"1606824000","MAX_COMMITTEES_PER_SLOT":"64","MAX_EFFECTIVE_BALANCE":"32000000000","SHARD_COMMITTEE_PERIOD":"256","GENESIS_FORK_VERSION":"0x00000000","ALTAIR_FORK_VERSION":"0x01000000","ALTAIR_FORK_EPOCH":"74240","DEPOSIT_CONTRACT_ADDRESS":"0x00000000219ab540356cbb839cbe05303d7705fa","DOMAIN_BEACON_PROPOSER":"0x00000000","DOMAIN_RANDAO":"0x02000000"}}`)(w, r)
+		},
+	})
+	service, err := standardhttp.New(context.Background(),
+		standardhttp.WithTimeout(timeout),
+		standardhttp.WithAddress(server.URL),
+	)
+	require.NoError(t, err)
+
+	config, err := service.ChainConfig(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "mainnet", config.ConfigName)
+	require.Equal(t, 12*time.Second, config.SecondsPerSlot)
+	require.Equal(t, uint64(32), config.SlotsPerEpoch)
+	require.Equal(t, 7*24*time.Hour, config.GenesisDelay)
+	require.Equal(t, time.Unix(1606824000, 0), config.MinGenesisTime)
+	require.Equal(t, uint64(64), config.MaxCommitteesPerSlot)
+	require.Equal(t, spec.Gwei(32000000000), config.MaxEffectiveBalance)
+	require.Equal(t, spec.Epoch(256), config.ShardCommitteePeriod)
+	require.Equal(t, uint64(32), config.AttestationPropagationSlotRange)
+	require.Equal(t, spec.Version{0x00, 0x00, 0x00, 0x00}, config.GenesisForkVersion)
+	require.Equal(t, spec.Version{0x01, 0x00, 0x00, 0x00}, config.AltairForkVersion)
+	require.Equal(t, spec.Epoch(74240), config.AltairForkEpoch)
+	require.Equal(t, spec.Epoch(0xffffffffffffffff), config.BellatrixForkEpoch)
+	require.Len(t, config.DepositContractAddress, 20)
+	require.Equal(t, spec.DomainType{0x02, 0x00, 0x00, 0x00}, config.DomainRANDAO)
+
+	// Configuration is cached.
+	_, err = service.ChainConfig(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, requests)
+
+	// Existing providers use the typed configuration.
+	slotDuration, err := service.SlotDuration(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 12*time.Second, slotDuration)
+	domain, err := service.RANDAODomain(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, spec.DomainType{0x02, 0x00, 0x00, 0x00}, domain)
+}
+
+func TestChainConfigErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		spec string
+		err  string
+	}{
+		{
+			name: "SlotsPerEpochMissing",
+			spec: `{"data":{"SECONDS_PER_SLOT":"12"}}`,
+			err:  "failed to parse chain configuration: SLOTS_PER_EPOCH missing",
+		},
+		{
+			name: "ForkVersionLength",
+			spec: `{"data":{"SECONDS_PER_SLOT":"12","SLOTS_PER_EPOCH":"32","GENESIS_FORK_VERSION":"0x0000"}}`,
+			err:  "failed to parse chain configuration: incorrect length 2 for GENESIS_FORK_VERSION",
+		},
+		{
+			name: "InvalidType",
+			spec: `{"data":{"SECONDS_PER_SLOT":"12","SLOTS_PER_EPOCH":"32","MAX_DEPOSITS":"sixteen"}}`,
+			err:  "failed to parse chain configuration: invalid type string for MAX_DEPOSITS",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := newTestServer(t, map[string]string{
+				"/eth/v1/config/spec": test.spec,
+			})
+			service, err := standardhttp.New(context.Background(),
+				standardhttp.WithTimeout(timeout),
+				standardhttp.WithAddress(server.URL),
+			)
+			// The service starts regardless of the chain configuration.
+			require.NoError(t, err)
+			_, err = service.ChainConfig(context.Background())
+			require.EqualError(t, err, test.err)
+		})
+	}
+}
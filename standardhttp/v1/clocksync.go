@@ -0,0 +1,52 @@
+// Copyright © 2020 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// CheckClockSync estimates the skew between the local clock and the node's view of the chain.
+// The skew is calculated by comparing the slot that the local clock says should be current
+// with the node's head slot, so has a granularity of a single slot.  A positive value means
+// that the local clock is ahead of the node.
+func (s *Service) CheckClockSync(ctx context.Context) (time.Duration, error) {
+	genesisTime, err := s.GenesisTime(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to obtain genesis time")
+	}
+	slotDuration, err := s.SlotDuration(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to obtain slot duration")
+	}
+	if slotDuration == 0 {
+		return 0, errors.New("slot duration of 0")
+	}
+	head, err := s.Head(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to obtain head")
+	}
+
+	elapsed := time.Since(genesisTime)
+	if elapsed < 0 {
+		// Chain has not started; nothing to compare against.
+		return 0, nil
+	}
+	localSlot := int64(elapsed / slotDuration)
+
+	return time.Duration(localSlot-int64(head.Slot)) * slotDuration, nil
+}
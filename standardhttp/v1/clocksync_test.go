@@ -0,0 +1,69 @@
+// Copyright © 2020 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckClockSync(t *testing.T) {
+	// Genesis time and slot duration match the static responses.
+	genesisTime := time.Unix(1606824023, 0)
+	slotDuration := 12 * time.Second
+
+	tests := []struct {
+		name    string
+		slotLag int64
+		skew    time.Duration
+	}{
+		{
+			name: "InSync",
+		},
+		{
+			name:    "LocalAhead",
+			slotLag: 5,
+			skew:    5 * slotDuration,
+		},
+		{
+			name:    "LocalBehind",
+			slotLag: -5,
+			skew:    -5 * slotDuration,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			headSlot := int64(time.Since(genesisTime)/slotDuration) - test.slotLag
+			server := newTestServer(t, map[string]string{
+				"/eth/v1/beacon/headers/head": fmt.Sprintf(`{"data":{"root":"0xbc354f1a5f27f8d096eee9e6b6139e1b730385f9752513832a57c9849a149df7","canonical":true,"header":{"message":{"slot":"%d","proposer_index":"29787","parent_root":"0xba4d784293df28bab771a14df58cdbed9d8d64afd0ddf1c52dff3e25fcdd51df","state_root":"0x4e405274abd4f59c6a2268b4e6ca93dba01e15ae6b56401fb20a1ad9701b036d","body_root":"0x57bb79520694c132a35dc887cac2e4dad9acc5ded58b5ae66b491644ab8835c8"},"signature":"0xa8d684242ee025ee96e877b28433d93176072b8c8e8295609501863147bb1d174b8a16aed661d001f30859c9e42c0f9d18ea35786a9bdf115dff1877980046e19e0e4c9310e281f8129f2692ddc4680673ab78b7f8db72f91be7863dd9fe1e55"}}}`, headSlot),
+			})
+			service, err := standardhttp.New(context.Background(),
+				standardhttp.WithTimeout(timeout),
+				standardhttp.WithAddress(server.URL),
+			)
+			require.NoError(t, err)
+
+			skew, err := service.CheckClockSync(context.Background())
+			require.NoError(t, err)
+			// Allow for a slot boundary being crossed during the test.
+			require.InDelta(t, test.skew, skew, float64(slotDuration))
+		})
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright © 2020 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// coalescedCall is an in-flight or completed call shared by identical requests.
+type coalescedCall struct {
+	done chan struct{}
+	data []byte
+	err  error
+}
+
+// requestCoalescer ensures that only one call for a given key is in flight at a time, with concurrent
+// callers for the same key sharing its result.
+type requestCoalescer struct {
+	mutex sync.Mutex
+	calls map[string]*coalescedCall
+}
+
+// do calls the supplied function, unless a call with the same key is already in flight in which case
+// it waits for that call and returns its result.
+// The function runs independently of the callers, so it should not depend on any caller's context.  Each caller
+// waits for the result or for its own context to be done, so one caller giving up does not affect the others.
+func (c *requestCoalescer) do(ctx context.Context, key string, fn func() ([]byte, error)) ([]byte, error) {
+	c.mutex.Lock()
+	if c.calls == nil {
+		c.calls = make(map[string]*coalescedCall)
+	}
+	call, exists := c.calls[key]
+	if !exists {
+		call = &coalescedCall{
+			done: make(chan struct{}),
+		}
+		c.calls[key] = call
+		go func() {
+			call.data, call.err = fn()
+			c.mutex.Lock()
+			delete(c.calls, key)
+			c.mutex.Unlock()
+			close(call.done)
+		}()
+	}
+	c.mutex.Unlock()
+
+	select {
+	case <-call.done:
+		return call.data, call.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// coalescingKey provides the key under which a GET request is coalesced.  It includes the request headers, so that
+// requests made with different credentials are not shared.
+func coalescingKey(accept string, endpoint string, headers map[string]string) string {
+	keys := make([]string, 0, len(headers))
+	for k := range headers {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var builder strings.Builder
+	builder.WriteString(accept)
+	builder.WriteString(" ")
+	builder.WriteString(endpoint)
+	for _, k := range keys {
+		builder.WriteString("\n")
+		builder.WriteString(k)
+		builder.WriteString(": ")
+		builder.WriteString(headers[k])
+	}
+
+	return builder.String()
+}
@@ -0,0 +1,148 @@
+// Copyright © 2020 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestCoalescing(t *testing.T) {
+	attestationData := `{"data":{"slot":"1","index":"2","beacon_block_root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","source":{"epoch":"0","root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f"},"target":{"epoch":"1","root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f"}}}`
+
+	tests := []struct {
+		name       string
+		coalescing bool
+		requests   int32
+	}{
+		{
+			name:       "Enabled",
+			coalescing: true,
+			requests:   1,
+		},
+		{
+			name:     "Disabled",
+			requests: 10,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			requests := int32(0)
+			server := newTestServerWithHandlers(t, map[string]http.HandlerFunc{
+				"/eth/v1/validator/attestation_data": func(w http.ResponseWriter, r *http.Request) {
+					atomic.AddInt32(&requests, 1)
+					// Delay the response to ensure that the requests are concurrent.
+					time.Sleep(100 * time.Millisecond)
+					respondWith(attestationData)(w, r)
+				},
+			})
+			service, err := standardhttp.New(context.Background(),
+				standardhttp.WithTimeout(timeout),
+				standardhttp.WithAddress(server.URL),
+				standardhttp.WithRequestCoalescing(test.coalescing),
+			)
+			require.NoError(t, err)
+
+			var wg sync.WaitGroup
+			errs := make(chan error, 10)
+			for i := 0; i < 10; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					data, err := service.AttestationData(context.Background(), 1, 2)
+					if err == nil && data.Slot != 1 {
+						t.Errorf("unexpected slot %d", data.Slot)
+					}
+					errs <- err
+				}()
+			}
+			wg.Wait()
+			close(errs)
+			for err := range errs {
+				require.NoError(t, err)
+			}
+			require.Equal(t, test.requests, atomic.LoadInt32(&requests))
+		})
+	}
+}
+
+func TestRequestCoalescingCallers(t *testing.T) {
+	attestationData := `{"data":{"slot":"1","index":"2","beacon_block_root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","source":{"epoch":"0","root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f"},"target":{"epoch":"1","root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f"}}}`
+	var mu sync.Mutex
+	authorizations := make([]string, 0)
+	server := newTestServerWithHandlers(t, map[string]http.HandlerFunc{
+		"/eth/v1/validator/attestation_data": func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			authorizations = append(authorizations, r.Header.Get("Authorization"))
+			mu.Unlock()
+			// Delay the response to ensure that the requests are concurrent.
+			time.Sleep(200 * time.Millisecond)
+			respondWith(attestationData)(w, r)
+		},
+	})
+	service, err := standardhttp.New(context.Background(),
+		standardhttp.WithTimeout(timeout),
+		standardhttp.WithAddress(server.URL),
+		standardhttp.WithRequestCoalescing(true),
+	)
+	require.NoError(t, err)
+
+	call := func(ctx context.Context, wg *sync.WaitGroup, errs chan<- error) {
+		defer wg.Done()
+		_, err := service.AttestationData(ctx, 1, 2)
+		errs <- err
+	}
+
+	// The first caller giving up does not fail the callers sharing its request.
+	shortCtx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	var wg sync.WaitGroup
+	shortErrs := make(chan error, 1)
+	errs := make(chan error, 2)
+	wg.Add(1)
+	go call(shortCtx, &wg, shortErrs)
+	time.Sleep(10 * time.Millisecond)
+	wg.Add(2)
+	go call(context.Background(), &wg, errs)
+	go call(context.Background(), &wg, errs)
+	wg.Wait()
+	close(errs)
+	require.True(t, errors.Is(<-shortErrs, context.DeadlineExceeded))
+	for err := range errs {
+		require.NoError(t, err)
+	}
+	require.Equal(t, []string{""}, authorizations)
+
+	// Requests with different headers are not shared.
+	authorizations = authorizations[:0]
+	errs = make(chan error, 2)
+	wg.Add(2)
+	go call(standardhttp.WithRequestHeaders(context.Background(), map[string]string{"Authorization": "Bearer a"}), &wg, errs)
+	go call(standardhttp.WithRequestHeaders(context.Background(), map[string]string{"Authorization": "Bearer b"}), &wg, errs)
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		require.NoError(t, err)
+	}
+	require.ElementsMatch(t, []string{"Bearer a", "Bearer b"}, authorizations)
+}
@@ -0,0 +1,112 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	client "github.com/attestantio/go-eth2-client"
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// ComputeCommitteeAssignment computes the beacon committee assignment of a validator for an epoch from a beacon state,
+// as per get_committee_assignment.  The epoch can be no later than the epoch after that of the state.
+// N.B if the validator is not active in the epoch this will return an error that wraps client.ErrNotFound.
+func (s *Service) ComputeCommitteeAssignment(ctx context.Context,
+	state *spec.BeaconState,
+	epoch spec.Epoch,
+	validatorIndex spec.ValidatorIndex,
+) (
+	*api.CommitteeAssignment,
+	error,
+) {
+	if state == nil {
+		return nil, errors.New("no state specified")
+	}
+	config, err := s.ChainConfig(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain chain configuration")
+	}
+	if config.SlotsPerEpoch == 0 || config.TargetCommitteeSize == 0 || config.EpochsPerHistoricalVector == 0 {
+		return nil, errors.New("invalid chain configuration")
+	}
+
+	stateEpoch := spec.Epoch(state.Slot / config.SlotsPerEpoch)
+	if epoch > stateEpoch+1 {
+		return nil, fmt.Errorf("epoch %d is too far ahead of state epoch %d", epoch, stateEpoch)
+	}
+	if epoch+config.EpochsPerHistoricalVector <= stateEpoch+config.MinSeedLookahead+1 {
+		return nil, fmt.Errorf("epoch %d is too far behind state epoch %d", epoch, stateEpoch)
+	}
+
+	activeIndices := make([]spec.ValidatorIndex, 0, len(state.Validators))
+	position := -1
+	for i, validator := range state.Validators {
+		if validator == nil {
+			return nil, fmt.Errorf("validator %d missing from state", i)
+		}
+		if validator.ActivationEpoch <= epoch && epoch < validator.ExitEpoch {
+			if spec.ValidatorIndex(i) == validatorIndex {
+				position = len(activeIndices)
+			}
+			activeIndices = append(activeIndices, spec.ValidatorIndex(i))
+		}
+	}
+	if position == -1 {
+		return nil, errors.Wrap(client.ErrNotFound, fmt.Sprintf("validator %d not active at epoch %d", validatorIndex, epoch))
+	}
+
+	seed, err := spec.GetSeed(state, epoch, config.DomainBeaconAttester)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to calculate seed")
+	}
+
+	activeCount := uint64(len(activeIndices))
+	committeesAtSlot := activeCount / config.SlotsPerEpoch / config.TargetCommitteeSize
+	if committeesAtSlot > config.MaxCommitteesPerSlot {
+		committeesAtSlot = config.MaxCommitteesPerSlot
+	}
+	if committeesAtSlot == 0 {
+		committeesAtSlot = 1
+	}
+	committees := committeesAtSlot * config.SlotsPerEpoch
+
+	// Committees are contiguous slices of the shuffled active indices, so rather than build every committee we find the
+	// position of the validator in the shuffled list and the committee that contains it.
+	shuffledPosition, err := spec.ComputeUnshuffledIndex(uint64(position), activeCount, seed, config.ShuffleRoundCount)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to calculate committee position")
+	}
+	for committee := uint64(0); committee < committees; committee++ {
+		start := activeCount * committee / committees
+		end := activeCount * (committee + 1) / committees
+		if shuffledPosition < start || shuffledPosition >= end {
+			continue
+		}
+		return &api.CommitteeAssignment{
+			ValidatorIndex:          validatorIndex,
+			Slot:                    spec.Slot(uint64(epoch)*config.SlotsPerEpoch + committee/committeesAtSlot),
+			CommitteeIndex:          spec.CommitteeIndex(committee % committeesAtSlot),
+			CommitteeLength:         end - start,
+			CommitteesAtSlot:        committeesAtSlot,
+			ValidatorCommitteeIndex: shuffledPosition - start,
+		}, nil
+	}
+
+	// Every position in the shuffled list falls within a committee, so this should not happen.
+	return nil, fmt.Errorf("no committee found for validator %d at epoch %d", validatorIndex, epoch)
+}
@@ -0,0 +1,185 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
+	"github.com/stretchr/testify/require"
+)
+
+// committeeAssignmentState creates a state at the start of epoch 3 with 100 validators, of which validator 5 exits at
+// epoch 2.
+func committeeAssignmentState() *spec.BeaconState {
+	state := &spec.BeaconState{
+		Slot:        12,
+		Validators:  make([]*spec.Validator, 100),
+		RANDAOMixes: make([][]byte, 64),
+	}
+	for i := range state.Validators {
+		state.Validators[i] = &spec.Validator{
+			ExitEpoch: 0xffffffffffffffff,
+		}
+	}
+	state.Validators[5].ExitEpoch = 2
+	for i := range state.RANDAOMixes {
+		mix := sha256.Sum256([]byte{byte(i)})
+		state.RANDAOMixes[i] = mix[:]
+	}
+
+	return state
+}
+
+func TestComputeCommitteeAssignment(t *testing.T) {
+	server := newTestServer(t, map[string]string{
+		"/eth/v1/config/spec": `{"data":{"SECONDS_PER_SLOT":"12","SLOTS_PER_EPOCH":"4","TARGET_COMMITTEE_SIZE":"4","MAX_COMMITTEES_PER_SLOT":"4","SHUFFLE_ROUND_COUNT":"10","EPOCHS_PER_HISTORICAL_VECTOR":"64","MIN_SEED_LOOKAHEAD":"1","DOMAIN_BEACON_ATTESTER":"0x01000000"}}`,
+	})
+	service, err := standardhttp.New(context.Background(),
+		standardhttp.WithTimeout(timeout),
+		standardhttp.WithAddress(server.URL),
+	)
+	require.NoError(t, err)
+
+	// Expected values are from the consensus specification's get_committee_assignment.
+	tests := []struct {
+		name           string
+		epoch          spec.Epoch
+		validatorIndex spec.ValidatorIndex
+		expected       *api.CommitteeAssignment
+		err            string
+	}{
+		{
+			name:           "First",
+			epoch:          3,
+			validatorIndex: 0,
+			expected: &api.CommitteeAssignment{
+				ValidatorIndex:          0,
+				Slot:                    15,
+				CommitteeIndex:          3,
+				CommitteeLength:         7,
+				CommitteesAtSlot:        4,
+				ValidatorCommitteeIndex: 6,
+			},
+		},
+		{
+			name:           "Middle",
+			epoch:          3,
+			validatorIndex: 42,
+			expected: &api.CommitteeAssignment{
+				ValidatorIndex:          42,
+				Slot:                    12,
+				CommitteeIndex:          0,
+				CommitteeLength:         6,
+				CommitteesAtSlot:        4,
+				ValidatorCommitteeIndex: 0,
+			},
+		},
+		{
+			name:           "Last",
+			epoch:          3,
+			validatorIndex: 99,
+			expected: &api.CommitteeAssignment{
+				ValidatorIndex:          99,
+				Slot:                    14,
+				CommitteeIndex:          3,
+				CommitteeLength:         6,
+				CommitteesAtSlot:        4,
+				ValidatorCommitteeIndex: 2,
+			},
+		},
+		{
+			name:           "NextEpoch",
+			epoch:          4,
+			validatorIndex: 7,
+			expected: &api.CommitteeAssignment{
+				ValidatorIndex:          7,
+				Slot:                    19,
+				CommitteeIndex:          0,
+				CommitteeLength:         6,
+				CommitteesAtSlot:        4,
+				ValidatorCommitteeIndex: 0,
+			},
+		},
+		{
+			name:           "EpochTooFarAhead",
+			epoch:          5,
+			validatorIndex: 0,
+			err:            "epoch 5 is too far ahead of state epoch 3",
+		},
+		{
+			name:           "ValidatorInactive",
+			epoch:          3,
+			validatorIndex: 5,
+			err:            "validator 5 not active at epoch 3: not found",
+		},
+		{
+			name:           "ValidatorUnknown",
+			epoch:          3,
+			validatorIndex: 100,
+			err:            "validator 100 not active at epoch 3: not found",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assignment, err := service.ComputeCommitteeAssignment(context.Background(), committeeAssignmentState(), test.epoch, test.validatorIndex)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expected, assignment)
+		})
+	}
+}
+
+func TestComputeCommitteeAssignmentCoverage(t *testing.T) {
+	server := newTestServer(t, map[string]string{
+		"/eth/v1/config/spec": `{"data":{"SECONDS_PER_SLOT":"12","SLOTS_PER_EPOCH":"4","TARGET_COMMITTEE_SIZE":"4","MAX_COMMITTEES_PER_SLOT":"4","SHUFFLE_ROUND_COUNT":"10","EPOCHS_PER_HISTORICAL_VECTOR":"64","MIN_SEED_LOOKAHEAD":"1","DOMAIN_BEACON_ATTESTER":"0x01000000"}}`,
+	})
+	service, err := standardhttp.New(context.Background(),
+		standardhttp.WithTimeout(timeout),
+		standardhttp.WithAddress(server.URL),
+	)
+	require.NoError(t, err)
+
+	// Every active validator occupies a unique position in a committee.
+	state := committeeAssignmentState()
+	positions := make(map[spec.Slot]map[spec.CommitteeIndex]map[uint64]bool)
+	for i := range state.Validators {
+		assignment, err := service.ComputeCommitteeAssignment(context.Background(), state, 3, spec.ValidatorIndex(i))
+		if i == 5 {
+			require.True(t, errors.Is(err, client.ErrNotFound))
+			continue
+		}
+		require.NoError(t, err)
+		require.Less(t, assignment.ValidatorCommitteeIndex, assignment.CommitteeLength)
+		if _, exists := positions[assignment.Slot]; !exists {
+			positions[assignment.Slot] = make(map[spec.CommitteeIndex]map[uint64]bool)
+		}
+		if _, exists := positions[assignment.Slot][assignment.CommitteeIndex]; !exists {
+			positions[assignment.Slot][assignment.CommitteeIndex] = make(map[uint64]bool)
+		}
+		require.False(t, positions[assignment.Slot][assignment.CommitteeIndex][assignment.ValidatorCommitteeIndex])
+		positions[assignment.Slot][assignment.CommitteeIndex][assignment.ValidatorCommitteeIndex] = true
+	}
+	require.Len(t, positions, 4)
+}
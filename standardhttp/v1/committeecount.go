@@ -0,0 +1,37 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// CommitteeCount provides the number of beacon committees at the given epoch, across all of its slots.
+// Every slot in an epoch has the same number of committees, so this is the number of committees per slot multiplied
+// by the number of slots in an epoch.
+func (s *Service) CommitteeCount(ctx context.Context, stateID string, epoch spec.Epoch) (uint64, error) {
+	committeesPerSlot, err := s.CommitteesPerSlot(ctx, stateID, epoch)
+	if err != nil {
+		return 0, err
+	}
+	slotsPerEpoch, err := s.SlotsPerEpoch(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to obtain slots per epoch")
+	}
+
+	return committeesPerSlot * slotsPerEpoch, nil
+}
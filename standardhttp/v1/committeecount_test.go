@@ -0,0 +1,48 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommitteeCount(t *testing.T) {
+	ctx := context.Background()
+	server := newTestServer(t, map[string]string{
+		// Two committees in the first slot of epoch 10.
+		"/eth/v1/beacon/states/head/committees": `{"data":[{"index":"0","slot":"320","validators":["1","2"]},{"index":"1","slot":"320","validators":["3","4"]}]}`,
+	})
+	service, err := standardhttp.New(ctx,
+		standardhttp.WithTimeout(timeout),
+		standardhttp.WithAddress(server.URL),
+	)
+	require.NoError(t, err)
+
+	_, err = service.CommitteeCount(ctx, "", 10)
+	require.EqualError(t, err, "no state ID specified")
+
+	// Two committees in each of the 32 slots of the epoch.
+	count, err := service.CommitteeCount(ctx, "head", 10)
+	require.NoError(t, err)
+	require.Equal(t, uint64(64), count)
+
+	_, err = service.CommitteeCount(ctx, "1000", 10)
+	require.True(t, errors.Is(err, client.ErrNotFound))
+}
@@ -0,0 +1,56 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	client "github.com/attestantio/go-eth2-client"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// CommitteesPerSlot provides the number of beacon committees in each slot of the given epoch, as defined by
+// get_committee_count_per_slot in the specification.  This is the value used to size subnet subscriptions.
+// Every slot in an epoch has the same number of committees, so only the committees of the epoch's first slot are
+// requested, and they are counted without decoding their members.
+func (s *Service) CommitteesPerSlot(ctx context.Context, stateID string, epoch spec.Epoch) (uint64, error) {
+	if stateID == "" {
+		return 0, errors.New("no state ID specified")
+	}
+	slotsPerEpoch, err := s.SlotsPerEpoch(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to obtain slots per epoch")
+	}
+
+	slot := uint64(epoch) * slotsPerEpoch
+	respBodyReader, err := s.get(ctx, fmt.Sprintf("/eth/v1/beacon/states/%s/committees?epoch=%d&slot=%d", stateID, epoch, slot))
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to request beacon committees")
+	}
+	if respBodyReader == nil {
+		return 0, errors.Wrap(client.ErrNotFound, "failed to obtain beacon committees")
+	}
+
+	count, err := countDataItems(respBodyReader)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to parse beacon committees")
+	}
+	if count == 0 {
+		return 0, errors.New("no beacon committees returned")
+	}
+
+	return count, nil
+}
@@ -0,0 +1,70 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommitteesPerSlot(t *testing.T) {
+	ctx := context.Background()
+	committees := func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("epoch") {
+		case "10":
+			// Only the committees of the first slot of the epoch should be requested.
+			if r.URL.Query().Get("slot") != "320" {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"code":400,"message":"unexpected slot"}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"data":[{"index":"0","slot":"320","validators":["1","2"]},{"index":"1","slot":"320","validators":["3","4"]}]}`))
+		case "11":
+			_, _ = w.Write([]byte(`{"data":[]}`))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"code":400,"message":"invalid epoch"}`))
+		}
+	}
+	server := newTestServerWithHandlers(t, map[string]http.HandlerFunc{
+		"/eth/v1/beacon/states/head/committees": committees,
+	})
+	service, err := standardhttp.New(ctx,
+		standardhttp.WithTimeout(timeout),
+		standardhttp.WithAddress(server.URL),
+	)
+	require.NoError(t, err)
+
+	_, err = service.CommitteesPerSlot(ctx, "", 10)
+	require.EqualError(t, err, "no state ID specified")
+
+	count, err := service.CommitteesPerSlot(ctx, "head", 10)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), count)
+
+	_, err = service.CommitteesPerSlot(ctx, "head", 11)
+	require.EqualError(t, err, "no beacon committees returned")
+
+	_, err = service.CommitteesPerSlot(ctx, "head", 12)
+	require.EqualError(t, err, "failed to request beacon committees: GET failed with status 400: invalid epoch")
+
+	_, err = service.CommitteesPerSlot(ctx, "1000", 10)
+	require.True(t, errors.Is(err, client.ErrNotFound))
+}
@@ -0,0 +1,60 @@
+// Copyright © 2020 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+	"time"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// currentEpoch calculates the current epoch from the local clock.
+func (s *Service) currentEpoch(ctx context.Context) (spec.Epoch, error) {
+	slot, err := s.currentSlot(ctx)
+	if err != nil {
+		return 0, err
+	}
+	slotsPerEpoch, err := s.SlotsPerEpoch(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to obtain slots per epoch")
+	}
+	if slotsPerEpoch == 0 {
+		return 0, errors.New("invalid chain timing parameters")
+	}
+
+	return spec.Epoch(uint64(slot) / slotsPerEpoch), nil
+}
+
+// currentSlot calculates the current slot from the local clock.
+func (s *Service) currentSlot(ctx context.Context) (spec.Slot, error) {
+	genesisTime, err := s.GenesisTime(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to obtain genesis time")
+	}
+	slotDuration, err := s.SlotDuration(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to obtain slot duration")
+	}
+	if slotDuration == 0 {
+		return 0, errors.New("invalid chain timing parameters")
+	}
+
+	elapsed := time.Since(genesisTime)
+	if elapsed < 0 {
+		return 0, nil
+	}
+	return spec.Slot(elapsed / slotDuration), nil
+}
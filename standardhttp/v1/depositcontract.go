@@ -15,7 +15,6 @@ package v1
 
 import (
 	"context"
-	"encoding/json"
 
 	api "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/pkg/errors"
@@ -37,7 +36,7 @@ func (s *Service) DepositContract(ctx context.Context) (*api.DepositContract, er
 		}
 
 		var resp depositContractJSON
-		if err := json.NewDecoder(respBodyReader).Decode(&resp); err != nil {
+		if err := s.decodeJSON(respBodyReader, &resp); err != nil {
 			return nil, errors.Wrap(err, "failed to parse deposit contract")
 		}
 		s.depositContract = resp.Data
@@ -21,5 +21,5 @@ import (
 
 // DepositDomain provides the deposit domain of the chain.
 func (s *Service) DepositDomain(ctx context.Context) (spec.DomainType, error) {
-	return s.spec["DOMAIN_DEPOSIT"].(spec.DomainType), nil
+	return s.chainConfig.DomainDeposit, nil
 }
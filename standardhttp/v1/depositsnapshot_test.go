@@ -0,0 +1,70 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"context"
+	"testing"
+
+	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDepositSnapshot(t *testing.T) {
+	tests := []struct {
+		name      string
+		responses map[string]string
+		count     uint64
+		err       string
+	}{
+		{
+			name: "Good",
+			responses: map[string]string{
+				"/eth/v1/beacon/deposit_snapshot": `{"data":{"finalized":["0x7c2f1b69d8e0a1a5deb7c2e2d9cf0c61e5b6e1b2a4d4f457dd3a0a1e8d1f4fc0"],"deposit_root":"0x9f5d6a4769cddd2fa0f2e8b6c51a5a8fb2e5ecdb13c9f9b7b4e5d7a0b3c2d1e0","deposit_count":"12345","execution_block_hash":"0x2c3b0a7c8d6e5f4a3b2c1d0e9f8a7b6c5d4e3f2a1b0c9d8e7f6a5b4c3d2e1f00","execution_block_height":"17000000"}}`,
+			},
+			count: 12345,
+		},
+		{
+			name: "Empty",
+			responses: map[string]string{
+				"/eth/v1/beacon/deposit_snapshot": `{}`,
+			},
+			err: "no deposit snapshot returned",
+		},
+		{
+			name: "NotFound",
+			err:  "failed to obtain deposit snapshot: not found",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := newTestServer(t, test.responses)
+			service, err := standardhttp.New(context.Background(),
+				standardhttp.WithTimeout(timeout),
+				standardhttp.WithAddress(server.URL),
+			)
+			require.NoError(t, err)
+
+			snapshot, err := service.DepositSnapshot(context.Background())
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.count, snapshot.DepositCount)
+			require.Len(t, snapshot.Finalized, 1)
+		})
+	}
+}
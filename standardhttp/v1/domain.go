@@ -23,7 +23,7 @@ import (
 // Domain provides a domain for a given domain type at a given epoch.
 func (s *Service) Domain(ctx context.Context, domainType spec.DomainType, epoch spec.Epoch) (spec.Domain, error) {
 	// Obtain the fork for the epoch.
-	fork, err := s.forkAtEpoch(ctx, epoch)
+	fork, err := s.ForkAtEpoch(ctx, epoch)
 	if err != nil {
 		return spec.Domain{}, errors.Wrap(err, "failed to obtain fork")
 	}
@@ -59,20 +59,3 @@ func (s *Service) Domain(ctx context.Context, domainType spec.DomainType, epoch
 	copy(domain[4:], root[:])
 	return domain, nil
 }
-
-// forkAtEpoch works through the fork schedule to obtain the current fork.
-func (s *Service) forkAtEpoch(ctx context.Context, epoch spec.Epoch) (*spec.Fork, error) {
-	forkSchedule, err := s.ForkSchedule(ctx)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to obtain fork schedule")
-	}
-
-	currentFork := forkSchedule[0]
-	for i := range forkSchedule {
-		if forkSchedule[i].Epoch > epoch {
-			break
-		}
-		currentFork = forkSchedule[i]
-	}
-	return currentFork, nil
-}
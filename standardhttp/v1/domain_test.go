@@ -25,6 +25,8 @@ import (
 )
 
 func TestDomain(t *testing.T) {
+	skipWithoutNode(t)
+
 	tests := []struct {
 		name   string
 		epoch  spec.Epoch
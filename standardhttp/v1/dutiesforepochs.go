@@ -0,0 +1,147 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+
+	client "github.com/attestantio/go-eth2-client"
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// dutiesForEpochsConcurrency is the maximum number of epochs for which duties are requested at the same time.
+const dutiesForEpochsConcurrency = 4
+
+// DutiesForEpochs provides the proposer, attester and sync committee duties for the given validators for count
+// epochs starting at startEpoch.
+// Duties are returned for each epoch for which they could be obtained, in epoch order.  If duties for any epoch
+// could not be obtained the returned error is a *client.ItemsError listing the failed epochs.
+func (s *Service) DutiesForEpochs(ctx context.Context,
+	startEpoch spec.Epoch,
+	count uint64,
+	validatorIndices []spec.ValidatorIndex,
+) (
+	[]*api.EpochDuties,
+	error,
+) {
+	if count == 0 {
+		return nil, errors.New("no epochs specified")
+	}
+	chainConfig, err := s.ChainConfig(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain chain configuration")
+	}
+
+	epochDuties := make([]*api.EpochDuties, count)
+	epochErrs := make([]error, count)
+	forEachConcurrently(int(count), dutiesForEpochsConcurrency, func(i int) {
+		epoch := startEpoch + spec.Epoch(i)
+		epochDuties[i], epochErrs[i] = s.dutiesForEpoch(ctx, epoch, validatorIndices, epoch >= chainConfig.AltairForkEpoch)
+	})
+
+	res := make([]*api.EpochDuties, 0, count)
+	var failures []*client.ItemFailure
+	for i := range epochDuties {
+		if epochErrs[i] != nil {
+			failures = append(failures, &client.ItemFailure{
+				Items: []uint64{uint64(startEpoch) + uint64(i)},
+				Err:   epochErrs[i],
+			})
+			continue
+		}
+		res = append(res, epochDuties[i])
+	}
+	if len(failures) > 0 {
+		return res, &client.ItemsError{ItemName: "epoch", Failures: failures}
+	}
+
+	return res, nil
+}
+
+// dutiesForEpoch provides the duties for the given validators for a single epoch.
+func (s *Service) dutiesForEpoch(ctx context.Context,
+	epoch spec.Epoch,
+	validatorIndices []spec.ValidatorIndex,
+	syncCommittees bool,
+) (
+	*api.EpochDuties,
+	error,
+) {
+	proposerDuties, err := s.ProposerDuties(ctx, epoch, validatorIndices)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain proposer duties")
+	}
+	attesterDuties, err := s.AttesterDuties(ctx, epoch, validatorIndices)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain attester duties")
+	}
+	duties := &api.EpochDuties{
+		Epoch:          epoch,
+		ProposerDuties: proposerDuties,
+		AttesterDuties: attesterDuties,
+	}
+	if syncCommittees {
+		duties.SyncCommitteeDuties, err = s.syncCommitteeDuties(ctx, epoch, validatorIndices)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to obtain sync committee duties")
+		}
+	}
+
+	return duties, nil
+}
+
+// syncCommitteeDuties provides the sync committee duties for the given validators at the given epoch,
+// in the order in which the validators first appear in the committee.
+// If validatorIndices is empty duties are returned for all members of the committee.
+func (s *Service) syncCommitteeDuties(ctx context.Context,
+	epoch spec.Epoch,
+	validatorIndices []spec.ValidatorIndex,
+) (
+	[]*api.SyncCommitteeDuty,
+	error,
+) {
+	syncCommittee, err := s.SyncCommitteeAtEpoch(ctx, "head", epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	var requested map[spec.ValidatorIndex]bool
+	if len(validatorIndices) > 0 {
+		requested = make(map[spec.ValidatorIndex]bool, len(validatorIndices))
+		for _, index := range validatorIndices {
+			requested[index] = true
+		}
+	}
+
+	duties := make([]*api.SyncCommitteeDuty, 0)
+	memberDuties := make(map[spec.ValidatorIndex]*api.SyncCommitteeDuty)
+	for i, index := range syncCommittee.Validators {
+		if requested != nil && !requested[index] {
+			continue
+		}
+		duty, exists := memberDuties[index]
+		if !exists {
+			duty = &api.SyncCommitteeDuty{
+				ValidatorIndex: index,
+			}
+			memberDuties[index] = duty
+			duties = append(duties, duty)
+		}
+		duty.ValidatorSyncCommitteeIndices = append(duty.ValidatorSyncCommitteeIndices, uint64(i))
+	}
+
+	return duties, nil
+}
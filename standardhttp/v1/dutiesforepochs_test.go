@@ -0,0 +1,90 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDutiesForEpochs(t *testing.T) {
+	pubKey := "0xa1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1"
+	proposerDuties := func(w http.ResponseWriter, r *http.Request) {
+		epoch, _ := strconv.Atoi(r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:])
+		_, _ = fmt.Fprintf(w, `{"dependent_root":"0x0101010101010101010101010101010101010101010101010101010101010101","data":[{"pubkey":"%s","slot":"%d","validator_index":"2"},{"pubkey":"%s","slot":"%d","validator_index":"3"}]}`, pubKey, epoch*32, pubKey, epoch*32+1)
+	}
+	attesterDuties := func(w http.ResponseWriter, r *http.Request) {
+		epoch, _ := strconv.Atoi(r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:])
+		if epoch == 3 {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(`{"code":500,"message":"internal error"}`))
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"dependent_root":"0x0101010101010101010101010101010101010101010101010101010101010101","data":[{"pubkey":"%s","slot":"%d","validator_index":"2","committee_index":"3","committee_length":"128","committees_at_slot":"4","validator_committee_index":"61"}]}`, pubKey, epoch*32+5)
+	}
+	handlers := map[string]http.HandlerFunc{
+		"/eth/v1/config/spec":                        respondWith(`{"data":{"SECONDS_PER_SLOT":"12","SLOTS_PER_EPOCH":"32","ALTAIR_FORK_EPOCH":"2"}}`),
+		"/eth/v1/beacon/states/head/sync_committees": respondWith(`{"data":{"validators":["5","2","7","2"],"validator_aggregates":[["5","2"],["7","2"]]}}`),
+	}
+	for epoch := 1; epoch <= 4; epoch++ {
+		handlers[fmt.Sprintf("/eth/v1/validator/duties/proposer/%d", epoch)] = proposerDuties
+		handlers[fmt.Sprintf("/eth/v1/validator/duties/attester/%d", epoch)] = attesterDuties
+	}
+	server := newTestServerWithHandlers(t, handlers)
+
+	service, err := standardhttp.New(context.Background(),
+		standardhttp.WithTimeout(timeout),
+		standardhttp.WithAddress(server.URL),
+	)
+	require.NoError(t, err)
+
+	_, err = service.DutiesForEpochs(context.Background(), 1, 0, nil)
+	require.EqualError(t, err, "no epochs specified")
+
+	duties, err := service.DutiesForEpochs(context.Background(), 1, 4, []spec.ValidatorIndex{2})
+	require.EqualError(t, err, "failed to obtain 1 of the requested epochs: epoch 3: failed to obtain attester duties: failed to request attester duties: GET failed with status 500: internal error")
+	var dutiesErr *client.ItemsError
+	require.True(t, errors.As(err, &dutiesErr))
+	require.Len(t, dutiesErr.Failures, 1)
+	require.Equal(t, []uint64{3}, dutiesErr.Failures[0].Items)
+
+	// Successful epochs are returned in order.
+	require.Len(t, duties, 3)
+	require.Equal(t, spec.Epoch(1), duties[0].Epoch)
+	require.Equal(t, spec.Epoch(2), duties[1].Epoch)
+	require.Equal(t, spec.Epoch(4), duties[2].Epoch)
+	for _, epochDuties := range duties {
+		require.Len(t, epochDuties.ProposerDuties, 1)
+		require.Equal(t, spec.ValidatorIndex(2), epochDuties.ProposerDuties[0].ValidatorIndex)
+		require.Equal(t, spec.Slot(uint64(epochDuties.Epoch)*32), epochDuties.ProposerDuties[0].Slot)
+		require.Len(t, epochDuties.AttesterDuties, 1)
+		require.Equal(t, spec.Slot(uint64(epochDuties.Epoch)*32+5), epochDuties.AttesterDuties[0].Slot)
+	}
+
+	// Sync committee duties are only present from Altair.
+	require.Empty(t, duties[0].SyncCommitteeDuties)
+	require.Len(t, duties[1].SyncCommitteeDuties, 1)
+	require.Equal(t, spec.ValidatorIndex(2), duties[1].SyncCommitteeDuties[0].ValidatorIndex)
+	require.Equal(t, []uint64{1, 3}, duties[1].SyncCommitteeDuties[0].ValidatorSyncCommitteeIndices)
+}
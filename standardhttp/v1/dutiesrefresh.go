@@ -0,0 +1,95 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// refreshDuties keeps duties for the current and next epochs in the cache until the context is done.
+// Duties are refreshed at the start of each epoch, and whenever a head event shows that the dependent
+// roots for duties have changed.
+func (s *Service) refreshDuties(ctx context.Context) {
+	slotsPerEpoch, err := s.SlotsPerEpoch(ctx)
+	if err != nil {
+		s.log.Error().Err(err).Msg("Failed to obtain slots per epoch; duties will not be refreshed")
+		return
+	}
+	epoch, err := s.currentEpoch(ctx)
+	if err != nil {
+		s.log.Error().Err(err).Msg("Failed to obtain current epoch; duties will not be refreshed")
+		return
+	}
+	ticks, err := s.SlotTicker(ctx)
+	if err != nil {
+		s.log.Error().Err(err).Msg("Failed to start slot ticker; duties will not be refreshed")
+		return
+	}
+
+	// The handler is called serially, so can track the dependent roots without locking.
+	changed := make(chan struct{}, 1)
+	var previousDependentRoot, currentDependentRoot spec.Root
+	if err := s.Events(ctx, []string{"head"}, func(event *api.Event) {
+		headEvent, isHeadEvent := event.Data.(*api.HeadEvent)
+		if !isHeadEvent {
+			return
+		}
+		if (previousDependentRoot != spec.Root{} || currentDependentRoot != spec.Root{}) &&
+			(headEvent.PreviousDutyDependentRoot != previousDependentRoot || headEvent.CurrentDutyDependentRoot != currentDependentRoot) {
+			select {
+			case changed <- struct{}{}:
+			default:
+				// A refresh is already pending.
+			}
+		}
+		previousDependentRoot = headEvent.PreviousDutyDependentRoot
+		currentDependentRoot = headEvent.CurrentDutyDependentRoot
+	}); err != nil {
+		s.log.Warn().Err(err).Msg("Head events not available; duties will not be refreshed on dependent root changes")
+	}
+
+	s.refreshEpochDuties(ctx, epoch)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case slot, ok := <-ticks:
+			if !ok {
+				return
+			}
+			if slotEpoch := spec.Epoch(uint64(slot) / slotsPerEpoch); slotEpoch != epoch {
+				epoch = slotEpoch
+				s.refreshEpochDuties(ctx, epoch)
+			}
+		case <-changed:
+			s.log.Trace().Uint64("epoch", uint64(epoch)).Msg("Duty dependent roots changed; refreshing duties")
+			s.refreshEpochDuties(ctx, epoch)
+		}
+	}
+}
+
+// refreshEpochDuties refreshes the cached duties for the given and next epochs.
+func (s *Service) refreshEpochDuties(ctx context.Context, epoch spec.Epoch) {
+	for _, dutiesEpoch := range []spec.Epoch{epoch, epoch + 1} {
+		if _, err := s.refreshProposerDuties(ctx, dutiesEpoch); err != nil {
+			s.log.Debug().Uint64("epoch", uint64(dutiesEpoch)).Err(err).Msg("Failed to refresh proposer duties")
+		}
+		if err := s.refreshAttesterDuties(ctx, dutiesEpoch); err != nil {
+			s.log.Debug().Uint64("epoch", uint64(dutiesEpoch)).Err(err).Msg("Failed to refresh attester duties")
+		}
+	}
+}
@@ -0,0 +1,142 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDutiesRefresh(t *testing.T) {
+	headEvent := `{"slot":"10","block":"0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20","state":"0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20","epoch_transition":false,"previous_duty_dependent_root":"%s","current_duty_dependent_root":"%s"}`
+	root1 := "0x0101010101010101010101010101010101010101010101010101010101010101"
+	root2 := "0x0202020202020202020202020202020202020202020202020202020202020202"
+	var mu sync.Mutex
+	requests := make(map[string]int)
+	proposerDuties := func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests[r.URL.Path]++
+		mu.Unlock()
+		_, _ = w.Write([]byte(`{"dependent_root":"0x0101010101010101010101010101010101010101010101010101010101010101","data":[]}`))
+	}
+	reorg := make(chan struct{})
+	server := newTestServerWithHandlers(t, map[string]http.HandlerFunc{
+		"/eth/v1/beacon/genesis":              respondWith(genesisResponse(time.Now().Add(-10 * time.Second))),
+		"/eth/v1/config/spec":                 respondWith(`{"data":{"SECONDS_PER_SLOT":"1","SLOTS_PER_EPOCH":"32"}}`),
+		"/eth/v1/validator/duties/proposer/0": proposerDuties,
+		"/eth/v1/validator/duties/proposer/1": proposerDuties,
+		"/eth/v1/events": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "text/event-stream")
+			fmt.Fprintf(w, "event: head\ndata: %s\n\n", fmt.Sprintf(headEvent, root1, root1))
+			w.(http.Flusher).Flush()
+			select {
+			case <-reorg:
+				fmt.Fprintf(w, "event: head\ndata: %s\n\n", fmt.Sprintf(headEvent, root1, root2))
+				w.(http.Flusher).Flush()
+			case <-r.Context().Done():
+				return
+			}
+			<-r.Context().Done()
+		},
+	})
+	requestsFor := func(path string) int {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return requests[path]
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	service, err := standardhttp.New(ctx,
+		standardhttp.WithTimeout(timeout),
+		standardhttp.WithAddress(server.URL),
+		standardhttp.WithDutiesCacheTTL(time.Minute),
+		standardhttp.WithDutiesRefresh(true),
+	)
+	require.NoError(t, err)
+
+	// Duties for the current and next epochs are fetched in the background.
+	require.Eventually(t, func() bool {
+		return requestsFor("/eth/v1/validator/duties/proposer/0") == 1 &&
+			requestsFor("/eth/v1/validator/duties/proposer/1") == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// Requests for warm epochs are served from the cache.
+	_, err = service.ProposerDuties(ctx, 0, nil)
+	require.NoError(t, err)
+	_, err = service.ProposerDuties(ctx, 1, nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, requestsFor("/eth/v1/validator/duties/proposer/0"))
+	require.Equal(t, 1, requestsFor("/eth/v1/validator/duties/proposer/1"))
+
+	// A change of dependent root refreshes the duties.
+	close(reorg)
+	require.Eventually(t, func() bool {
+		return requestsFor("/eth/v1/validator/duties/proposer/0") == 2 &&
+			requestsFor("/eth/v1/validator/duties/proposer/1") == 2
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestDutiesRefreshRequiresCache(t *testing.T) {
+	server := newTestServer(t, map[string]string{})
+	_, err := standardhttp.New(context.Background(),
+		standardhttp.WithTimeout(timeout),
+		standardhttp.WithAddress(server.URL),
+		standardhttp.WithDutiesRefresh(true),
+	)
+	require.EqualError(t, err, "problem with parameters: duties refresh requires a duties cache TTL")
+}
+
+func TestDutiesRefreshCopies(t *testing.T) {
+	server := newTestServerWithHandlers(t, map[string]http.HandlerFunc{
+		"/eth/v1/beacon/genesis":              respondWith(genesisResponse(time.Now().Add(-10 * time.Second))),
+		"/eth/v1/config/spec":                 respondWith(`{"data":{"SECONDS_PER_SLOT":"1","SLOTS_PER_EPOCH":"32"}}`),
+		"/eth/v1/validator/duties/proposer/0": respondWith(`{"dependent_root":"0x0101010101010101010101010101010101010101010101010101010101010101","data":[{"pubkey":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f","validator_index":"1","slot":"5"}]}`),
+		"/eth/v1/validator/duties/proposer/1": respondWith(`{"dependent_root":"0x0101010101010101010101010101010101010101010101010101010101010101","data":[]}`),
+		"/eth/v1/events": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "text/event-stream")
+			w.(http.Flusher).Flush()
+			<-r.Context().Done()
+		},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	service, err := standardhttp.New(ctx,
+		standardhttp.WithTimeout(timeout),
+		standardhttp.WithAddress(server.URL),
+		standardhttp.WithDutiesCacheTTL(time.Minute),
+		standardhttp.WithDutiesRefresh(true),
+	)
+	require.NoError(t, err)
+
+	// Altering returned duties does not alter the cache.
+	duties, err := service.ProposerDuties(ctx, 0, nil)
+	require.NoError(t, err)
+	require.Len(t, duties, 1)
+	duties[0].Slot = 99
+	duties, err = service.ProposerDuties(ctx, 0, nil)
+	require.NoError(t, err)
+	require.Len(t, duties, 1)
+	require.Equal(t, spec.Slot(5), duties[0].Slot)
+}
@@ -0,0 +1,60 @@
+// Copyright © 2020 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// dependentRootValidatorIndex is the validator for which duties are requested to obtain the dependent root.
+// Some nodes reject requests without any validators, and validator 0 is present on every chain.
+const dependentRootValidatorIndex = spec.ValidatorIndex(0)
+
+// DutiesDependentRoot provides the dependent root for attester duties at the given epoch.
+// If the dependent root changes, for example due to a chain reorganisation, duties for the epoch must be refetched.
+func (s *Service) DutiesDependentRoot(ctx context.Context, epoch spec.Epoch) (spec.Root, error) {
+	resp, err := s.attesterDuties(ctx, epoch, []spec.ValidatorIndex{dependentRootValidatorIndex})
+	if err != nil {
+		return spec.Root{}, err
+	}
+
+	if resp.DependentRoot == "" {
+		return spec.Root{}, errors.New("dependent root missing")
+	}
+	if s.dutiesCacheTTL > 0 {
+		s.expireAttesterDuties(epoch, resp.DependentRoot)
+	}
+	dependentRoot, err := parseHex(resp.DependentRoot, spec.RootLength)
+	if err != nil {
+		return spec.Root{}, errors.Wrap(err, "invalid value for dependent root")
+	}
+
+	var res spec.Root
+	copy(res[:], dependentRoot)
+	return res, nil
+}
+
+// DutiesValid returns true if attester duties for the given epoch, obtained when the dependent root was
+// previousDependentRoot, remain valid.
+func (s *Service) DutiesValid(ctx context.Context, epoch spec.Epoch, previousDependentRoot spec.Root) (bool, error) {
+	dependentRoot, err := s.DutiesDependentRoot(ctx, epoch)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to obtain current dependent root")
+	}
+
+	return dependentRoot == previousDependentRoot, nil
+}
@@ -0,0 +1,106 @@
+// Copyright © 2020 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDutiesValid(t *testing.T) {
+	tests := []struct {
+		name                  string
+		response              string
+		previousDependentRoot spec.Root
+		valid                 bool
+		err                   string
+	}{
+		{
+			name:     "DependentRootMissing",
+			response: `{"data":[]}`,
+			err:      "failed to obtain current dependent root: dependent root missing",
+		},
+		{
+			name:     "DependentRootInvalid",
+			response: `{"dependent_root":"invalid","data":[]}`,
+			err:      "failed to obtain current dependent root: invalid value for dependent root: encoding/hex: invalid byte: U+0069 'i'",
+		},
+		{
+			name:     "DependentRootShort",
+			response: `{"dependent_root":"0x0102","data":[]}`,
+			err:      "failed to obtain current dependent root: invalid value for dependent root: incorrect length 2",
+		},
+		{
+			name:                  "Changed",
+			response:              `{"dependent_root":"0x0202020202020202020202020202020202020202020202020202020202020202","data":[]}`,
+			previousDependentRoot: spec.Root{0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01},
+			valid:                 false,
+		},
+		{
+			name:                  "Unchanged",
+			response:              `{"dependent_root":"0x0101010101010101010101010101010101010101010101010101010101010101","data":[]}`,
+			previousDependentRoot: spec.Root{0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01},
+			valid:                 true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := newTestServer(t, map[string]string{
+				"/eth/v1/validator/duties/attester/10": test.response,
+			})
+			service, err := standardhttp.New(context.Background(),
+				standardhttp.WithTimeout(timeout),
+				standardhttp.WithAddress(server.URL),
+			)
+			require.NoError(t, err)
+
+			valid, err := service.DutiesValid(context.Background(), 10, test.previousDependentRoot)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.valid, valid)
+			}
+		})
+	}
+}
+
+func TestDutiesDependentRootRequest(t *testing.T) {
+	var body string
+	server := newTestServerWithHandlers(t, map[string]http.HandlerFunc{
+		"/eth/v1/validator/duties/attester/10": func(w http.ResponseWriter, r *http.Request) {
+			data, err := ioutil.ReadAll(r.Body)
+			require.NoError(t, err)
+			body = string(data)
+			respondWith(`{"dependent_root":"0x0101010101010101010101010101010101010101010101010101010101010101","data":[]}`)(w, r)
+		},
+	})
+	service, err := standardhttp.New(context.Background(),
+		standardhttp.WithTimeout(timeout),
+		standardhttp.WithAddress(server.URL),
+	)
+	require.NoError(t, err)
+
+	_, err = service.DutiesDependentRoot(context.Background(), 10)
+	require.NoError(t, err)
+	// Duties are requested for a validator, as some nodes reject empty requests.
+	require.Equal(t, `["0"]`, body)
+}
@@ -0,0 +1,135 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// requestIDHeaders are the response headers in which nodes and proxies supply request identifiers.
+var requestIDHeaders = []string{
+	"X-Request-Id",
+	"X-Correlation-Id",
+	"Request-Id",
+	"X-Amzn-Requestid",
+}
+
+// requestIDFields are the error body fields in which nodes supply request identifiers.
+var requestIDFields = []string{
+	"request_id",
+	"requestId",
+	"correlation_id",
+	"correlationId",
+}
+
+// parseErrorBody obtains the code and message from the body of an error response.
+// Nodes return errors in a number of forms: an object with code and message, an object with
+// just a message (or, for some gateways, an error), a JSON string, an array wrapping any of these,
+// or plain text.  The code is 0 if not supplied by the node.
+func parseErrorBody(body []byte) (int, string) {
+	body = bytes.TrimSpace(body)
+	if len(body) == 0 {
+		return 0, ""
+	}
+	if !json.Valid(body) {
+		return 0, string(body)
+	}
+
+	switch body[0] {
+	case '[':
+		var items []json.RawMessage
+		if err := json.Unmarshal(body, &items); err != nil || len(items) == 0 {
+			return 0, ""
+		}
+		// Use the code of the first item, and combine the messages.
+		code, message := parseErrorBody(items[0])
+		for _, item := range items[1:] {
+			if _, itemMessage := parseErrorBody(item); itemMessage != "" {
+				message = strings.TrimPrefix(message+"; "+itemMessage, "; ")
+			}
+		}
+		return code, message
+	case '{':
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(body, &fields); err != nil {
+			return 0, ""
+		}
+		code := errorCode(fields["code"])
+		if code == 0 {
+			code = errorCode(fields["status"])
+		}
+		message := errorString(fields["message"])
+		if message == "" {
+			message = errorString(fields["error"])
+		}
+		return code, message
+	case '"':
+		return 0, errorString(body)
+	default:
+		// A bare number, boolean or null carries no useful information.
+		return 0, ""
+	}
+}
+
+// errorCode parses a code that may be a number or a string.
+func errorCode(input json.RawMessage) int {
+	if len(input) == 0 {
+		return 0
+	}
+	code, err := strconv.Atoi(strings.Trim(string(input), `"`))
+	if err != nil {
+		return 0
+	}
+	return code
+}
+
+// errorString parses a message that may be a string or an object with a message.
+func errorString(input json.RawMessage) string {
+	if len(input) == 0 {
+		return ""
+	}
+	var message string
+	if err := json.Unmarshal(input, &message); err == nil {
+		return strings.TrimSpace(message)
+	}
+	if input[0] == '{' {
+		_, message = parseErrorBody(input)
+	}
+	return message
+}
+
+// errorRequestID obtains the request identifier for an error response, if present.
+// Headers take precedence over fields in the body.
+func errorRequestID(header http.Header, body []byte) string {
+	for _, name := range requestIDHeaders {
+		if id := strings.TrimSpace(header.Get(name)); id != "" {
+			return id
+		}
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(bytes.TrimSpace(body), &fields); err != nil {
+		return ""
+	}
+	for _, name := range requestIDFields {
+		if id := errorString(fields[name]); id != "" {
+			return id
+		}
+	}
+	return ""
+}
@@ -0,0 +1,179 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseErrorBody(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		code    int
+		message string
+	}{
+		{
+			name: "Empty",
+		},
+		{
+			name:    "Lighthouse",
+			body:    `{"code":400,"message":"BAD_REQUEST: invalid validator ID foo","stacktraces":[]}`,
+			code:    400,
+			message: "BAD_REQUEST: invalid validator ID foo",
+		},
+		{
+			name:    "Teku",
+			body:    `{"code":400,"message":"Invalid state ID: foo"}`,
+			code:    400,
+			message: "Invalid state ID: foo",
+		},
+		{
+			name:    "TekuStringCode",
+			body:    `{"code":"503","message":"Beacon node is currently syncing"}`,
+			code:    503,
+			message: "Beacon node is currently syncing",
+		},
+		{
+			name:    "Prysm",
+			body:    `{"message":"Could not get state: invalid state ID","code":400}`,
+			code:    400,
+			message: "Could not get state: invalid state ID",
+		},
+		{
+			name:    "PrysmGateway",
+			body:    `{"error":"could not decode request","code":3,"message":"","details":[]}`,
+			code:    3,
+			message: "could not decode request",
+		},
+		{
+			name:    "Nimbus",
+			body:    `{"code":400,"message":"Invalid state identifier value","stacktraces":["unable to parse"]}`,
+			code:    400,
+			message: "Invalid state identifier value",
+		},
+		{
+			name:    "NimbusPlainText",
+			body:    "Invalid validator ID\n",
+			message: "Invalid validator ID",
+		},
+		{
+			name:    "MessageOnly",
+			body:    `{"message":"not ready"}`,
+			message: "not ready",
+		},
+		{
+			name:    "Status",
+			body:    `{"status":404,"message":"Block not found"}`,
+			code:    404,
+			message: "Block not found",
+		},
+		{
+			name:    "NestedError",
+			body:    `{"error":{"code":500,"message":"internal error"}}`,
+			message: "internal error",
+		},
+		{
+			name:    "BareString",
+			body:    `"Internal server error"`,
+			message: "Internal server error",
+		},
+		{
+			name:    "ArrayWrapped",
+			body:    `[{"code":400,"message":"first"},{"code":400,"message":"second"}]`,
+			code:    400,
+			message: "first; second",
+		},
+		{
+			name:    "ArrayOfStrings",
+			body:    `["first","second"]`,
+			message: "first; second",
+		},
+		{
+			name: "ArrayEmpty",
+			body: `[]`,
+		},
+		{
+			name: "NoMessage",
+			body: `{"code":400}`,
+			code: 400,
+		},
+		{
+			name: "Number",
+			body: `400`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			code, message := parseErrorBody([]byte(test.body))
+			require.Equal(t, test.code, code)
+			require.Equal(t, test.message, message)
+		})
+	}
+}
+
+func TestErrorRequestID(t *testing.T) {
+	tests := []struct {
+		name      string
+		header    http.Header
+		body      string
+		requestID string
+	}{
+		{
+			name: "None",
+			body: `{"code":500,"message":"internal error"}`,
+		},
+		{
+			name:      "Header",
+			header:    http.Header{"X-Request-Id": []string{"abc123"}},
+			body:      `{"code":500,"message":"internal error"}`,
+			requestID: "abc123",
+		},
+		{
+			name:      "CorrelationHeader",
+			header:    http.Header{"X-Correlation-Id": []string{"def456"}},
+			body:      `internal error`,
+			requestID: "def456",
+		},
+		{
+			name:      "Body",
+			body:      `{"code":500,"message":"internal error","request_id":"ghi789"}`,
+			requestID: "ghi789",
+		},
+		{
+			name:      "HeaderPrecedence",
+			header:    http.Header{"X-Request-Id": []string{"abc123"}},
+			body:      `{"code":500,"message":"internal error","requestId":"ghi789"}`,
+			requestID: "abc123",
+		},
+		{
+			name: "NotJSON",
+			body: `request_id: abc`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			header := test.header
+			if header == nil {
+				header = http.Header{}
+			}
+			require.Equal(t, test.requestID, errorRequestID(header, []byte(test.body)))
+		})
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+)
+
+// eventHistoryEntry is an event retained in the history.
+type eventHistoryEntry struct {
+	// seq orders events across topics.
+	seq   uint64
+	data  []byte
+	event *api.Event
+}
+
+// eventHistory retains the most recent events for each topic, for replay to new subscribers.
+type eventHistory struct {
+	size   int
+	mu     sync.Mutex
+	seq    uint64
+	topics map[string][]*eventHistoryEntry
+}
+
+// newEventHistory creates a history retaining up to size events per topic.
+func newEventHistory(size int) *eventHistory {
+	return &eventHistory{
+		size:   size,
+		topics: make(map[string][]*eventHistoryEntry),
+	}
+}
+
+// add adds an event, with the raw data from which it was parsed, to the history.
+// An event that is already retained, for example because it was received on more than one stream, is ignored.
+func (h *eventHistory) add(event *api.Event, data []byte) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	entries := h.topics[event.Topic]
+	for _, entry := range entries {
+		if bytes.Equal(entry.data, data) {
+			return
+		}
+	}
+	h.seq++
+	entries = append(entries, &eventHistoryEntry{
+		seq:   h.seq,
+		data:  append([]byte(nil), data...),
+		event: event,
+	})
+	if len(entries) > h.size {
+		entries = entries[len(entries)-h.size:]
+	}
+	h.topics[event.Topic] = entries
+}
+
+// events provides the retained events for the given topics, in the order in which they were received.
+func (h *eventHistory) events(topics []string) []*api.Event {
+	h.mu.Lock()
+	entries := make([]*eventHistoryEntry, 0)
+	for _, topic := range topics {
+		entries = append(entries, h.topics[topic]...)
+	}
+	h.mu.Unlock()
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].seq < entries[j].seq
+	})
+	events := make([]*api.Event, len(entries))
+	for i := range entries {
+		events[i] = entries[i].event
+	}
+
+	return events
+}
@@ -0,0 +1,107 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventHistory(t *testing.T) {
+	headEvent := `{"slot":"%d","block":"0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20","state":"0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20","epoch_transition":false,"previous_duty_dependent_root":"0x0101010101010101010101010101010101010101010101010101010101010101","current_duty_dependent_root":"0x0101010101010101010101010101010101010101010101010101010101010101"}`
+	connections := int32(0)
+	server := newTestServerWithHandlers(t, map[string]http.HandlerFunc{
+		"/eth/v1/events": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "text/event-stream")
+			if atomic.AddInt32(&connections, 1) == 1 {
+				// Only the first stream receives events.
+				for slot := 1; slot <= 3; slot++ {
+					fmt.Fprintf(w, "event: head\ndata: %s\n\n", fmt.Sprintf(headEvent, slot))
+				}
+			}
+			w.(http.Flusher).Flush()
+			<-r.Context().Done()
+		},
+	})
+
+	_, err := standardhttp.New(context.Background(),
+		standardhttp.WithTimeout(timeout),
+		standardhttp.WithAddress(server.URL),
+		standardhttp.WithEventHistory(-1),
+	)
+	require.EqualError(t, err, "problem with parameters: invalid event history size specified")
+
+	service, err := standardhttp.New(context.Background(),
+		standardhttp.WithTimeout(timeout),
+		standardhttp.WithAddress(server.URL),
+		standardhttp.WithEventHistory(2),
+	)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	receiveSlots := func(heads chan spec.Slot, count int) []spec.Slot {
+		slots := make([]spec.Slot, 0, count)
+		for len(slots) < count {
+			select {
+			case slot := <-heads:
+				slots = append(slots, slot)
+			case <-time.After(10 * time.Second):
+				require.Fail(t, "head event not received")
+				return slots
+			}
+		}
+		return slots
+	}
+	subscribe := func() chan spec.Slot {
+		heads := make(chan spec.Slot, 16)
+		require.NoError(t, service.Events(ctx, []string{"head"}, func(event *api.Event) {
+			if headEvent, isHeadEvent := event.Data.(*api.HeadEvent); isHeadEvent {
+				heads <- headEvent.Slot
+			}
+		}))
+		return heads
+	}
+
+	require.Equal(t, []spec.Slot{1, 2, 3}, receiveSlots(subscribe(), 3))
+
+	// A late subscriber receives the most recent events from the history.
+	late := subscribe()
+	require.Equal(t, []spec.Slot{2, 3}, receiveSlots(late, 2))
+	select {
+	case slot := <-late:
+		require.Fail(t, "unexpected head event", "slot %d", slot)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// Subscribers to other topics receive nothing.
+	blocks := make(chan *api.Event, 1)
+	require.NoError(t, service.Events(ctx, []string{"block"}, func(event *api.Event) {
+		blocks <- event
+	}))
+	select {
+	case <-blocks:
+		require.Fail(t, "unexpected block event")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
@@ -17,21 +17,30 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"net/url"
+	"runtime/debug"
 	"strings"
+	"time"
 
 	client "github.com/attestantio/go-eth2-client"
 	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/altair"
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 	"github.com/r3labs/sse/v2"
+	"gopkg.in/cenkalti/backoff.v1"
 )
 
 // Events feeds requested events with the given topics to the supplied handler.
+// The stream runs until either the supplied context or the service context is done.
 func (s *Service) Events(ctx context.Context, topics []string, handler client.EventHandlerFunc) error {
 	if len(topics) == 0 {
 		return errors.New("no topics supplied")
 	}
+	if err := s.checkNetworkUnchanged(); err != nil {
+		return err
+	}
 
 	// Ensure we support the requested topic(s).
 	for i := range topics {
@@ -40,25 +49,153 @@ func (s *Service) Events(ctx context.Context, topics []string, handler client.Ev
 		}
 	}
 
-	reference, err := url.Parse(fmt.Sprintf("/eth/v1/events?topics=%s", strings.Join(topics, "&topics=")))
+	// Some topics are optional, so drop them if the node does not support them.
+	supportedTopics := make([]string, 0, len(topics))
+	for i := range topics {
+		if api.OptionalEventTopics[topics[i]] {
+			supported, err := s.probeEventTopic(ctx, topics[i])
+			if err != nil {
+				// Failure to probe the topic is not treated as a rejection, and is left to the event stream to handle.
+				s.log.Debug().Err(err).Str("topic", topics[i]).Msg("Failed to check event topic support")
+				supported = true
+			}
+			if !supported {
+				s.log.Warn().Str("topic", topics[i]).Msg("Node does not support event topic; ignoring")
+				continue
+			}
+		}
+		supportedTopics = append(supportedTopics, topics[i])
+	}
+	if len(supportedTopics) == 0 {
+		return errors.New("no supported topics supplied")
+	}
+	topics = supportedTopics
+
+	endpoint := fmt.Sprintf("/eth/v1/events?topics=%s", strings.Join(topics, "&topics="))
+	reference, err := url.Parse(endpoint)
 	if err != nil {
 		return errors.Wrap(err, "invalid endpoint")
 	}
 	url := s.base.ResolveReference(reference).String()
-	log.Trace().Str("url", url).Msg("GET request to events stream")
+	s.log.Trace().Str("url", url).Msg("GET request to events stream")
 
-	client := sse.NewClient(url)
-	go func() {
-		if err := client.SubscribeRawWithContext(ctx, func(msg *sse.Event) {
-			s.handleEvent(msg, handler)
-		}); err != nil {
-			log.Error().Err(err).Msg("Failed to subscribe to event stream")
+	// Derive the stream context from both the supplied and service contexts, so that closing the
+	// service also stops the stream.
+	ctx, cancel := s.withServiceContext(ctx)
+
+	client := sse.NewClient(url, sse.ClientMaxBufferSize(s.eventBufferSize))
+	// Use the service's transport, adding headers to each connection attempt rather than once for the stream.
+	client.Connection = &http.Client{
+		Transport: &headerTransport{
+			service: s,
+			next:    s.client.Transport,
+		},
+	}
+	// Keep reconnecting until the context is done.  The client retains the ID of the last event
+	// received and sends it as Last-Event-ID when reconnecting, allowing nodes that support it to
+	// replay events that were missed whilst disconnected.
+	// attempts is the number of reconnection attempts since the stream was last connected.  It is only
+	// accessed from within the subscription goroutine.
+	attempts := 0
+	var reconnectBackOff backoff.BackOff = s.newReconnectBackOff()
+	if s.maxReconnects > 0 {
+		reconnectBackOff = &budgetBackOff{
+			BackOff:     reconnectBackOff,
+			maxAttempts: s.maxReconnects,
+			attempts:    &attempts,
 		}
-	}()
+	}
+	client.ReconnectStrategy = backoff.WithContext(reconnectBackOff, ctx)
+	client.ReconnectNotify = func(err error, wait time.Duration) {
+		s.log.Debug().Err(err).Dur("wait", wait).Str("last_event_id", client.EventID).Msg("Event stream disconnected; reconnecting")
+		attempts++
+		if s.onReconnect != nil {
+			s.onReconnect(s.address, endpoint, attempts)
+		}
+	}
+	client.ResponseValidator = func(c *sse.Client, resp *http.Response) error {
+		if resp.StatusCode != http.StatusOK {
+			return fmt.Errorf("could not connect to stream: status %d", resp.StatusCode)
+		}
+		attempts = 0
+		if s.onConnected != nil {
+			s.onConnected(s.address, endpoint)
+		}
+		return nil
+	}
+	if err := s.background.run("event stream", func() {
+		defer cancel()
+		// Replay retained events before those from the stream.
+		if s.eventHistory != nil {
+			for _, event := range s.eventHistory.events(topics) {
+				s.callHandler(handler, event)
+			}
+		}
+		// Back off separately for streams closed by the node, resetting once events flow again.
+		closedBackOff := s.newReconnectBackOff()
+		for {
+			err := client.SubscribeRawWithContext(ctx, func(msg *sse.Event) {
+				closedBackOff.Reset()
+				s.handleEvent(msg, handler)
+			})
+			if ctx.Err() != nil {
+				// Context is done, so we are no longer interested in events.
+				return
+			}
+			if err != nil {
+				s.log.Error().Err(err).Msg("Failed to subscribe to event stream")
+				s.streamFailed(endpoint, err)
+				return
+			}
+			if s.maxReconnects > 0 && attempts >= s.maxReconnects {
+				s.log.Error().Int("attempts", attempts).Msg("Event stream closed and maximum reconnects reached")
+				s.streamFailed(endpoint, errors.New("event stream closed; maximum reconnects reached"))
+				return
+			}
+			// The node closed the stream; reconnect after a pause.
+			wait := closedBackOff.NextBackOff()
+			s.log.Debug().Dur("wait", wait).Str("last_event_id", client.EventID).Msg("Event stream closed; reconnecting")
+			attempts++
+			if s.onReconnect != nil {
+				s.onReconnect(s.address, endpoint, attempts)
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(wait):
+			}
+		}
+	}); err != nil {
+		cancel()
+		return err
+	}
 
 	return nil
 }
 
+// streamErrorsBufferSize is the number of abandoned stream errors retained for the caller.
+const streamErrorsBufferSize = 16
+
+// StreamErrors provides a channel that receives a client.StreamFailedError each time an event stream is abandoned,
+// which happens when the stream cannot be reconnected within the limit set by WithMaxReconnects.
+// Errors are dropped if the channel is full.  The channel is never closed.
+func (s *Service) StreamErrors() <-chan error {
+	return s.streamErrors
+}
+
+// streamFailed informs the caller that an event stream has been abandoned.
+func (s *Service) streamFailed(endpoint string, err error) {
+	if s.onStreamFailed != nil {
+		s.onStreamFailed(s.address, endpoint, err)
+	}
+
+	select {
+	case s.streamErrors <- &client.StreamFailedError{Endpoint: endpoint, Err: err}:
+	default:
+		s.log.Warn().Str("endpoint", endpoint).Msg("Stream errors channel full; dropping error")
+	}
+}
+
 // handleEvent parses an event and passes it on to the handler.
 func (s *Service) handleEvent(msg *sse.Event, handler client.EventHandlerFunc) {
 	event := &api.Event{
@@ -69,48 +206,113 @@ func (s *Service) handleEvent(msg *sse.Event, handler client.EventHandlerFunc) {
 		headEvent := &api.HeadEvent{}
 		err := json.Unmarshal(msg.Data, headEvent)
 		if err != nil {
-			log.Error().Err(err).Msg("Failed to parse head event")
+			s.log.Error().Err(err).Msg("Failed to parse head event")
 		}
 		event.Data = headEvent
 	case "block":
 		blockEvent := &api.BlockEvent{}
 		err := json.Unmarshal(msg.Data, blockEvent)
 		if err != nil {
-			log.Error().Err(err).Msg("Failed to parse block event")
+			s.log.Error().Err(err).Msg("Failed to parse block event")
 		}
 		event.Data = blockEvent
 	case "attestation":
 		attestation := &spec.Attestation{}
 		err := json.Unmarshal(msg.Data, attestation)
 		if err != nil {
-			log.Error().Err(err).Msg("Failed to parse attestation")
+			s.log.Error().Err(err).Msg("Failed to parse attestation")
 		}
 		event.Data = attestation
 	case "voluntary_exit":
 		voluntaryExit := &spec.SignedVoluntaryExit{}
 		err := json.Unmarshal(msg.Data, voluntaryExit)
 		if err != nil {
-			log.Error().Err(err).Msg("Failed to parse voluntary exit")
+			s.log.Error().Err(err).Msg("Failed to parse voluntary exit")
 		}
 		event.Data = voluntaryExit
 	case "finalized_checkpoint":
 		finalizedCheckpointEvent := &api.FinalizedCheckpointEvent{}
 		err := json.Unmarshal(msg.Data, finalizedCheckpointEvent)
 		if err != nil {
-			log.Error().Err(err).Msg("Failed to parse finalized checkpoint event")
+			s.log.Error().Err(err).Msg("Failed to parse finalized checkpoint event")
 		}
 		event.Data = finalizedCheckpointEvent
 	case "chain_reorg":
 		chainReorgEvent := &api.ChainReorgEvent{}
 		err := json.Unmarshal(msg.Data, chainReorgEvent)
 		if err != nil {
-			log.Error().Err(err).Msg("Failed to parse chain reorg event")
+			s.log.Error().Err(err).Msg("Failed to parse chain reorg event")
 		}
 		event.Data = chainReorgEvent
+	case "block_gossip":
+		blockGossipEvent := &api.BlockGossipEvent{}
+		err := json.Unmarshal(msg.Data, blockGossipEvent)
+		if err != nil {
+			s.log.Error().Err(err).Msg("Failed to parse block gossip event")
+		}
+		event.Data = blockGossipEvent
+	case "attester_slashing":
+		attesterSlashing := &spec.AttesterSlashing{}
+		err := json.Unmarshal(msg.Data, attesterSlashing)
+		if err != nil {
+			s.log.Error().Err(err).Msg("Failed to parse attester slashing")
+		}
+		event.Data = attesterSlashing
+	case "proposer_slashing":
+		proposerSlashing := &spec.ProposerSlashing{}
+		err := json.Unmarshal(msg.Data, proposerSlashing)
+		if err != nil {
+			s.log.Error().Err(err).Msg("Failed to parse proposer slashing")
+		}
+		event.Data = proposerSlashing
+	case "light_client_finality_update":
+		finalityUpdate := &altair.LightClientFinalityUpdate{}
+		err := unmarshalEventData(msg.Data, finalityUpdate)
+		if err != nil {
+			s.log.Error().Err(err).Msg("Failed to parse light client finality update")
+		}
+		event.Data = finalityUpdate
+	case "light_client_optimistic_update":
+		optimisticUpdate := &altair.LightClientOptimisticUpdate{}
+		err := unmarshalEventData(msg.Data, optimisticUpdate)
+		if err != nil {
+			s.log.Error().Err(err).Msg("Failed to parse light client optimistic update")
+		}
+		event.Data = optimisticUpdate
 	case "":
 		// A message with a blank event comes when the event stream shuts down.  Ignore it.
 	default:
-		log.Warn().Str("topic", string(msg.Event)).Msg("Received message with unhandled topic")
+		s.log.Warn().Str("topic", string(msg.Event)).Msg("Received message with unhandled topic")
+	}
+	if s.eventHistory != nil && event.Topic != "" {
+		s.eventHistory.add(event, msg.Data)
 	}
+	s.callHandler(handler, event)
+}
+
+// callHandler passes an event to the handler.  A panic in the handler is recovered and logged, so that a faulty
+// handler does not silently stop the event stream.
+func (s *Service) callHandler(handler client.EventHandlerFunc, event *api.Event) {
+	defer func() {
+		if r := recover(); r != nil {
+			s.log.Error().Str("topic", event.Topic).Interface("panic", r).Bytes("stack", debug.Stack()).Msg("Recovered from panic in event handler")
+		}
+	}()
 	handler(event)
 }
+
+// versionedEventDataJSON is the wrapper that some nodes place around event data.
+type versionedEventDataJSON struct {
+	Version string          `json:"version"`
+	Data    json.RawMessage `json:"data"`
+}
+
+// unmarshalEventData unmarshals event data that may or may not be wrapped with its fork version.
+func unmarshalEventData(input []byte, data interface{}) error {
+	var versioned versionedEventDataJSON
+	if err := json.Unmarshal(input, &versioned); err == nil && len(versioned.Data) > 0 {
+		return json.Unmarshal(versioned.Data, data)
+	}
+
+	return json.Unmarshal(input, data)
+}
@@ -15,16 +15,26 @@ package v1_test
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net/http"
 	"os"
+	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	client "github.com/attestantio/go-eth2-client"
 	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
 	"github.com/stretchr/testify/require"
 )
 
 func TestEvents(t *testing.T) {
+	skipWithoutNode(t)
+
 	tests := []struct {
 		name   string
 		topics []string
@@ -55,3 +65,383 @@ func TestEvents(t *testing.T) {
 		})
 	}
 }
+
+func TestEventsLastEventID(t *testing.T) {
+	headEvent := `{"slot":"%d","block":"0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20","state":"0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20","epoch_transition":false}`
+	lastEventIDs := make(chan string, 2)
+	connections := int32(0)
+	server := newTestServerWithHandlers(t, map[string]http.HandlerFunc{
+		"/eth/v1/events": func(w http.ResponseWriter, r *http.Request) {
+			connection := atomic.AddInt32(&connections, 1)
+			lastEventIDs <- r.Header.Get("Last-Event-ID")
+			w.Header().Set("Content-Type", "text/event-stream")
+			fmt.Fprintf(w, "id: %d\nevent: head\ndata: %s\n\n", connection, fmt.Sprintf(headEvent, connection))
+			w.(http.Flusher).Flush()
+			if connection > 1 {
+				// Hold the second connection open.
+				<-r.Context().Done()
+			}
+		},
+	})
+
+	service, err := standardhttp.New(context.Background(),
+		standardhttp.WithTimeout(timeout),
+		standardhttp.WithAddress(server.URL),
+	)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	slots := make(chan uint64, 2)
+	require.NoError(t, service.Events(ctx, []string{"head"}, func(event *api.Event) {
+		if headEvent, isHeadEvent := event.Data.(*api.HeadEvent); isHeadEvent {
+			slots <- uint64(headEvent.Slot)
+		}
+	}))
+
+	// Initial connection has no last event ID.
+	require.Equal(t, "", <-lastEventIDs)
+	require.Equal(t, uint64(1), <-slots)
+
+	// Reconnection supplies the ID of the last event received.
+	select {
+	case lastEventID := <-lastEventIDs:
+		require.Equal(t, "1", lastEventID)
+	case <-time.After(10 * time.Second):
+		require.Fail(t, "event stream did not reconnect")
+	}
+	require.Equal(t, uint64(2), <-slots)
+}
+
+func TestEventsServiceContext(t *testing.T) {
+	closed := make(chan struct{})
+	server := newTestServerWithHandlers(t, map[string]http.HandlerFunc{
+		"/eth/v1/events": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "text/event-stream")
+			w.(http.Flusher).Flush()
+			<-r.Context().Done()
+			close(closed)
+		},
+	})
+
+	serviceCtx, serviceCancel := context.WithCancel(context.Background())
+	service, err := standardhttp.New(serviceCtx,
+		standardhttp.WithTimeout(timeout),
+		standardhttp.WithAddress(server.URL),
+	)
+	require.NoError(t, err)
+	require.NoError(t, service.Context().Err())
+
+	require.NoError(t, service.Events(context.Background(), []string{"head"}, func(event *api.Event) {}))
+
+	// Cancelling the service context should close the stream.
+	time.Sleep(100 * time.Millisecond)
+	serviceCancel()
+	select {
+	case <-closed:
+	case <-time.After(10 * time.Second):
+		require.Fail(t, "event stream not closed by service context")
+	}
+	<-service.Context().Done()
+}
+
+func TestEventsConnectionCallbacks(t *testing.T) {
+	connections := int32(0)
+	server := newTestServerWithHandlers(t, map[string]http.HandlerFunc{
+		"/eth/v1/events": func(w http.ResponseWriter, r *http.Request) {
+			connection := atomic.AddInt32(&connections, 1)
+			w.Header().Set("Content-Type", "text/event-stream")
+			w.(http.Flusher).Flush()
+			if connection > 1 {
+				// Hold the second connection open.
+				<-r.Context().Done()
+			}
+		},
+	})
+
+	connected := make(chan string, 2)
+	reconnected := make(chan int, 2)
+	service, err := standardhttp.New(context.Background(),
+		standardhttp.WithTimeout(timeout),
+		standardhttp.WithAddress(server.URL),
+		standardhttp.WithOnConnected(func(backend string, endpoint string) {
+			require.Equal(t, server.URL, backend)
+			connected <- endpoint
+		}),
+		standardhttp.WithOnReconnect(func(backend string, endpoint string, attempt int) {
+			require.Equal(t, server.URL, backend)
+			require.Equal(t, "/eth/v1/events?topics=head", endpoint)
+			reconnected <- attempt
+		}),
+	)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, service.Events(ctx, []string{"head"}, func(event *api.Event) {}))
+
+	// Initial connection.
+	require.Equal(t, "/eth/v1/events?topics=head", <-connected)
+
+	// The node closes the stream, resulting in a reconnection.
+	select {
+	case attempt := <-reconnected:
+		require.Equal(t, 1, attempt)
+	case <-time.After(10 * time.Second):
+		require.Fail(t, "event stream did not attempt to reconnect")
+	}
+	select {
+	case endpoint := <-connected:
+		require.Equal(t, "/eth/v1/events?topics=head", endpoint)
+	case <-time.After(10 * time.Second):
+		require.Fail(t, "event stream did not reconnect")
+	}
+}
+
+func TestEventsMaxReconnects(t *testing.T) {
+	connections := int32(0)
+	server := newTestServerWithHandlers(t, map[string]http.HandlerFunc{
+		"/eth/v1/events": func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&connections, 1)
+			w.WriteHeader(http.StatusServiceUnavailable)
+		},
+	})
+
+	failed := make(chan error, 1)
+	service, err := standardhttp.New(context.Background(),
+		standardhttp.WithTimeout(timeout),
+		standardhttp.WithAddress(server.URL),
+		standardhttp.WithMaxReconnects(2),
+		standardhttp.WithOnStreamFailed(func(backend string, endpoint string, err error) {
+			require.Equal(t, server.URL, backend)
+			require.Equal(t, "/eth/v1/events?topics=head", endpoint)
+			failed <- err
+		}),
+	)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, service.Events(ctx, []string{"head"}, func(event *api.Event) {}))
+
+	select {
+	case err := <-failed:
+		require.EqualError(t, err, "could not connect to stream: status 503")
+	case <-time.After(10 * time.Second):
+		require.Fail(t, "event stream did not fail")
+	}
+	select {
+	case err := <-service.StreamErrors():
+		require.EqualError(t, err, "event stream /eth/v1/events?topics=head failed: could not connect to stream: status 503")
+		var streamErr *client.StreamFailedError
+		require.True(t, errors.As(err, &streamErr))
+		require.Equal(t, "/eth/v1/events?topics=head", streamErr.Endpoint)
+	case <-time.After(10 * time.Second):
+		require.Fail(t, "stream error not provided")
+	}
+	// The initial connection plus two reconnects.
+	require.Equal(t, int32(3), atomic.LoadInt32(&connections))
+}
+
+func TestEventsLightClientTopics(t *testing.T) {
+	finalityUpdate := `{"version":"altair","data":{"attested_header":{"beacon":{"slot":"1","proposer_index":"2","parent_root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","state_root":"0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f","body_root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f"}},"finalized_header":{"beacon":{"slot":"1","proposer_index":"2","parent_root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","state_root":"0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f","body_root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f"}},"finality_branch":["0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f","0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f","0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f"],"sync_aggregate":{"sync_committee_bits":"0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff7f","sync_committee_signature":"0x404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f606162636465666768696a6b6c6d6e6f707172737475767778797a7b7c7d7e7f808182838485868788898a8b8c8d8e8f909192939495969798999a9b9c9d9e9f"},"signature_slot":"3"}}`
+	server := newTestServerWithHandlers(t, map[string]http.HandlerFunc{
+		"/eth/v1/events": func(w http.ResponseWriter, r *http.Request) {
+			for _, topic := range r.URL.Query()["topics"] {
+				if topic == "light_client_optimistic_update" {
+					// Node does not support this topic.
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+			}
+			w.Header().Set("Content-Type", "text/event-stream")
+			fmt.Fprintf(w, "event: light_client_finality_update\ndata: %s\n\n", finalityUpdate)
+			w.(http.Flusher).Flush()
+			<-r.Context().Done()
+		},
+	})
+
+	service, err := standardhttp.New(context.Background(),
+		standardhttp.WithTimeout(timeout),
+		standardhttp.WithAddress(server.URL),
+	)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Requesting only unsupported light client topics is an error.
+	require.EqualError(t, service.Events(ctx, []string{"light_client_optimistic_update"}, func(event *api.Event) {}), "no supported topics supplied")
+
+	// Unsupported light client topics are dropped from the subscription.
+	updates := make(chan *altair.LightClientFinalityUpdate, 1)
+	require.NoError(t, service.Events(ctx, []string{"light_client_finality_update", "light_client_optimistic_update"}, func(event *api.Event) {
+		if update, isUpdate := event.Data.(*altair.LightClientFinalityUpdate); isUpdate {
+			updates <- update
+		}
+	}))
+
+	select {
+	case update := <-updates:
+		require.Equal(t, uint64(3), uint64(update.SignatureSlot))
+		require.Equal(t, uint64(1), uint64(update.FinalizedHeader.Beacon.Slot))
+		require.Len(t, update.FinalityBranch, 6)
+	case <-time.After(10 * time.Second):
+		require.Fail(t, "light client finality update not received")
+	}
+}
+
+func TestEventsOversizedEvent(t *testing.T) {
+	// Aggregation bits of this size take the event well beyond the default 64KiB of a line scanner.
+	aggregationBits := fmt.Sprintf("0x%s01", strings.Repeat("ff", 128*1024))
+	attestation := fmt.Sprintf(`{"aggregation_bits":"%s","data":{"slot":"66","index":"0","beacon_block_root":"0x737b2949b471552a7f95f772e289ae6d74bd8e527120d9993095fd34ed89e100","source":{"epoch":"0","root":"0x0000000000000000000000000000000000000000000000000000000000000000"},"target":{"epoch":"2","root":"0x674d7e0ce7a28ba0d71ecef8d44621e8f4ed206e9116dc647fafd7f32f61f440"}},"signature":"0x8a75731b877a4be72ddc81ae5318eaa9863fef2297b58a4f01a447bd1fff10d48bb79e62d280557c472af5d457032e0112db17f99b2e925ce2c89dd839e5bd8e5e95b2f5253bb80087753555c69b116162c334f5a142e38ff6a66ef579c9a70d"}`, aggregationBits)
+	server := newTestServerWithHandlers(t, map[string]http.HandlerFunc{
+		"/eth/v1/events": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "text/event-stream")
+			fmt.Fprintf(w, "event: attestation\ndata: %s\n\n", attestation)
+			w.(http.Flusher).Flush()
+			<-r.Context().Done()
+		},
+	})
+
+	tests := []struct {
+		name     string
+		params   []standardhttp.Parameter
+		received bool
+	}{
+		{
+			name:     "Default",
+			received: true,
+		},
+		{
+			name: "BufferTooSmall",
+			params: []standardhttp.Parameter{
+				standardhttp.WithEventBufferSize(64 * 1024),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			params := append([]standardhttp.Parameter{
+				standardhttp.WithTimeout(timeout),
+				standardhttp.WithAddress(server.URL),
+				standardhttp.WithMaxReconnects(1),
+			}, test.params...)
+			service, err := standardhttp.New(context.Background(), params...)
+			require.NoError(t, err)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			attestations := make(chan *spec.Attestation, 1)
+			require.NoError(t, service.Events(ctx, []string{"attestation"}, func(event *api.Event) {
+				if attestation, isAttestation := event.Data.(*spec.Attestation); isAttestation && attestation.Data != nil {
+					attestations <- attestation
+				}
+			}))
+
+			select {
+			case attestation := <-attestations:
+				require.True(t, test.received, "oversized attestation received")
+				require.Equal(t, uint64(66), uint64(attestation.Data.Slot))
+				require.Len(t, attestation.AggregationBits, 128*1024+1)
+			case <-time.After(time.Second):
+				require.False(t, test.received, "oversized attestation not received")
+			}
+		})
+	}
+}
+
+func TestWithEventBufferSize(t *testing.T) {
+	skipWithoutNode(t)
+
+	_, err := standardhttp.New(context.Background(),
+		standardhttp.WithAddress(os.Getenv("HTTP_ADDRESS")),
+		standardhttp.WithEventBufferSize(0),
+	)
+	require.EqualError(t, err, "problem with parameters: no event buffer size specified")
+}
+
+func TestEventsSlashings(t *testing.T) {
+	attesterSlashing := `{"attestation_1":{"attesting_indices":["1","2","3"],"data":{"slot":"100","index":"1","beacon_block_root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","source":{"epoch":"1","root":"0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f"},"target":{"epoch":"2","root":"0x404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f"}},"signature":"0x606162636465666768696a6b6c6d6e6f707172737475767778797a7b7c7d7e7f808182838485868788898a8b8c8d8e8f909192939495969798999a9b9c9d9e9fa0a1a2a3a4a5a6a7a8a9aaabacadaeafb0b1b2b3b4b5b6b7b8b9babbbcbdbebf"},"attestation_2":{"attesting_indices":["1","2","3"],"data":{"slot":"100","index":"1","beacon_block_root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","source":{"epoch":"1","root":"0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f"},"target":{"epoch":"2","root":"0x404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f"}},"signature":"0x606162636465666768696a6b6c6d6e6f707172737475767778797a7b7c7d7e7f808182838485868788898a8b8c8d8e8f909192939495969798999a9b9c9d9e9fa0a1a2a3a4a5a6a7a8a9aaabacadaeafb0b1b2b3b4b5b6b7b8b9babbbcbdbebf"}}`
+	proposerSlashing := `{"signed_header_1":{"message":{"slot":"1","proposer_index":"2","parent_root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","state_root":"0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f","body_root":"0x404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f"},"signature":"0x606162636465666768696a6b6c6d6e6f707172737475767778797a7b7c7d7e7f808182838485868788898a8b8c8d8e8f909192939495969798999a9b9c9d9e9fa0a1a2a3a4a5a6a7a8a9aaabacadaeafb0b1b2b3b4b5b6b7b8b9babbbcbdbebf"},"signed_header_2":{"message":{"slot":"1","proposer_index":"2","parent_root":"0x010102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","state_root":"0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f","body_root":"0x404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f"},"signature":"0x606162636465666768696a6b6c6d6e6f707172737475767778797a7b7c7d7e7f808182838485868788898a8b8c8d8e8f909192939495969798999a9b9c9d9e9fa0a1a2a3a4a5a6a7a8a9aaabacadaeafb0b1b2b3b4b5b6b7b8b9babbbcbdbebf"}}`
+
+	tests := []struct {
+		name                   string
+		attesterSlashingTopic  bool
+		expectAttesterSlashing bool
+	}{
+		{
+			name:                   "Supported",
+			attesterSlashingTopic:  true,
+			expectAttesterSlashing: true,
+		},
+		{
+			name: "AttesterSlashingUnsupported",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := newTestServerWithHandlers(t, map[string]http.HandlerFunc{
+				"/eth/v1/events": func(w http.ResponseWriter, r *http.Request) {
+					topics := r.URL.Query()["topics"]
+					for _, topic := range topics {
+						if topic == "attester_slashing" && !test.attesterSlashingTopic {
+							// Node does not support this topic.
+							w.WriteHeader(http.StatusBadRequest)
+							return
+						}
+					}
+					w.Header().Set("Content-Type", "text/event-stream")
+					for _, topic := range topics {
+						switch topic {
+						case "attester_slashing":
+							fmt.Fprintf(w, "event: attester_slashing\ndata: %s\n\n", attesterSlashing)
+						case "proposer_slashing":
+							fmt.Fprintf(w, "event: proposer_slashing\ndata: %s\n\n", proposerSlashing)
+						}
+					}
+					w.(http.Flusher).Flush()
+					<-r.Context().Done()
+				},
+			})
+
+			service, err := standardhttp.New(context.Background(),
+				standardhttp.WithTimeout(timeout),
+				standardhttp.WithAddress(server.URL),
+			)
+			require.NoError(t, err)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			attesterSlashings := make(chan *spec.AttesterSlashing, 1)
+			proposerSlashings := make(chan *spec.ProposerSlashing, 1)
+			require.NoError(t, service.Events(ctx, []string{"attester_slashing", "proposer_slashing"}, func(event *api.Event) {
+				switch data := event.Data.(type) {
+				case *spec.AttesterSlashing:
+					attesterSlashings <- data
+				case *spec.ProposerSlashing:
+					proposerSlashings <- data
+				}
+			}))
+
+			select {
+			case slashing := <-proposerSlashings:
+				require.Equal(t, uint64(1), uint64(slashing.SignedHeader1.Message.Slot))
+			case <-time.After(10 * time.Second):
+				require.Fail(t, "proposer slashing not received")
+			}
+			if test.expectAttesterSlashing {
+				select {
+				case slashing := <-attesterSlashings:
+					require.Equal(t, uint64(100), uint64(slashing.Attestation1.Data.Slot))
+				case <-time.After(10 * time.Second):
+					require.Fail(t, "attester slashing not received")
+				}
+			} else {
+				require.Empty(t, attesterSlashings)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+type expectedWithdrawalsJSON struct {
+	Data []*capella.Withdrawal `json:"data"`
+}
+
+// ExpectedWithdrawals provides the withdrawals that the node expects to be included in the next block built on the
+// given state.
+// stateID can be a slot number or state root, or one of the special values "genesis", "head", "justified" or "finalized".
+// If proposalSlot is supplied the withdrawals are those expected for a block proposed at that slot.
+// N.B if the requested state is not available this will return an error that wraps client.ErrNotFound.
+func (s *Service) ExpectedWithdrawals(ctx context.Context, stateID string, proposalSlot *spec.Slot) ([]*capella.Withdrawal, error) {
+	if stateID == "" {
+		return nil, errors.New("no state ID specified")
+	}
+
+	url := fmt.Sprintf("/eth/v1/builder/states/%s/expected_withdrawals", stateID)
+	if proposalSlot != nil {
+		url = fmt.Sprintf("%s?proposal_slot=%d", url, *proposalSlot)
+	}
+	respBodyReader, err := s.get(ctx, url)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request expected withdrawals")
+	}
+	if respBodyReader == nil {
+		return nil, errors.Wrap(client.ErrNotFound, "failed to obtain expected withdrawals")
+	}
+
+	var resp expectedWithdrawalsJSON
+	if err := s.decodeJSON(respBodyReader, &resp); err != nil {
+		return nil, errors.Wrap(err, "failed to parse expected withdrawals")
+	}
+	if resp.Data == nil {
+		return nil, errors.New("expected withdrawals missing")
+	}
+
+	return resp.Data, nil
+}
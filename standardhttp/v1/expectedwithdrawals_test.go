@@ -0,0 +1,108 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpectedWithdrawals(t *testing.T) {
+	proposalSlot := spec.Slot(12)
+	tests := []struct {
+		name         string
+		proposalSlot *spec.Slot
+		response     string
+		query        string
+		expected     []*capella.Withdrawal
+		err          string
+	}{
+		{
+			name:     "Good",
+			response: `{"execution_optimistic":false,"data":[{"index":"1","validator_index":"2","address":"0x000102030405060708090a0b0c0d0e0f10111213","amount":"3"}]}`,
+			expected: []*capella.Withdrawal{
+				{
+					Index:          1,
+					ValidatorIndex: 2,
+					Address:        capella.ExecutionAddress{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10, 0x11, 0x12, 0x13},
+					Amount:         3,
+				},
+			},
+		},
+		{
+			name:         "ProposalSlot",
+			proposalSlot: &proposalSlot,
+			response:     `{"data":[]}`,
+			query:        "proposal_slot=12",
+			expected:     []*capella.Withdrawal{},
+		},
+		{
+			name:     "DataMissing",
+			response: `{}`,
+			err:      "expected withdrawals missing",
+		},
+		{
+			name:     "WithdrawalInvalid",
+			response: `{"data":[{"index":"1"}]}`,
+			err:      "failed to parse expected withdrawals: validator index missing",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			query := ""
+			server := newTestServerWithHandlers(t, map[string]http.HandlerFunc{
+				"/eth/v1/builder/states/head/expected_withdrawals": func(w http.ResponseWriter, r *http.Request) {
+					query = r.URL.RawQuery
+					respondWith(test.response)(w, r)
+				},
+			})
+			service, err := standardhttp.New(context.Background(),
+				standardhttp.WithTimeout(timeout),
+				standardhttp.WithAddress(server.URL),
+			)
+			require.NoError(t, err)
+
+			withdrawals, err := service.ExpectedWithdrawals(context.Background(), "head", test.proposalSlot)
+			require.Equal(t, test.query, query)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expected, withdrawals)
+		})
+	}
+}
+
+func TestExpectedWithdrawalsNotFound(t *testing.T) {
+	server := newTestServer(t, nil)
+	service, err := standardhttp.New(context.Background(),
+		standardhttp.WithTimeout(timeout),
+		standardhttp.WithAddress(server.URL),
+	)
+	require.NoError(t, err)
+
+	_, err = service.ExpectedWithdrawals(context.Background(), "head", nil)
+	require.EqualError(t, err, "failed to obtain expected withdrawals: not found")
+
+	_, err = service.ExpectedWithdrawals(context.Background(), "", nil)
+	require.EqualError(t, err, "no state ID specified")
+}
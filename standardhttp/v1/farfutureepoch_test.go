@@ -23,6 +23,8 @@ import (
 )
 
 func TestFarFutureEpoch(t *testing.T) {
+	skipWithoutNode(t)
+
 	tests := []struct {
 		name string
 	}{
@@ -15,9 +15,9 @@ package v1
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
+	client "github.com/attestantio/go-eth2-client"
 	api "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/pkg/errors"
 )
@@ -37,11 +37,11 @@ func (s *Service) Finality(ctx context.Context, stateID string) (*api.Finality,
 		return nil, errors.Wrap(err, "failed to request finality checkpoints")
 	}
 	if respBodyReader == nil {
-		return nil, errors.New("failed to obtain finality checkpoints")
+		return nil, errors.Wrap(client.ErrNotFound, "failed to obtain finality checkpoints")
 	}
 
 	var finalityJSON finalityJSON
-	if err := json.NewDecoder(respBodyReader).Decode(&finalityJSON); err != nil {
+	if err := s.decodeJSON(respBodyReader, &finalityJSON); err != nil {
 		return nil, errors.Wrap(err, "failed to parse finality")
 	}
 	if finalityJSON.Data == nil {
@@ -0,0 +1,36 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// stateEndpoint matches endpoints that fetch information from a state.
+var stateEndpoint = regexp.MustCompile(`^/eth/v[0-9]+/(debug/)?beacon/states/`)
+
+// finalizedEndpoint adds the finalized query parameter to state endpoints if only finalized data is requested.
+func (s *Service) finalizedEndpoint(endpoint string) string {
+	if !s.finalizedOnly || !stateEndpoint.MatchString(endpoint) {
+		return endpoint
+	}
+	separator := "?"
+	if strings.Contains(endpoint, "?") {
+		separator = "&"
+	}
+
+	return fmt.Sprintf("%s%sfinalized=true", endpoint, separator)
+}
@@ -0,0 +1,86 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFinalizedOnly(t *testing.T) {
+	var mu sync.Mutex
+	queries := make(map[string]string)
+	record := func(response string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			queries[r.URL.Path] = r.URL.RawQuery
+			mu.Unlock()
+			respondWith(response)(w, r)
+		}
+	}
+	server := newTestServerWithHandlers(t, map[string]http.HandlerFunc{
+		"/eth/v1/beacon/states/head/fork":       record(`{"data":{"previous_version":"0x00000000","current_version":"0x00000000","epoch":"0"}}`),
+		"/eth/v1/beacon/states/head/validators": record(`{"data":[]}`),
+		"/eth/v1/node/syncing":                  record(`{"data":{"head_slot":"1","sync_distance":"0","is_syncing":false}}`),
+	})
+
+	tests := []struct {
+		name          string
+		finalizedOnly bool
+		fork          string
+		validators    string
+	}{
+		{
+			name:       "Default",
+			validators: "id=1",
+		},
+		{
+			name:          "FinalizedOnly",
+			finalizedOnly: true,
+			fork:          "finalized=true",
+			validators:    "id=1&finalized=true",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			service, err := standardhttp.New(context.Background(),
+				standardhttp.WithTimeout(timeout),
+				standardhttp.WithAddress(server.URL),
+				standardhttp.WithFinalizedOnly(test.finalizedOnly),
+			)
+			require.NoError(t, err)
+
+			_, err = service.Fork(context.Background(), "head")
+			require.NoError(t, err)
+			_, err = service.Validators(context.Background(), "head", []spec.ValidatorIndex{1})
+			require.NoError(t, err)
+			_, err = service.NodeSyncing(context.Background())
+			require.NoError(t, err)
+
+			mu.Lock()
+			defer mu.Unlock()
+			require.Equal(t, test.fork, queries["/eth/v1/beacon/states/head/fork"])
+			require.Equal(t, test.validators, queries["/eth/v1/beacon/states/head/validators"])
+			// Other endpoints are unaffected.
+			require.Equal(t, "", queries["/eth/v1/node/syncing"])
+		})
+	}
+}
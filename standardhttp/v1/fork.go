@@ -15,9 +15,9 @@ package v1
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
+	client "github.com/attestantio/go-eth2-client"
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 )
@@ -37,11 +37,11 @@ func (s *Service) Fork(ctx context.Context, stateID string) (*spec.Fork, error)
 		return nil, errors.Wrap(err, "failed to request fork")
 	}
 	if respBodyReader == nil {
-		return nil, errors.New("failed to obtain fork")
+		return nil, errors.Wrap(client.ErrNotFound, "failed to obtain fork")
 	}
 
 	var forkJSON forkJSON
-	if err := json.NewDecoder(respBodyReader).Decode(&forkJSON); err != nil {
+	if err := s.decodeJSON(respBodyReader, &forkJSON); err != nil {
 		return nil, errors.Wrap(err, "failed to parse fork")
 	}
 	if forkJSON.Data == nil {
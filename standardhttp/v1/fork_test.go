@@ -24,6 +24,8 @@ import (
 )
 
 func TestFork(t *testing.T) {
+	skipWithoutNode(t)
+
 	tests := []struct {
 		name              string
 		stateID           string
@@ -0,0 +1,42 @@
+// Copyright © 2020 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+
+	client "github.com/attestantio/go-eth2-client"
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/pkg/errors"
+)
+
+// ForkChoice fetches the node's current fork choice context.
+// N.B if the node does not expose the fork choice debug endpoint this will return an error that wraps client.ErrNotFound.
+func (s *Service) ForkChoice(ctx context.Context) (*api.ForkChoice, error) {
+	respBodyReader, err := s.get(ctx, "/eth/v1/debug/fork_choice")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request fork choice")
+	}
+	if respBodyReader == nil {
+		return nil, errors.Wrap(client.ErrNotFound, "fork choice not available from node")
+	}
+
+	// The fork choice response is not wrapped in a data element.
+	var forkChoice api.ForkChoice
+	if err := s.decodeJSON(respBodyReader, &forkChoice); err != nil {
+		return nil, errors.Wrap(err, "failed to parse fork choice")
+	}
+
+	return &forkChoice, nil
+}
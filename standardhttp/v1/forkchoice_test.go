@@ -0,0 +1,65 @@
+// Copyright © 2020 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForkChoice(t *testing.T) {
+	forkChoice := `{"justified_checkpoint":{"epoch":"2","root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f"},"finalized_checkpoint":{"epoch":"1","root":"0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f"},"fork_choice_nodes":[{"slot":"1","block_root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","parent_root":"0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f","justified_epoch":"2","finalized_epoch":"1","weight":"32000000000","validity":"valid"}]}`
+
+	tests := []struct {
+		name      string
+		responses map[string]string
+		notFound  bool
+	}{
+		{
+			name: "Good",
+			responses: map[string]string{
+				"/eth/v1/debug/fork_choice": forkChoice,
+			},
+		},
+		{
+			name:      "NotExposed",
+			responses: map[string]string{},
+			notFound:  true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := newTestServer(t, test.responses)
+			service, err := standardhttp.New(context.Background(),
+				standardhttp.WithTimeout(timeout),
+				standardhttp.WithAddress(server.URL),
+			)
+			require.NoError(t, err)
+
+			res, err := service.ForkChoice(context.Background())
+			if test.notFound {
+				require.True(t, errors.Is(err, client.ErrNotFound))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, forkChoice, res.String())
+		})
+	}
+}
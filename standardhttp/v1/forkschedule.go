@@ -15,7 +15,7 @@ package v1
 
 import (
 	"context"
-	"encoding/json"
+	"sort"
 
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
@@ -37,7 +37,7 @@ func (s *Service) ForkSchedule(ctx context.Context) ([]*spec.Fork, error) {
 		}
 
 		var forkScheduleJSON forkScheduleJSON
-		if err := json.NewDecoder(respBodyReader).Decode(&forkScheduleJSON); err != nil {
+		if err := s.decodeJSON(respBodyReader, &forkScheduleJSON); err != nil {
 			return nil, errors.Wrap(err, "failed to parse fork schedule")
 		}
 
@@ -55,3 +55,38 @@ func (s *Service) ForkSchedule(ctx context.Context) ([]*spec.Fork, error) {
 
 	return forkSchedule, nil
 }
+
+// Forks provides the complete history of forks known to the node, sorted by epoch.
+func (s *Service) Forks(ctx context.Context) ([]*spec.Fork, error) {
+	forks, err := s.ForkSchedule(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(forks, func(i, j int) bool {
+		return forks[i].Epoch < forks[j].Epoch
+	})
+
+	return forks, nil
+}
+
+// ForkAtEpoch provides the fork active at the given epoch.
+// If the epoch is prior to all known forks the earliest fork is returned.
+func (s *Service) ForkAtEpoch(ctx context.Context, epoch spec.Epoch) (*spec.Fork, error) {
+	forks, err := s.Forks(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain forks")
+	}
+	if len(forks) == 0 {
+		return nil, errors.New("no forks known")
+	}
+
+	currentFork := forks[0]
+	for i := range forks {
+		if forks[i].Epoch > epoch {
+			break
+		}
+		currentFork = forks[i]
+	}
+
+	return currentFork, nil
+}
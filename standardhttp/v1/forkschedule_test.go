@@ -18,11 +18,14 @@ import (
 	"os"
 	"testing"
 
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
 	"github.com/stretchr/testify/require"
 )
 
 func TestForkSchedule(t *testing.T) {
+	skipWithoutNode(t)
+
 	tests := []struct {
 		name string
 	}{
@@ -48,3 +51,56 @@ func TestForkSchedule(t *testing.T) {
 		})
 	}
 }
+
+func TestForks(t *testing.T) {
+	server := newTestServer(t, map[string]string{
+		"/eth/v1/config/fork_schedule": `{"data":[{"previous_version":"0x01000000","current_version":"0x02000000","epoch":"144896"},{"previous_version":"0x00000000","current_version":"0x00000000","epoch":"0"},{"previous_version":"0x00000000","current_version":"0x01000000","epoch":"74240"}]}`,
+	})
+	service, err := standardhttp.New(context.Background(),
+		standardhttp.WithTimeout(timeout),
+		standardhttp.WithAddress(server.URL),
+	)
+	require.NoError(t, err)
+
+	forks, err := service.Forks(context.Background())
+	require.NoError(t, err)
+	require.Len(t, forks, 3)
+	require.Equal(t, spec.Epoch(0), forks[0].Epoch)
+	require.Equal(t, spec.Epoch(74240), forks[1].Epoch)
+	require.Equal(t, spec.Epoch(144896), forks[2].Epoch)
+
+	tests := []struct {
+		name    string
+		epoch   spec.Epoch
+		version spec.Version
+	}{
+		{
+			name:    "Genesis",
+			epoch:   0,
+			version: spec.Version{0x00, 0x00, 0x00, 0x00},
+		},
+		{
+			name:    "BeforeFork",
+			epoch:   74239,
+			version: spec.Version{0x00, 0x00, 0x00, 0x00},
+		},
+		{
+			name:    "AtFork",
+			epoch:   74240,
+			version: spec.Version{0x01, 0x00, 0x00, 0x00},
+		},
+		{
+			name:    "Latest",
+			epoch:   200000,
+			version: spec.Version{0x02, 0x00, 0x00, 0x00},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fork, err := service.ForkAtEpoch(context.Background(), test.epoch)
+			require.NoError(t, err)
+			require.Equal(t, test.version, fork.CurrentVersion)
+		})
+	}
+}
@@ -15,7 +15,6 @@ package v1
 
 import (
 	"context"
-	"encoding/json"
 
 	api "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/pkg/errors"
@@ -26,7 +25,20 @@ type genesisJSON struct {
 }
 
 // Genesis provides the genesis information of the chain.
+// If SSZ is preferred it is requested as SSZ, with a single retry as JSON if the SSZ cannot be obtained or decoded.
+// Unlike other SSZ requests this retry happens regardless of the fallback setting, as genesis is required to start the client.
 func (s *Service) Genesis(ctx context.Context) (*api.Genesis, error) {
+	if s.genesis == nil && s.preferSSZ {
+		var genesis api.Genesis
+		decoded, err := s.getUnversionedSSZObject(ctx, "/eth/v1/beacon/genesis", "genesis", &genesis)
+		if err != nil {
+			// Genesis is required to start the client and many nodes do not serve it as SSZ, so always retry with JSON.
+			s.log.Debug().Err(err).Msg("Failed to obtain SSZ genesis; retrying with JSON")
+		}
+		if decoded {
+			s.genesis = &genesis
+		}
+	}
 	if s.genesis == nil {
 		respBodyReader, err := s.get(ctx, "/eth/v1/beacon/genesis")
 		if err != nil {
@@ -37,7 +49,7 @@ func (s *Service) Genesis(ctx context.Context) (*api.Genesis, error) {
 		}
 
 		var resp genesisJSON
-		if err := json.NewDecoder(respBodyReader).Decode(&resp); err != nil {
+		if err := s.decodeJSON(respBodyReader, &resp); err != nil {
 			return nil, errors.Wrap(err, "failed to parse genesis")
 		}
 		s.genesis = resp.Data
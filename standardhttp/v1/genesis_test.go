@@ -15,14 +15,20 @@ package v1_test
 
 import (
 	"context"
+	"net/http"
 	"os"
 	"testing"
+	"time"
 
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesis(t *testing.T) {
+	skipWithoutNode(t)
+
 	tests := []struct {
 		name string
 	}{
@@ -48,3 +54,77 @@ func TestGenesis(t *testing.T) {
 		})
 	}
 }
+
+func TestGenesisSSZ(t *testing.T) {
+	genesis := &api.Genesis{
+		GenesisTime:           time.Unix(1606824023, 0),
+		GenesisValidatorsRoot: spec.Root{0x4b, 0x36, 0x3d, 0xb9},
+		GenesisForkVersion:    spec.Version{0x00, 0x00, 0x00, 0x01},
+	}
+	genesisSSZ, err := genesis.MarshalSSZ()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		sszStatus    int
+		noFallback   bool
+		jsonRequests int
+		version      spec.Version
+	}{
+		{
+			name:    "SSZ",
+			version: spec.Version{0x00, 0x00, 0x00, 0x01},
+		},
+		{
+			name:         "SSZNotAcceptable",
+			sszStatus:    http.StatusNotAcceptable,
+			jsonRequests: 1,
+			version:      spec.Version{0x00, 0x00, 0x00, 0x00},
+		},
+		{
+			name:         "JSONOnlyNoFallback",
+			sszStatus:    http.StatusOK,
+			noFallback:   true,
+			jsonRequests: 1,
+			version:      spec.Version{0x00, 0x00, 0x00, 0x00},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			jsonRequests := 0
+			server := newTestServerWithHandlers(t, map[string]http.HandlerFunc{
+				"/eth/v1/beacon/genesis": func(w http.ResponseWriter, r *http.Request) {
+					if r.Header.Get("Accept") == "application/octet-stream" {
+						switch test.sszStatus {
+						case 0:
+							w.Header().Set("Content-Type", "application/octet-stream")
+							_, _ = w.Write(genesisSSZ)
+							return
+						case http.StatusOK:
+							// Node ignores the accept header and returns JSON.
+						default:
+							w.WriteHeader(test.sszStatus)
+							return
+						}
+					} else {
+						jsonRequests++
+					}
+					respondWith(staticResponses["/eth/v1/beacon/genesis"])(w, r)
+				},
+			})
+			service, err := standardhttp.New(context.Background(),
+				standardhttp.WithTimeout(timeout),
+				standardhttp.WithAddress(server.URL),
+				standardhttp.WithPreferSSZ(true),
+				standardhttp.WithSSZFallback(!test.noFallback),
+			)
+			require.NoError(t, err)
+
+			res, err := service.Genesis(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, test.version, res.GenesisForkVersion)
+			require.Equal(t, test.jsonRequests, jsonRequests)
+		})
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright © 2020 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"crypto/tls"
+	"net"
+	"net/http"
+	"sync"
+
+	"github.com/rs/zerolog"
+	"golang.org/x/net/http2"
+)
+
+// h2cTransport is a round tripper that talks HTTP/2 with prior knowledge over unencrypted connections.
+// If the first request fails it is retried over HTTP/1.1, and all subsequent requests use HTTP/1.1.
+type h2cTransport struct {
+	h2  *http2.Transport
+	h1  http.RoundTripper
+	log zerolog.Logger
+
+	mutex     sync.Mutex
+	confirmed bool
+	fallback  bool
+}
+
+// newH2CTransport creates a new h2c transport, using the supplied transport for fallback.
+func newH2CTransport(dialer *net.Dialer, h1 http.RoundTripper, log zerolog.Logger) *h2cTransport {
+	return &h2cTransport{
+		log: log,
+		h2: &http2.Transport{
+			AllowHTTP: true,
+			// Connections are not encrypted, so dial directly.
+			DialTLS: func(network string, addr string, _ *tls.Config) (net.Conn, error) {
+				return dialer.Dial(network, addr)
+			},
+		},
+		h1: h1,
+	}
+}
+
+// RoundTrip executes a single HTTP transaction.
+func (t *h2cTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.mutex.Lock()
+	confirmed, fallback := t.confirmed, t.fallback
+	t.mutex.Unlock()
+	if fallback {
+		return t.h1.RoundTrip(req)
+	}
+
+	resp, err := t.h2.RoundTrip(req)
+	if err == nil {
+		if !confirmed {
+			t.mutex.Lock()
+			t.confirmed = true
+			t.mutex.Unlock()
+		}
+		return resp, nil
+	}
+	if confirmed || req.Context().Err() != nil {
+		return nil, err
+	}
+
+	// The node does not appear to support h2c; retry with HTTP/1.1.
+	t.log.Debug().Err(err).Msg("HTTP/2 request failed; falling back to HTTP/1.1")
+	if req.Body != nil && req.Body != http.NoBody {
+		if req.GetBody == nil {
+			return nil, err
+		}
+		body, bodyErr := req.GetBody()
+		if bodyErr != nil {
+			return nil, err
+		}
+		req = req.Clone(req.Context())
+		req.Body = body
+	}
+	resp, h1Err := t.h1.RoundTrip(req)
+	if h1Err != nil {
+		return nil, h1Err
+	}
+	t.mutex.Lock()
+	t.fallback = true
+	t.mutex.Unlock()
+
+	return resp, nil
+}
@@ -0,0 +1,118 @@
+// Copyright © 2020 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+)
+
+// protocolRecorder records the HTTP major versions of requests received.
+type protocolRecorder struct {
+	mutex  sync.Mutex
+	protos []int
+}
+
+func (p *protocolRecorder) handler(w http.ResponseWriter, r *http.Request) {
+	if r.Method == "PRI" {
+		// HTTP/1.1 servers see the HTTP/2 connection preface as a request; reject it.
+		http.Error(w, "unsupported", http.StatusBadRequest)
+		return
+	}
+	p.mutex.Lock()
+	p.protos = append(p.protos, r.ProtoMajor)
+	p.mutex.Unlock()
+	if response, exists := staticResponses[r.URL.Path]; exists {
+		respondWith(response)(w, r)
+		return
+	}
+	http.NotFound(w, r)
+}
+
+func (p *protocolRecorder) versions() []int {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	return append([]int{}, p.protos...)
+}
+
+func TestHTTP2(t *testing.T) {
+	recorder := &protocolRecorder{}
+	server := httptest.NewServer(h2c.NewHandler(http.HandlerFunc(recorder.handler), &http2.Server{}))
+	defer server.Close()
+
+	service, err := standardhttp.New(context.Background(),
+		standardhttp.WithTimeout(timeout),
+		standardhttp.WithAddress(server.URL),
+		standardhttp.WithHTTP2(true),
+	)
+	require.NoError(t, err)
+	_, err = service.Genesis(context.Background())
+	require.NoError(t, err)
+
+	versions := recorder.versions()
+	require.NotEmpty(t, versions)
+	for _, version := range versions {
+		require.Equal(t, 2, version)
+	}
+}
+
+func TestHTTP2Fallback(t *testing.T) {
+	recorder := &protocolRecorder{}
+	// Server only understands HTTP/1.1.
+	server := httptest.NewServer(http.HandlerFunc(recorder.handler))
+	defer server.Close()
+
+	service, err := standardhttp.New(context.Background(),
+		standardhttp.WithTimeout(timeout),
+		standardhttp.WithAddress(server.URL),
+		standardhttp.WithHTTP2(true),
+	)
+	require.NoError(t, err)
+	_, err = service.Genesis(context.Background())
+	require.NoError(t, err)
+
+	versions := recorder.versions()
+	require.NotEmpty(t, versions)
+	for _, version := range versions {
+		require.Equal(t, 1, version)
+	}
+}
+
+func TestHTTP1(t *testing.T) {
+	recorder := &protocolRecorder{}
+	server := httptest.NewServer(h2c.NewHandler(http.HandlerFunc(recorder.handler), &http2.Server{}))
+	defer server.Close()
+
+	_, err := standardhttp.New(context.Background(),
+		standardhttp.WithTimeout(timeout),
+		standardhttp.WithAddress(server.URL),
+	)
+	require.NoError(t, err)
+
+	// HTTP/2 is not used unless requested.
+	versions := recorder.versions()
+	require.NotEmpty(t, versions)
+	for _, version := range versions {
+		require.Equal(t, 1, version)
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright © 2020 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/pkg/errors"
+)
+
+// Head provides a summary of the current head of the chain.
+func (s *Service) Head(ctx context.Context) (*api.HeadSummary, error) {
+	header, err := s.BeaconBlockHeader(ctx, "head")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain head header")
+	}
+	if header == nil || header.Header == nil || header.Header.Message == nil {
+		return nil, errors.New("head header missing")
+	}
+
+	return &api.HeadSummary{
+		Slot:                header.Header.Message.Slot,
+		BlockRoot:           header.Root,
+		StateRoot:           header.Header.Message.StateRoot,
+		ExecutionOptimistic: header.ExecutionOptimistic,
+	}, nil
+}
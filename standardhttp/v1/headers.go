@@ -0,0 +1,119 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+// sensitiveHeaderFragments are parts of header names whose values are redacted in logs.
+var sensitiveHeaderFragments = []string{
+	"authorization",
+	"cookie",
+	"key",
+	"secret",
+	"token",
+	"password",
+}
+
+// requestHeadersKey is the context key for per-request headers.
+type requestHeadersKey struct{}
+
+// WithRequestHeaders returns a context that adds the supplied headers to requests to the node made
+// with it, for example to supply a short-lived authorization token.
+// Headers in the context take precedence over headers of the same name supplied with WithHeader or WithExtraHeaders.
+// Headers required by the API, such as Accept, are always set by the client.
+// If request coalescing is enabled, only concurrent requests with the same headers are shared.
+func WithRequestHeaders(ctx context.Context, headers map[string]string) context.Context {
+	return context.WithValue(ctx, requestHeadersKey{}, headers)
+}
+
+// WithRequestHeadersFunc returns a context that adds the headers provided by the supplied function to requests to
+// the node made with it.  The function is called for each request, including each reconnection of an event stream,
+// so can supply authorization tokens that rotate during long-running subscriptions.
+// The function must be safe to call concurrently.
+func WithRequestHeadersFunc(ctx context.Context, headersFunc func() map[string]string) context.Context {
+	return context.WithValue(ctx, requestHeadersKey{}, headersFunc)
+}
+
+// requestHeaders provides the headers to add to a request made with the given context.
+func (s *Service) requestHeaders(ctx context.Context) map[string]string {
+	var contextHeaders map[string]string
+	switch headers := ctx.Value(requestHeadersKey{}).(type) {
+	case map[string]string:
+		contextHeaders = headers
+	case func() map[string]string:
+		contextHeaders = headers()
+	}
+	if len(contextHeaders) == 0 {
+		return s.extraHeaders
+	}
+	if len(s.extraHeaders) == 0 {
+		return contextHeaders
+	}
+
+	headers := make(map[string]string, len(s.extraHeaders)+len(contextHeaders))
+	for k, v := range s.extraHeaders {
+		headers[http.CanonicalHeaderKey(k)] = v
+	}
+	for k, v := range contextHeaders {
+		headers[http.CanonicalHeaderKey(k)] = v
+	}
+	return headers
+}
+
+// addHeaders adds the static and per-request headers to a request.
+func (s *Service) addHeaders(req *http.Request) {
+	headers := s.requestHeaders(req.Context())
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	if e := s.log.Trace(); e.Enabled() && len(headers) > 0 {
+		e.Interface("headers", redactHeaders(headers)).Msg("Additional request headers")
+	}
+}
+
+// headerTransport adds the static and per-request headers to each request it sends.  It is used for event streams,
+// whose requests are made by the stream client, so that headers are obtained afresh each time the stream reconnects.
+type headerTransport struct {
+	service *Service
+	next    http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// Round trippers must not modify the supplied request.
+	req = req.Clone(req.Context())
+	t.service.addHeaders(req)
+
+	return t.next.RoundTrip(req)
+}
+
+// redactHeaders provides a copy of the headers suitable for logging, with the values of sensitive headers removed.
+func redactHeaders(headers map[string]string) map[string]string {
+	res := make(map[string]string, len(headers))
+	for k, v := range headers {
+		res[k] = v
+		lowerKey := strings.ToLower(k)
+		for _, fragment := range sensitiveHeaderFragments {
+			if strings.Contains(lowerKey, fragment) {
+				res[k] = "<redacted>"
+				break
+			}
+		}
+	}
+	return res
+}
@@ -0,0 +1,42 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedactHeaders(t *testing.T) {
+	headers := map[string]string{
+		"Authorization":       "Bearer secret",
+		"Proxy-Authorization": "Basic secret",
+		"X-Api-Key":           "secret",
+		"X-Auth-Token":        "secret",
+		"X-Tenant-Id":         "tenant",
+		"Baggage":             "trace=1",
+	}
+	redacted := redactHeaders(headers)
+	require.Equal(t, map[string]string{
+		"Authorization":       "<redacted>",
+		"Proxy-Authorization": "<redacted>",
+		"X-Api-Key":           "<redacted>",
+		"X-Auth-Token":        "<redacted>",
+		"X-Tenant-Id":         "tenant",
+		"Baggage":             "trace=1",
+	}, redacted)
+	// Original headers are untouched.
+	require.Equal(t, "Bearer secret", headers["Authorization"])
+}
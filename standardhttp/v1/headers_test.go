@@ -0,0 +1,241 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestHeaders(t *testing.T) {
+	headers := make(chan http.Header, 1)
+	server := newTestServerWithHandlers(t, map[string]http.HandlerFunc{
+		"/eth/v1/node/syncing": func(w http.ResponseWriter, r *http.Request) {
+			headers <- r.Header.Clone()
+			respondWith(`{"data":{"head_slot":"1","sync_distance":"0","is_syncing":false}}`)(w, r)
+		},
+		"/eth/v1/beacon/pool/voluntary_exits": func(w http.ResponseWriter, r *http.Request) {
+			headers <- r.Header.Clone()
+		},
+	})
+
+	service, err := standardhttp.New(context.Background(),
+		standardhttp.WithTimeout(timeout),
+		standardhttp.WithAddress(server.URL),
+		standardhttp.WithExtraHeaders(map[string]string{
+			"authorization": "Bearer static",
+			"X-Static":      "static",
+		}),
+	)
+	require.NoError(t, err)
+	receive := func() http.Header {
+		select {
+		case received := <-headers:
+			return received
+		case <-time.After(5 * time.Second):
+			require.Fail(t, "request not received")
+			return nil
+		}
+	}
+
+	// Static headers only.
+	_, err = service.NodeSyncing(context.Background())
+	require.NoError(t, err)
+	received := receive()
+	require.Equal(t, "Bearer static", received.Get("Authorization"))
+	require.Equal(t, "static", received.Get("X-Static"))
+
+	// Context headers take precedence over static headers.
+	ctx := standardhttp.WithRequestHeaders(context.Background(), map[string]string{
+		"Authorization": "Bearer dynamic",
+		"X-Dynamic":     "dynamic",
+	})
+	_, err = service.NodeSyncing(ctx)
+	require.NoError(t, err)
+	received = receive()
+	require.Equal(t, "Bearer dynamic", received.Get("Authorization"))
+	require.Equal(t, "static", received.Get("X-Static"))
+	require.Equal(t, "dynamic", received.Get("X-Dynamic"))
+
+	// Context headers are also applied to submissions.
+	ctx = standardhttp.WithRequestHeaders(context.Background(), map[string]string{
+		"Authorization": "Bearer rotated",
+	})
+	require.NoError(t, service.SubmitVoluntaryExit(ctx, &spec.SignedVoluntaryExit{
+		Message: &spec.VoluntaryExit{},
+	}))
+	received = receive()
+	require.Equal(t, "Bearer rotated", received.Get("Authorization"))
+}
+
+func TestRequestHeadersEvents(t *testing.T) {
+	headers := make(chan http.Header, 2)
+	server := newTestServerWithHandlers(t, map[string]http.HandlerFunc{
+		"/eth/v1/events": func(w http.ResponseWriter, r *http.Request) {
+			headers <- r.Header.Clone()
+			w.Header().Set("Content-Type", "text/event-stream")
+			w.(http.Flusher).Flush()
+			<-r.Context().Done()
+		},
+	})
+
+	service, err := standardhttp.New(context.Background(),
+		standardhttp.WithTimeout(timeout),
+		standardhttp.WithAddress(server.URL),
+		standardhttp.WithExtraHeaders(map[string]string{"Authorization": "Bearer static"}),
+	)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(standardhttp.WithRequestHeaders(context.Background(), map[string]string{
+		"Authorization": "Bearer dynamic",
+	}))
+	defer cancel()
+	require.NoError(t, service.Events(ctx, []string{"head"}, func(*api.Event) {}))
+
+	for {
+		select {
+		case received := <-headers:
+			require.Equal(t, "Bearer dynamic", received.Get("Authorization"))
+			if received.Get("Accept") == "text/event-stream" && received.Get("Cache-Control") != "" {
+				// This is the stream itself, rather than the topic probe.
+				return
+			}
+		case <-time.After(5 * time.Second):
+			require.Fail(t, "event stream not opened")
+			return
+		}
+	}
+}
+
+func TestRequestHeadersEventsReconnect(t *testing.T) {
+	headers := make(chan http.Header, 4)
+	server := newTestServerWithHandlers(t, map[string]http.HandlerFunc{
+		"/eth/v1/events": func(w http.ResponseWriter, r *http.Request) {
+			headers <- r.Header.Clone()
+			// Close the stream immediately, forcing a reconnect.
+			w.Header().Set("Content-Type", "text/event-stream")
+			w.(http.Flusher).Flush()
+		},
+	})
+
+	service, err := standardhttp.New(context.Background(),
+		standardhttp.WithTimeout(timeout),
+		standardhttp.WithAddress(server.URL),
+	)
+	require.NoError(t, err)
+
+	// Headers are obtained afresh for each connection, so rotated tokens are used on reconnect.
+	tokens := int32(0)
+	ctx, cancel := context.WithCancel(standardhttp.WithRequestHeadersFunc(context.Background(), func() map[string]string {
+		return map[string]string{
+			"Authorization": fmt.Sprintf("Bearer %d", atomic.AddInt32(&tokens, 1)),
+		}
+	}))
+	defer cancel()
+	require.NoError(t, service.Events(ctx, []string{"head"}, func(*api.Event) {}))
+
+	for _, expected := range []string{"Bearer 1", "Bearer 2"} {
+		select {
+		case received := <-headers:
+			require.Equal(t, expected, received.Get("Authorization"))
+		case <-time.After(10 * time.Second):
+			require.Fail(t, "event stream not opened")
+			return
+		}
+	}
+}
+
+func TestRequestHeadersEventTopicProbe(t *testing.T) {
+	streamTopics := make(chan []string, 1)
+	server := newTestServerWithHandlers(t, map[string]http.HandlerFunc{
+		"/eth/v1/events": func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") != "Bearer secret" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			if r.Header.Get("Cache-Control") != "" {
+				// This is the stream itself, rather than the topic probe.
+				streamTopics <- r.URL.Query()["topics"]
+			}
+			w.Header().Set("Content-Type", "text/event-stream")
+			w.(http.Flusher).Flush()
+			<-r.Context().Done()
+		},
+	})
+
+	service, err := standardhttp.New(context.Background(),
+		standardhttp.WithTimeout(timeout),
+		standardhttp.WithAddress(server.URL),
+		standardhttp.WithExtraHeaders(map[string]string{"Authorization": "Bearer secret"}),
+	)
+	require.NoError(t, err)
+
+	// The optional topic is only retained if the probe is authorized.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, service.Events(ctx, []string{"head", "attester_slashing"}, func(*api.Event) {}))
+	select {
+	case topics := <-streamTopics:
+		require.Equal(t, []string{"head", "attester_slashing"}, topics)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "event stream not opened")
+	}
+}
+
+func TestWithHeader(t *testing.T) {
+	headers := make(chan http.Header, 1)
+	server := newTestServerWithHandlers(t, map[string]http.HandlerFunc{
+		"/eth/v1/node/syncing": func(w http.ResponseWriter, r *http.Request) {
+			headers <- r.Header.Clone()
+			respondWith(`{"data":{"head_slot":"1","sync_distance":"0","is_syncing":false}}`)(w, r)
+		},
+	})
+
+	service, err := standardhttp.New(context.Background(),
+		standardhttp.WithTimeout(timeout),
+		standardhttp.WithAddress(server.URL),
+		standardhttp.WithHeader("X-Api-Key", "first"),
+		standardhttp.WithHeader("X-Tenant-ID", "tenant"),
+		standardhttp.WithExtraHeaders(map[string]string{"Baggage": "trace=1"}),
+		// Later values override earlier ones, regardless of case.
+		standardhttp.WithHeader("x-api-key", "second"),
+	)
+	require.NoError(t, err)
+
+	_, err = service.NodeSyncing(context.Background())
+	require.NoError(t, err)
+	received := <-headers
+	require.Equal(t, []string{"second"}, received.Values("X-Api-Key"))
+	require.Equal(t, "tenant", received.Get("X-Tenant-Id"))
+	require.Equal(t, "trace=1", received.Get("Baggage"))
+}
+
+func TestWithHeaderEmpty(t *testing.T) {
+	server := newTestServer(t, map[string]string{})
+	_, err := standardhttp.New(context.Background(),
+		standardhttp.WithTimeout(timeout),
+		standardhttp.WithAddress(server.URL),
+		standardhttp.WithHeader("", "value"),
+	)
+	require.EqualError(t, err, "problem with parameters: empty header name specified")
+}
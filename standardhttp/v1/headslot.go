@@ -0,0 +1,79 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// headSlotJSON contains only the parts of the head header required to obtain its slot.
+type headSlotJSON struct {
+	Data *struct {
+		Header *struct {
+			Message *struct {
+				Slot string `json:"slot"`
+			} `json:"message"`
+		} `json:"header"`
+	} `json:"data"`
+}
+
+// HeadSlot provides the slot of the head of the chain.
+// This decodes only the slot of the head header, falling back to the head slot from the node's
+// syncing information if the header is not available.
+func (s *Service) HeadSlot(ctx context.Context) (spec.Slot, error) {
+	slot, err := s.headerHeadSlot(ctx)
+	if err == nil {
+		return slot, nil
+	}
+	s.log.Debug().Err(err).Msg("Failed to obtain head slot from header; using syncing information")
+
+	syncState, err := s.NodeSyncing(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to obtain head slot")
+	}
+	if syncState == nil {
+		return 0, errors.New("syncing information missing")
+	}
+	return syncState.HeadSlot, nil
+}
+
+// headerHeadSlot obtains the slot of the head header.
+func (s *Service) headerHeadSlot(ctx context.Context) (spec.Slot, error) {
+	respBodyReader, err := s.get(ctx, "/eth/v1/beacon/headers/head")
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to request head header")
+	}
+	if respBodyReader == nil {
+		return 0, errors.New("head header not available")
+	}
+
+	// Decoding is always lenient, as only the slot is of interest.
+	var resp headSlotJSON
+	if err := json.NewDecoder(respBodyReader).Decode(&resp); err != nil {
+		return 0, errors.Wrap(err, "failed to parse head header")
+	}
+	if resp.Data == nil || resp.Data.Header == nil || resp.Data.Header.Message == nil {
+		return 0, errors.New("head header missing")
+	}
+	slot, err := strconv.ParseUint(resp.Data.Header.Message.Slot, 10, 64)
+	if err != nil {
+		return 0, errors.Wrap(err, "invalid value for slot")
+	}
+	return spec.Slot(slot), nil
+}
@@ -0,0 +1,39 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// hexParam encodes bytes as a lowercase, 0x-prefixed hex string suitable for use in a request.
+// Unlike fmt's %#x this always includes the prefix, even for empty input.
+func hexParam(input []byte) string {
+	return "0x" + hex.EncodeToString(input)
+}
+
+// parseHex decodes a hex string, with or without a 0x prefix, that is expected to be of the given length in bytes.
+func parseHex(input string, expectedLen int) ([]byte, error) {
+	res, err := hex.DecodeString(strings.TrimPrefix(input, "0x"))
+	if err != nil {
+		return nil, err
+	}
+	if len(res) != expectedLen {
+		return nil, fmt.Errorf("incorrect length %d", len(res))
+	}
+
+	return res, nil
+}
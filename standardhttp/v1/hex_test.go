@@ -0,0 +1,137 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"testing"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHexParam(t *testing.T) {
+	root := spec.Root{0xAB, 0xCD, 0xEF}
+	pubKey := spec.BLSPubKey{0xA0}
+	pubKey[47] = 0xFF
+
+	tests := []struct {
+		name     string
+		input    []byte
+		expected string
+	}{
+		{
+			name:     "Nil",
+			expected: "0x",
+		},
+		{
+			name:     "Empty",
+			input:    []byte{},
+			expected: "0x",
+		},
+		{
+			name:     "Root",
+			input:    root[:],
+			expected: "0xabcdef0000000000000000000000000000000000000000000000000000000000",
+		},
+		{
+			name:     "PubKey",
+			input:    pubKey[:],
+			expected: "0xa000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000ff",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, hexParam(test.input))
+		})
+	}
+}
+
+func TestParseHex(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		expectedLen int
+		expected    []byte
+		err         string
+	}{
+		{
+			name:        "Prefixed",
+			input:       "0x0102",
+			expectedLen: 2,
+			expected:    []byte{0x01, 0x02},
+		},
+		{
+			name:        "Unprefixed",
+			input:       "0102",
+			expectedLen: 2,
+			expected:    []byte{0x01, 0x02},
+		},
+		{
+			name:        "Uppercase",
+			input:       "0xABCD",
+			expectedLen: 2,
+			expected:    []byte{0xab, 0xcd},
+		},
+		{
+			name:        "Empty",
+			input:       "0x",
+			expectedLen: 0,
+			expected:    []byte{},
+		},
+		{
+			name:        "Short",
+			input:       "0x01",
+			expectedLen: 2,
+			err:         "incorrect length 1",
+		},
+		{
+			name:        "Long",
+			input:       "0x010203",
+			expectedLen: 2,
+			err:         "incorrect length 3",
+		},
+		{
+			name:        "Invalid",
+			input:       "0xinvalid",
+			expectedLen: 2,
+			err:         "encoding/hex: invalid byte: U+0069 'i'",
+		},
+		{
+			name:        "OddLength",
+			input:       "0x012",
+			expectedLen: 2,
+			err:         "encoding/hex: odd length hex string",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := parseHex(test.input, test.expectedLen)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expected, res)
+		})
+	}
+}
+
+func TestHexParamRoundTrip(t *testing.T) {
+	root := spec.Root{0x01, 0x02, 0xFE}
+	res, err := parseHex(hexParam(root[:]), spec.RootLength)
+	require.NoError(t, err)
+	require.Equal(t, root[:], res)
+}
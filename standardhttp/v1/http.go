@@ -16,19 +16,90 @@ package v1
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"math"
 	"net/http"
 	"net/url"
 
+	client "github.com/attestantio/go-eth2-client"
 	"github.com/pkg/errors"
 )
 
+// sszContentType is the content type of SSZ-encoded requests and responses.
+const sszContentType = "application/octet-stream"
+
 // get sends an HTTP get request and returns the body.
 // If the response from the server is a 404 this will return nil for both the reader and the error.
 func (s *Service) get(ctx context.Context, endpoint string) (io.Reader, error) {
-	log.Trace().Str("endpoint", endpoint).Msg("GET request")
+	return s.getWithAccept(ctx, endpoint, "")
+}
+
+// getWithAccept sends an HTTP get request with an optional accept header and returns the body.
+// If lenient integer parsing is enabled, unquoted integers in JSON responses are quoted.
+// If the response from the server is a 404 this will return nil for both the reader and the error.
+func (s *Service) getWithAccept(ctx context.Context, endpoint string, accept string) (io.Reader, error) {
+	respBodyReader, err := s.getRawWithAccept(ctx, endpoint, accept)
+	if err != nil || respBodyReader == nil {
+		return nil, err
+	}
+
+	return s.lenientResponse(respBodyReader, accept)
+}
+
+// getRawWithAccept sends an HTTP get request with an optional accept header and returns the body as sent by the node.
+// If only finalized data is requested, state endpoints are requested with the finalized query parameter.
+// If request coalescing is enabled, concurrent identical requests with identical headers share a single call to the
+// node.
+// If the response cache is enabled, responses for blocks and states requested by root are served from the cache.
+func (s *Service) getRawWithAccept(ctx context.Context, endpoint string, accept string) (io.Reader, error) {
+	if err := s.checkNetworkUnchanged(); err != nil {
+		return nil, err
+	}
+	endpoint = s.finalizedEndpoint(endpoint)
+
+	cacheKey := ""
+	if s.responseCache != nil && cacheableEndpoint.MatchString(endpoint) {
+		cacheKey = fmt.Sprintf("%s %s", accept, endpoint)
+		if data := s.responseCache.get(cacheKey); data != nil {
+			s.log.Trace().Str("endpoint", endpoint).Msg("GET response from cache")
+			return bytes.NewReader(data), nil
+		}
+	}
+
+	var data []byte
+	var err error
+	if s.requestCoalescing {
+		// Requests are only shared between callers sending the same headers, and the shared call is made
+		// independently of any one caller's context, bounded by the service's timeout in doGet.
+		headers := s.requestHeaders(ctx)
+		fetchCtx := context.WithValue(s.runCtx, requestHeadersKey{}, headers)
+		data, err = s.coalescedRequests.do(ctx, coalescingKey(accept, endpoint, headers), func() ([]byte, error) {
+			return s.doGet(fetchCtx, endpoint, accept)
+		})
+	} else {
+		data, err = s.doGet(ctx, endpoint, accept)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, nil
+	}
+	if cacheKey != "" {
+		s.responseCache.set(cacheKey, data)
+	}
+
+	return bytes.NewReader(data), nil
+}
+
+// doGet sends an HTTP get request with an optional accept header and returns the body.
+// If the response from the server is a 404 this will return nil for both the data and the error, unless the
+// response reports that a requested state has been pruned in which case this will return a client.StatePrunedError.
+func (s *Service) doGet(ctx context.Context, endpoint string, accept string) ([]byte, error) {
+	s.log.Trace().Str("endpoint", endpoint).Str("accept", accept).Msg("GET request")
 
 	reference, err := url.Parse(endpoint)
 	if err != nil {
@@ -41,19 +112,24 @@ func (s *Service) get(ctx context.Context, endpoint string) (io.Reader, error) {
 		cancel()
 		return nil, errors.Wrap(err, "failed to create GET request")
 	}
+	s.addHeaders(req)
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
 	resp, err := s.client.Do(req)
 	if err != nil {
 		cancel()
 		return nil, errors.Wrap(err, "failed to call GET endpoint")
 	}
+	defer resp.Body.Close()
 
-	if resp.StatusCode == 404 {
+	if resp.StatusCode == 404 && !stateEndpoint.MatchString(endpoint) {
 		// Nothing found.  This is not an error, so we return nil on both counts.
 		cancel()
 		return nil, nil
 	}
 
-	data, err := ioutil.ReadAll(resp.Body)
+	data, err := readBody(resp.Body, s.maxResponseBytes)
 	if err != nil {
 		cancel()
 		return nil, errors.Wrap(err, "failed to read GET response")
@@ -62,31 +138,90 @@ func (s *Service) get(ctx context.Context, endpoint string) (io.Reader, error) {
 	statusFamily := resp.StatusCode / 100
 	if statusFamily != 2 {
 		cancel()
-		return nil, fmt.Errorf("GET failed with status %d: %s", resp.StatusCode, string(data))
+		// Nodes report pruned states in a number of ways, so check for them regardless of status code.
+		if err := prunedStateError(endpoint, data); err != nil {
+			return nil, err
+		}
+		if resp.StatusCode == 404 {
+			// Nothing found.  This is not an error, so we return nil on both counts.
+			return nil, nil
+		}
+		return nil, &httpError{method: http.MethodGet, statusCode: resp.StatusCode, body: data, requestID: errorRequestID(resp.Header, data)}
 	}
 	cancel()
 
-	log.Trace().Str("response", string(data)).Msg("GET response")
+	if accept == sszContentType {
+		s.log.Trace().Int("size", len(data)).Msg("GET SSZ response")
+		return data, nil
+	}
+
+	s.log.Trace().Str("response", string(data)).Msg("GET response")
+
+	return data, nil
+}
+
+// lenientResponse returns the supplied response body, quoting any unquoted integers if lenient integer parsing is
+// enabled and the response is JSON.
+func (s *Service) lenientResponse(respBodyReader io.Reader, accept string) (io.Reader, error) {
+	if !s.lenientIntegerParsing || accept == sszContentType {
+		return respBodyReader, nil
+	}
+
+	data, err := ioutil.ReadAll(respBodyReader)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read GET response")
+	}
+	data, err = s.lenientData(data)
+	if err != nil {
+		return nil, err
+	}
 
 	return bytes.NewReader(data), nil
 }
 
+// lenientData returns the supplied JSON data, quoting any unquoted integers if lenient integer parsing is enabled.
+func (s *Service) lenientData(data []byte) ([]byte, error) {
+	if !s.lenientIntegerParsing {
+		return data, nil
+	}
+
+	data, err := quoteIntegers(data)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to quote integers in GET response")
+	}
+
+	return data, nil
+}
+
 // post sends an HTTP post request and returns the body.
 func (s *Service) post(ctx context.Context, endpoint string, body io.Reader) (io.Reader, error) {
+	return s.postWithHeaders(ctx, endpoint, nil, body)
+}
+
+// postWithHeaders sends an HTTP post request with the supplied additional headers and returns the body.
+func (s *Service) postWithHeaders(ctx context.Context, endpoint string, headers map[string]string, body io.Reader) (io.Reader, error) {
+	if err := s.checkNetworkUnchanged(); err != nil {
+		return nil, err
+	}
+
 	reference, err := url.Parse(endpoint)
 	if err != nil {
 		return nil, errors.Wrap(err, "invalid endpoint")
 	}
 	url := s.base.ResolveReference(reference).String()
 
-	if e := log.Trace(); e.Enabled() {
+	if e := s.log.Trace(); e.Enabled() {
 		bodyBytes, err := ioutil.ReadAll(body)
 		if err != nil {
 			return nil, errors.New("failed to read request body")
 		}
 		body = bytes.NewReader(bodyBytes)
 
-		e.Str("url", url).Str("body", string(bodyBytes)).Msg("POST request")
+		if headers["Content-Type"] == sszContentType {
+			e.Str("url", url).Str("body", fmt.Sprintf("%#x", bodyBytes)).Msg("POST request")
+		} else {
+			e.Str("url", url).Str("body", string(bodyBytes)).Msg("POST request")
+		}
 	}
 
 	opCtx, cancel := context.WithTimeout(ctx, s.timeout)
@@ -95,13 +230,18 @@ func (s *Service) post(ctx context.Context, endpoint string, body io.Reader) (io
 		cancel()
 		return nil, errors.Wrap(err, "failed to create POST request")
 	}
+	s.addHeaders(req)
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
 	resp, err := s.client.Do(req)
 	if err != nil {
 		cancel()
 		return nil, errors.Wrap(err, "failed to call POST endpoint")
 	}
+	defer resp.Body.Close()
 
-	data, err := ioutil.ReadAll(resp.Body)
+	data, err := readBody(resp.Body, s.maxResponseBytes)
 	if err != nil {
 		cancel()
 		return nil, errors.Wrap(err, "failed to read POST response")
@@ -110,11 +250,117 @@ func (s *Service) post(ctx context.Context, endpoint string, body io.Reader) (io
 	statusFamily := resp.StatusCode / 100
 	if statusFamily != 2 {
 		cancel()
-		return nil, fmt.Errorf("POST failed with status %d: %s", resp.StatusCode, string(data))
+		return nil, &httpError{method: http.MethodPost, statusCode: resp.StatusCode, body: data, requestID: errorRequestID(resp.Header, data)}
 	}
 	cancel()
 
-	log.Trace().Str("response", string(data)).Msg("GET response")
+	if s.lenientIntegerParsing {
+		data, err = quoteIntegers(data)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to quote integers in POST response")
+		}
+	}
+
+	s.log.Trace().Str("response", string(data)).Msg("GET response")
 
 	return bytes.NewReader(data), nil
 }
+
+// readBody reads a response body, returning an error if it is larger than the maximum allowed size.
+func readBody(body io.Reader, maxBytes int64) ([]byte, error) {
+	if maxBytes == math.MaxInt64 {
+		// The size cannot be exceeded, and the limit below would overflow.
+		return ioutil.ReadAll(body)
+	}
+	data, err := ioutil.ReadAll(io.LimitReader(body, maxBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > maxBytes {
+		return nil, &client.ResponseTooLargeError{MaxBytes: maxBytes}
+	}
+
+	return data, nil
+}
+
+// httpError is returned when a request receives a non-2xx response.
+// Server errors unwrap to client.ServerError.
+type httpError struct {
+	method     string
+	statusCode int
+	body       []byte
+	requestID  string
+}
+
+// Error implements error.
+// The message supplied by the node is used if present, otherwise the body is included as-is.
+func (e *httpError) Error() string {
+	msg := string(e.body)
+	if _, message := parseErrorBody(e.body); message != "" {
+		msg = message
+	}
+	if e.requestID != "" {
+		return fmt.Sprintf("%s failed with status %d: %s (request ID %s)", e.method, e.statusCode, msg, e.requestID)
+	}
+	return fmt.Sprintf("%s failed with status %d: %s", e.method, e.statusCode, msg)
+}
+
+// Unwrap provides a client.ServerError for server errors.
+func (e *httpError) Unwrap() error {
+	if e.statusCode/100 != 5 {
+		return nil
+	}
+	_, message := parseErrorBody(e.body)
+	return &client.ServerError{
+		Status:    e.statusCode,
+		Message:   message,
+		RequestID: e.requestID,
+	}
+}
+
+type submissionFailuresJSON struct {
+	Message  string                   `json:"message"`
+	Failures []*submissionFailureJSON `json:"failures"`
+}
+
+type submissionFailureJSON struct {
+	Index   int    `json:"index"`
+	Message string `json:"message"`
+}
+
+// submissionError converts an error from a submission to a client.SubmissionError if the node
+// reported per-item failures, or to a client.BadRequestError if the node rejected the request with
+// a message, otherwise returning the original error.
+func submissionError(err error) error {
+	var httpErr *httpError
+	if !errors.As(err, &httpErr) {
+		return err
+	}
+
+	var resp submissionFailuresJSON
+	if json.Unmarshal(httpErr.body, &resp) != nil || len(resp.Failures) == 0 {
+		code, message := parseErrorBody(httpErr.body)
+		if httpErr.statusCode != http.StatusBadRequest || message == "" {
+			return err
+		}
+		if code == 0 {
+			code = httpErr.statusCode
+		}
+		return &client.BadRequestError{
+			Code:    code,
+			Message: message,
+		}
+	}
+
+	res := &client.SubmissionError{
+		Message:  resp.Message,
+		Failures: make([]*client.SubmissionFailure, len(resp.Failures)),
+	}
+	for i := range resp.Failures {
+		res.Failures[i] = &client.SubmissionFailure{
+			Index:   resp.Failures[i].Index,
+			Message: resp.Failures[i].Message,
+		}
+	}
+	return res
+}
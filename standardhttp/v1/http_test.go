@@ -0,0 +1,243 @@
+// Copyright © 2020 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"testing"
+
+	eth2client "github.com/attestantio/go-eth2-client"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	client "github.com/attestantio/go-eth2-client/standardhttp/v1"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaxResponseBytes(t *testing.T) {
+	tests := []struct {
+		name             string
+		maxResponseBytes int64
+		err              string
+	}{
+		{
+			name:             "Zero",
+			maxResponseBytes: 0,
+			err:              "problem with parameters: no maximum response size specified",
+		},
+		{
+			name:             "TooSmall",
+			maxResponseBytes: 100,
+			err:              "failed to confirm node connection: failed to fetch genesis: failed to request genesis: failed to read GET response: response exceeds maximum size of 100 bytes",
+		},
+		{
+			name:             "Good",
+			maxResponseBytes: 1024,
+		},
+		{
+			name:             "Unlimited",
+			maxResponseBytes: math.MaxInt64,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := newTestServer(t, nil)
+			_, err := client.New(context.Background(),
+				client.WithAddress(server.URL),
+				client.WithTimeout(timeout),
+				client.WithMaxResponseBytes(test.maxResponseBytes),
+			)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				var tooLargeErr *eth2client.ResponseTooLargeError
+				require.Equal(t, test.maxResponseBytes != 0, errors.As(err, &tooLargeErr))
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestBadRequest(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     int
+		response   string
+		err        string
+		badRequest *eth2client.BadRequestError
+	}{
+		{
+			name:     "BadRequest",
+			status:   http.StatusBadRequest,
+			response: `{"code":400,"message":"voluntary exit epoch not reached"}`,
+			err:      "failed to submit voluntary exit: bad request (400): voluntary exit epoch not reached",
+			badRequest: &eth2client.BadRequestError{
+				Code:    400,
+				Message: "voluntary exit epoch not reached",
+			},
+		},
+		{
+			name:     "NoMessage",
+			status:   http.StatusBadRequest,
+			response: `{"code":400}`,
+			err:      `failed to submit voluntary exit: POST failed with status 400: {"code":400}`,
+		},
+		{
+			name:     "NotJSON",
+			status:   http.StatusBadRequest,
+			response: `bad request`,
+			err:      "failed to submit voluntary exit: bad request (400): bad request",
+			badRequest: &eth2client.BadRequestError{
+				Code:    400,
+				Message: "bad request",
+			},
+		},
+		{
+			name:     "ServerError",
+			status:   http.StatusInternalServerError,
+			response: `{"code":500,"message":"internal error"}`,
+			err:      `failed to submit voluntary exit: POST failed with status 500: internal error`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := newTestServerWithHandlers(t, map[string]http.HandlerFunc{
+				"/eth/v1/beacon/pool/voluntary_exits": func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(test.status)
+					_, _ = w.Write([]byte(test.response))
+				},
+			})
+			service, err := client.New(context.Background(),
+				client.WithTimeout(timeout),
+				client.WithAddress(server.URL),
+			)
+			require.NoError(t, err)
+
+			err = service.SubmitVoluntaryExit(context.Background(), &spec.SignedVoluntaryExit{
+				Message: &spec.VoluntaryExit{},
+			})
+			require.EqualError(t, err, test.err)
+			var badRequestErr *eth2client.BadRequestError
+			if test.badRequest == nil {
+				require.False(t, errors.As(err, &badRequestErr))
+				return
+			}
+			require.True(t, errors.As(err, &badRequestErr))
+			require.Equal(t, test.badRequest, badRequestErr)
+		})
+	}
+}
+
+func TestServerError(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      int
+		header      map[string]string
+		response    string
+		err         string
+		serverError *eth2client.ServerError
+	}{
+		{
+			name:     "RequestIDHeader",
+			status:   http.StatusInternalServerError,
+			header:   map[string]string{"X-Request-Id": "abc123"},
+			response: `{"code":500,"message":"internal error"}`,
+			err:      "failed to request syncing: GET failed with status 500: internal error (request ID abc123)",
+			serverError: &eth2client.ServerError{
+				Status:    500,
+				Message:   "internal error",
+				RequestID: "abc123",
+			},
+		},
+		{
+			name:     "RequestIDBody",
+			status:   http.StatusServiceUnavailable,
+			response: `{"code":503,"message":"node is syncing","request_id":"def456"}`,
+			err:      "failed to request syncing: GET failed with status 503: node is syncing (request ID def456)",
+			serverError: &eth2client.ServerError{
+				Status:    503,
+				Message:   "node is syncing",
+				RequestID: "def456",
+			},
+		},
+		{
+			name:     "NoRequestID",
+			status:   http.StatusInternalServerError,
+			response: `internal error`,
+			err:      "failed to request syncing: GET failed with status 500: internal error",
+			serverError: &eth2client.ServerError{
+				Status:  500,
+				Message: "internal error",
+			},
+		},
+		{
+			name:     "ClientError",
+			status:   http.StatusBadRequest,
+			header:   map[string]string{"X-Request-Id": "abc123"},
+			response: `{"code":400,"message":"bad request"}`,
+			err:      "failed to request syncing: GET failed with status 400: bad request (request ID abc123)",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := newTestServerWithHandlers(t, map[string]http.HandlerFunc{
+				"/eth/v1/node/syncing": func(w http.ResponseWriter, r *http.Request) {
+					for k, v := range test.header {
+						w.Header().Set(k, v)
+					}
+					w.WriteHeader(test.status)
+					_, _ = w.Write([]byte(test.response))
+				},
+				"/eth/v1/beacon/pool/voluntary_exits": func(w http.ResponseWriter, r *http.Request) {
+					for k, v := range test.header {
+						w.Header().Set(k, v)
+					}
+					w.WriteHeader(test.status)
+					_, _ = w.Write([]byte(test.response))
+				},
+			})
+			service, err := client.New(context.Background(),
+				client.WithTimeout(timeout),
+				client.WithAddress(server.URL),
+			)
+			require.NoError(t, err)
+
+			_, err = service.NodeSyncing(context.Background())
+			require.EqualError(t, err, test.err)
+			var serverErr *eth2client.ServerError
+			if test.serverError == nil {
+				require.False(t, errors.As(err, &serverErr))
+			} else {
+				require.True(t, errors.As(err, &serverErr))
+				require.Equal(t, test.serverError, serverErr)
+			}
+
+			// Submissions are treated the same way.
+			err = service.SubmitVoluntaryExit(context.Background(), &spec.SignedVoluntaryExit{
+				Message: &spec.VoluntaryExit{},
+			})
+			serverErr = nil
+			if test.serverError == nil {
+				require.False(t, errors.As(err, &serverErr))
+			} else {
+				require.True(t, errors.As(err, &serverErr))
+				require.Equal(t, test.serverError, serverErr)
+			}
+		})
+	}
+}
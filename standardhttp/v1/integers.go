@@ -0,0 +1,98 @@
+// Copyright © 2020 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// quoteIntegers rewrites a JSON document, quoting any unquoted integers.
+func quoteIntegers(input []byte) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(input))
+	decoder.UseNumber()
+
+	var output bytes.Buffer
+	for decoder.More() {
+		if err := quoteIntegersInValue(decoder, &output); err != nil {
+			return nil, err
+		}
+	}
+
+	return output.Bytes(), nil
+}
+
+// quoteIntegersInValue writes the next value from the decoder to the output, quoting any unquoted integers.
+func quoteIntegersInValue(decoder *json.Decoder, output *bytes.Buffer) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	switch value := token.(type) {
+	case json.Delim:
+		output.WriteString(value.String())
+		for i := 0; decoder.More(); i++ {
+			if i > 0 {
+				output.WriteByte(',')
+			}
+			if value == '{' {
+				key, err := decoder.Token()
+				if err != nil {
+					return errors.Wrap(err, "invalid JSON")
+				}
+				if err := writeJSON(output, key); err != nil {
+					return err
+				}
+				output.WriteByte(':')
+			}
+			if err := quoteIntegersInValue(decoder, output); err != nil {
+				return err
+			}
+		}
+		// Closing delimiter.
+		token, err := decoder.Token()
+		if err != nil {
+			return errors.Wrap(err, "invalid JSON")
+		}
+		output.WriteString(token.(json.Delim).String())
+	case json.Number:
+		if strings.ContainsAny(value.String(), ".eE") {
+			output.WriteString(value.String())
+		} else {
+			output.WriteString(fmt.Sprintf("%q", value.String()))
+		}
+	default:
+		if err := writeJSON(output, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// writeJSON writes the JSON representation of a simple value to the output.
+func writeJSON(output *bytes.Buffer, value interface{}) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal value")
+	}
+	output.Write(data)
+
+	return nil
+}
@@ -0,0 +1,75 @@
+// Copyright © 2020 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"context"
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	client "github.com/attestantio/go-eth2-client/standardhttp/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLenientIntegerParsing(t *testing.T) {
+	responses := map[string]string{
+		"/eth/v1/beacon/genesis":          `{"data":{"genesis_time":1606824023,"genesis_validators_root":"0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95","genesis_fork_version":"0x00000000"}}`,
+		"/eth/v1/config/spec":             `{"data":{"SECONDS_PER_SLOT":12,"SLOTS_PER_EPOCH":32,"MIN_GENESIS_DELAY":86400}}`,
+		"/eth/v1/config/deposit_contract": `{"data":{"chain_id":1,"address":"0x00000000219ab540356cbb839cbe05303d7705fa"}}`,
+		"/eth/v1/node/syncing":            `{"data":{"head_slot":12345,"sync_distance":0}}`,
+	}
+
+	tests := []struct {
+		name    string
+		lenient bool
+		err     string
+	}{
+		{
+			name: "Strict",
+			err:  "failed to confirm node connection: failed to fetch genesis: failed to parse genesis: invalid JSON: json: cannot unmarshal number into Go struct field genesisJSON.genesis_time of type string",
+		},
+		{
+			name:    "Lenient",
+			lenient: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := newTestServer(t, responses)
+			service, err := client.New(context.Background(),
+				client.WithAddress(server.URL),
+				client.WithTimeout(timeout),
+				client.WithLenientIntegerParsing(test.lenient),
+			)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+
+			genesis, err := service.Genesis(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, int64(1606824023), genesis.GenesisTime.Unix())
+
+			slotsPerEpoch, err := service.SlotsPerEpoch(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, uint64(32), slotsPerEpoch)
+
+			syncState, err := service.NodeSyncing(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, &api.SyncState{HeadSlot: 12345}, syncState)
+		})
+	}
+}
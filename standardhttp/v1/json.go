@@ -0,0 +1,145 @@
+// Copyright © 2020 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"github.com/pkg/errors"
+)
+
+// decodeJSON decodes a JSON response in to the supplied object.
+// If strict JSON is enabled, fields in the response that are not present in the object are rejected.
+func (s *Service) decodeJSON(respBodyReader io.Reader, obj interface{}) error {
+	if !s.strictJSON {
+		return json.NewDecoder(respBodyReader).Decode(obj)
+	}
+
+	raw, err := ioutil.ReadAll(respBodyReader)
+	if err != nil {
+		return errors.Wrap(err, "failed to read response")
+	}
+	return s.unmarshalJSON(raw, obj)
+}
+
+// unmarshalJSON unmarshals a JSON response in to the supplied object.
+// If strict JSON is enabled, fields in the response that are not present in the object are rejected.
+func (s *Service) unmarshalJSON(raw []byte, obj interface{}) error {
+	if !s.strictJSON {
+		return json.Unmarshal(raw, obj)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(obj); err != nil {
+		return err
+	}
+
+	// The decoder does not check fields handled by types with their own unmarshalers, so also ensure that
+	// every field in the response is present when the object is marshalled back to JSON.
+	return checkUnknownFields(raw, obj)
+}
+
+// checkUnknownFields returns an error if the raw JSON contains fields that are not present in the
+// JSON representation of the object.
+func checkUnknownFields(raw []byte, obj interface{}) error {
+	var input interface{}
+	if err := json.Unmarshal(raw, &input); err != nil {
+		return err
+	}
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal decoded response")
+	}
+	var known interface{}
+	if err := json.Unmarshal(data, &known); err != nil {
+		return errors.Wrap(err, "failed to unmarshal decoded response")
+	}
+
+	return compareFields("", input, known)
+}
+
+// compareFields recursively checks that all fields in the input are present in the known value.
+func compareFields(path string, input interface{}, known interface{}) error {
+	switch inputValue := input.(type) {
+	case map[string]interface{}:
+		knownValue, isMap := known.(map[string]interface{})
+		if !isMap {
+			// Type mismatches are the responsibility of the decoder.
+			return nil
+		}
+		for key, value := range inputValue {
+			fieldPath := key
+			if path != "" {
+				fieldPath = fmt.Sprintf("%s.%s", path, key)
+			}
+			knownField, exists := knownValue[key]
+			if !exists {
+				return fmt.Errorf("unknown field %s", fieldPath)
+			}
+			if err := compareFields(fieldPath, value, knownField); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		knownValue, isSlice := known.([]interface{})
+		if !isSlice || len(knownValue) != len(inputValue) {
+			return nil
+		}
+		for i := range inputValue {
+			if err := compareFields(fmt.Sprintf("%s[%d]", path, i), inputValue[i], knownValue[i]); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// forEachDataItem streams the data list of a response, calling the supplied function to decode each item in turn.
+// This avoids holding the entire decoded list in memory for large responses.
+func forEachDataItem(reader io.Reader, fn func(decoder *json.Decoder) error) error {
+	decoder := json.NewDecoder(reader)
+	if token, err := decoder.Token(); err != nil || token != json.Delim('{') {
+		return errors.New("response is not an object")
+	}
+	for decoder.More() {
+		key, err := decoder.Token()
+		if err != nil {
+			return errors.Wrap(err, "invalid JSON")
+		}
+		if key != "data" {
+			var skip json.RawMessage
+			if err := decoder.Decode(&skip); err != nil {
+				return errors.Wrap(err, "invalid JSON")
+			}
+			continue
+		}
+		if token, err := decoder.Token(); err != nil || token != json.Delim('[') {
+			return errors.New("data is not a list")
+		}
+		for decoder.More() {
+			if err := fn(decoder); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	return errors.New("data missing")
+}
@@ -0,0 +1,92 @@
+// Copyright © 2020 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStrictJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		strict   bool
+		response string
+		err      string
+	}{
+		{
+			name:     "Good",
+			strict:   true,
+			response: `{"data":{"previous_version":"0x00000000","current_version":"0x00000001","epoch":"1"}}`,
+		},
+		{
+			name:     "UnknownField",
+			strict:   true,
+			response: `{"data":{"previous_version":"0x00000000","current_version":"0x00000001","epoch":"1"},"extra":true}`,
+			err:      `failed to parse fork: json: unknown field "extra"`,
+		},
+		{
+			name:     "UnknownNestedField",
+			strict:   true,
+			response: `{"data":{"previous_version":"0x00000000","current_version":"0x00000001","epoch":"1","extra":true}}`,
+			err:      "failed to parse fork: unknown field data.extra",
+		},
+		{
+			name:     "UnknownFieldNotStrict",
+			response: `{"data":{"previous_version":"0x00000000","current_version":"0x00000001","epoch":"1","extra":true},"extra":true}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := newTestServer(t, map[string]string{
+				"/eth/v1/beacon/states/head/fork": test.response,
+			})
+			service, err := standardhttp.New(context.Background(),
+				standardhttp.WithTimeout(timeout),
+				standardhttp.WithAddress(server.URL),
+				standardhttp.WithStrictJSON(test.strict),
+			)
+			require.NoError(t, err)
+
+			fork, err := service.Fork(context.Background(), "head")
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, fork)
+		})
+	}
+}
+
+func TestStrictJSONNestedList(t *testing.T) {
+	duty := `{"pubkey":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f","slot":"32","validator_index":"1"`
+	server := newTestServer(t, map[string]string{
+		"/eth/v1/validator/duties/proposer/1": fmt.Sprintf(`{"data":[%s},%s,"extra":true}]}`, duty, duty),
+	})
+	service, err := standardhttp.New(context.Background(),
+		standardhttp.WithTimeout(timeout),
+		standardhttp.WithAddress(server.URL),
+		standardhttp.WithStrictJSON(true),
+	)
+	require.NoError(t, err)
+
+	_, err = service.ProposerDuties(context.Background(), 1, nil)
+	require.EqualError(t, err, "failed to parse proposer duties response: unknown field data[1].extra")
+}
@@ -0,0 +1,51 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	client "github.com/attestantio/go-eth2-client"
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+type lightClientBootstrapJSON struct {
+	Version string                    `json:"version"`
+	Data    *api.LightClientBootstrap `json:"data"`
+}
+
+// LightClientBootstrap provides the data to bootstrap a light client from the given trusted block root.
+// N.B if the node does not have bootstrap data for the block root this will return an error that wraps client.ErrNotFound.
+func (s *Service) LightClientBootstrap(ctx context.Context, blockRoot spec.Root) (*api.LightClientBootstrap, error) {
+	respBodyReader, err := s.get(ctx, fmt.Sprintf("/eth/v1/beacon/light_client/bootstrap/%s", hexParam(blockRoot[:])))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request light client bootstrap")
+	}
+	if respBodyReader == nil {
+		return nil, errors.Wrap(client.ErrNotFound, "failed to obtain light client bootstrap")
+	}
+
+	var resp lightClientBootstrapJSON
+	if err := s.decodeJSON(respBodyReader, &resp); err != nil {
+		return nil, errors.Wrap(err, "failed to parse light client bootstrap")
+	}
+	if resp.Data == nil {
+		return nil, errors.New("no light client bootstrap returned")
+	}
+
+	return resp.Data, nil
+}
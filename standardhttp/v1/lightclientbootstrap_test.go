@@ -0,0 +1,76 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testLightClientHeader = `{"beacon":{"slot":"1","proposer_index":"2","parent_root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","state_root":"0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f","body_root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f"}}`
+	testSyncAggregate     = `{"sync_committee_bits":"0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff7f","sync_committee_signature":"0x404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f606162636465666768696a6b6c6d6e6f707172737475767778797a7b7c7d7e7f808182838485868788898a8b8c8d8e8f909192939495969798999a9b9c9d9e9f"}`
+)
+
+// testSyncCommittee returns the JSON for a sync committee.
+func testSyncCommittee() string {
+	pubkeys := make([]string, 512)
+	for i := range pubkeys {
+		pubkeys[i] = fmt.Sprintf(`"0x%096x"`, i)
+	}
+	return fmt.Sprintf(`{"pubkeys":[%s],"aggregate_pubkey":"0x%096x"}`, strings.Join(pubkeys, ","), 512)
+}
+
+// testBranch returns the JSON for a merkle branch with the given number of roots.
+func testBranch(length int) string {
+	roots := make([]string, length)
+	for i := range roots {
+		roots[i] = `"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f"`
+	}
+	return fmt.Sprintf("[%s]", strings.Join(roots, ","))
+}
+
+func TestLightClientBootstrap(t *testing.T) {
+	blockRoot := spec.Root{0x01}
+	server := newTestServerWithHandlers(t, map[string]http.HandlerFunc{
+		fmt.Sprintf("/eth/v1/beacon/light_client/bootstrap/%#x", blockRoot): respondWith(fmt.Sprintf(`{"version":"altair","data":{"header":%s,"current_sync_committee":%s,"current_sync_committee_branch":%s}}`,
+			testLightClientHeader, testSyncCommittee(), testBranch(5))),
+	})
+
+	service, err := standardhttp.New(context.Background(),
+		standardhttp.WithTimeout(timeout),
+		standardhttp.WithAddress(server.URL),
+	)
+	require.NoError(t, err)
+
+	bootstrap, err := service.LightClientBootstrap(context.Background(), blockRoot)
+	require.NoError(t, err)
+	require.Equal(t, spec.Slot(1), bootstrap.Header.Beacon.Slot)
+	require.Len(t, bootstrap.CurrentSyncCommittee.Pubkeys, 512)
+	require.Equal(t, byte(0x01), bootstrap.CurrentSyncCommittee.Pubkeys[1][47])
+	require.Len(t, bootstrap.CurrentSyncCommitteeBranch, 5)
+
+	// Unknown block root.
+	_, err = service.LightClientBootstrap(context.Background(), spec.Root{0x02})
+	require.True(t, errors.Is(err, client.ErrNotFound))
+}
@@ -0,0 +1,78 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+
+	client "github.com/attestantio/go-eth2-client"
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/pkg/errors"
+)
+
+type lightClientUpdateJSON struct {
+	Version string                 `json:"version"`
+	Data    *api.LightClientUpdate `json:"data"`
+}
+
+// lightClientUpdatesJSON is the response format used by nodes that wrap the list of updates in a data field.
+type lightClientUpdatesJSON struct {
+	Data []*lightClientUpdateJSON `json:"data"`
+}
+
+// LightClientUpdates provides up to count light client updates, starting at the given sync committee period.
+// N.B if the node does not provide light client updates this will return an error that wraps client.ErrNotFound.
+func (s *Service) LightClientUpdates(ctx context.Context, startPeriod uint64, count uint64) ([]*api.LightClientUpdate, error) {
+	if count == 0 {
+		return nil, errors.New("no count specified")
+	}
+
+	respBodyReader, err := s.get(ctx, fmt.Sprintf("/eth/v1/beacon/light_client/updates?start_period=%d&count=%d", startPeriod, count))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request light client updates")
+	}
+	if respBodyReader == nil {
+		return nil, errors.Wrap(client.ErrNotFound, "failed to obtain light client updates")
+	}
+	data, err := ioutil.ReadAll(respBodyReader)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read light client updates")
+	}
+
+	// The standard response is a list of versioned updates, but some nodes wrap the list in a data field.
+	var updates []*lightClientUpdateJSON
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("[")) {
+		err = s.unmarshalJSON(data, &updates)
+	} else {
+		var resp lightClientUpdatesJSON
+		err = s.unmarshalJSON(data, &resp)
+		updates = resp.Data
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse light client updates")
+	}
+
+	res := make([]*api.LightClientUpdate, 0, len(updates))
+	for i := range updates {
+		if updates[i] == nil || updates[i].Data == nil {
+			return nil, fmt.Errorf("light client update %d missing", i)
+		}
+		res = append(res, updates[i].Data)
+	}
+
+	return res, nil
+}
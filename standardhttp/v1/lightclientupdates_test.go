@@ -0,0 +1,117 @@
+// Copyright © 2022 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLightClientUpdates(t *testing.T) {
+	update := fmt.Sprintf(`{"version":"altair","data":{"attested_header":%s,"next_sync_committee":%s,"next_sync_committee_branch":%s,"finalized_header":%s,"finality_branch":%s,"sync_aggregate":%s,"signature_slot":"3"}}`,
+		testLightClientHeader, testSyncCommittee(), testBranch(5), testLightClientHeader, testBranch(6), testSyncAggregate)
+
+	tests := []struct {
+		name     string
+		response string
+		count    uint64
+		updates  int
+		err      string
+	}{
+		{
+			name:  "CountZero",
+			count: 0,
+			err:   "no count specified",
+		},
+		{
+			name:     "List",
+			response: fmt.Sprintf("[%s,%s]", update, update),
+			count:    2,
+			updates:  2,
+		},
+		{
+			name:     "Wrapped",
+			response: fmt.Sprintf(`{"data":[%s]}`, update),
+			count:    1,
+			updates:  1,
+		},
+		{
+			name:     "Empty",
+			response: "[]",
+			count:    1,
+		},
+		{
+			name:     "UpdateMissing",
+			response: `[{"version":"altair"}]`,
+			count:    1,
+			err:      "light client update 0 missing",
+		},
+		{
+			name:     "BranchInvalid",
+			response: fmt.Sprintf(`[{"version":"altair","data":{"attested_header":%s,"next_sync_committee":%s,"next_sync_committee_branch":%s,"finalized_header":%s,"finality_branch":%s,"sync_aggregate":%s,"signature_slot":"3"}}]`, testLightClientHeader, testSyncCommittee(), testBranch(4), testLightClientHeader, testBranch(6), testSyncAggregate),
+			count:    1,
+			err:      "failed to parse light client updates: invalid value for next sync committee branch: incorrect length 4",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := newTestServerWithHandlers(t, map[string]http.HandlerFunc{
+				"/eth/v1/beacon/light_client/updates": func(w http.ResponseWriter, r *http.Request) {
+					require.Equal(t, "5", r.URL.Query().Get("start_period"))
+					require.Equal(t, fmt.Sprintf("%d", test.count), r.URL.Query().Get("count"))
+					respondWith(test.response)(w, r)
+				},
+			})
+			service, err := standardhttp.New(context.Background(),
+				standardhttp.WithTimeout(timeout),
+				standardhttp.WithAddress(server.URL),
+			)
+			require.NoError(t, err)
+
+			updates, err := service.LightClientUpdates(context.Background(), 5, test.count)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Len(t, updates, test.updates)
+				for _, update := range updates {
+					require.Equal(t, spec.Slot(3), update.SignatureSlot)
+					require.Len(t, update.NextSyncCommitteeBranch, 5)
+					require.Len(t, update.FinalityBranch, 6)
+				}
+			}
+		})
+	}
+}
+
+func TestLightClientUpdatesNotSupported(t *testing.T) {
+	server := newTestServerWithHandlers(t, map[string]http.HandlerFunc{})
+	service, err := standardhttp.New(context.Background(),
+		standardhttp.WithTimeout(timeout),
+		standardhttp.WithAddress(server.URL),
+	)
+	require.NoError(t, err)
+
+	_, err = service.LightClientUpdates(context.Background(), 0, 1)
+	require.True(t, errors.Is(err, client.ErrNotFound))
+}
@@ -26,7 +26,13 @@ var timeout = 60 * time.Second
 
 func TestMain(m *testing.M) {
 	zerolog.SetGlobalLevel(zerolog.Disabled)
-	if os.Getenv("HTTP_ADDRESS") != "" {
-		os.Exit(m.Run())
+	os.Exit(m.Run())
+}
+
+// skipWithoutNode skips a test that requires a live node if no node address is supplied in HTTP_ADDRESS.
+func skipWithoutNode(t *testing.T) {
+	t.Helper()
+	if os.Getenv("HTTP_ADDRESS") == "" {
+		t.Skip("HTTP_ADDRESS not set")
 	}
 }
@@ -0,0 +1,58 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+// knownNetwork is a network with a well-known genesis validators root and deposit chain ID.
+type knownNetwork struct {
+	name    string
+	chainID uint64
+}
+
+// knownNetworks are the known networks, keyed by hex genesis validators root.
+var knownNetworks = map[string]knownNetwork{
+	"0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95": {name: "mainnet", chainID: 1},
+	"0x043db0d9a83813551ee2f33450d23797757d430911a9320530ad8a0eabc43efb": {name: "goerli", chainID: 5},
+	"0xd8ea171f3c94aea21ebc42a1ed61052acf3f9209c00e4efbaaddac09ed9b8078": {name: "sepolia", chainID: 11155111},
+	"0x9143aa7c615a7f7115e2b6aac319c03529df8242ae705fba9df39b79c59fa8b1": {name: "holesky", chainID: 17000},
+	"0xf5dcb5564e829aab27264b9becd5dfaa017085611224cb3036f573368dbb9d47": {name: "gnosis", chainID: 100},
+}
+
+// Network provides the name of the network to which the node is connected, for example "mainnet" or "holesky".
+// The name is derived from the genesis validators root and deposit chain ID; if these do not match a known network
+// the name is "unknown" followed by the genesis validators root.
+func (s *Service) Network(ctx context.Context) (string, error) {
+	genesis, err := s.Genesis(ctx)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to obtain genesis")
+	}
+	depositContract, err := s.DepositContract(ctx)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to obtain deposit contract")
+	}
+
+	root := fmt.Sprintf("%#x", genesis.GenesisValidatorsRoot)
+	network, exists := knownNetworks[root]
+	if !exists || network.chainID != depositContract.ChainID {
+		return fmt.Sprintf("unknown (%s)", root), nil
+	}
+
+	return network.name, nil
+}
@@ -0,0 +1,74 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"context"
+	"testing"
+
+	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNetwork(t *testing.T) {
+	tests := []struct {
+		name            string
+		genesis         string
+		depositContract string
+		expected        string
+	}{
+		{
+			name:            "Mainnet",
+			genesis:         `{"data":{"genesis_time":"1606824023","genesis_validators_root":"0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95","genesis_fork_version":"0x00000000"}}`,
+			depositContract: `{"data":{"chain_id":"1","address":"0x00000000219ab540356cbb839cbe05303d7705fa"}}`,
+			expected:        "mainnet",
+		},
+		{
+			name:            "Holesky",
+			genesis:         `{"data":{"genesis_time":"1695902400","genesis_validators_root":"0x9143aa7c615a7f7115e2b6aac319c03529df8242ae705fba9df39b79c59fa8b1","genesis_fork_version":"0x01017000"}}`,
+			depositContract: `{"data":{"chain_id":"17000","address":"0x4242424242424242424242424242424242424242"}}`,
+			expected:        "holesky",
+		},
+		{
+			name:            "ChainIDMismatch",
+			genesis:         `{"data":{"genesis_time":"1606824023","genesis_validators_root":"0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95","genesis_fork_version":"0x00000000"}}`,
+			depositContract: `{"data":{"chain_id":"5","address":"0x00000000219ab540356cbb839cbe05303d7705fa"}}`,
+			expected:        "unknown (0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95)",
+		},
+		{
+			name:            "Unknown",
+			genesis:         `{"data":{"genesis_time":"1606824023","genesis_validators_root":"0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20","genesis_fork_version":"0x00000000"}}`,
+			depositContract: `{"data":{"chain_id":"1337","address":"0x00000000219ab540356cbb839cbe05303d7705fa"}}`,
+			expected:        "unknown (0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20)",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := newTestServer(t, map[string]string{
+				"/eth/v1/beacon/genesis":          test.genesis,
+				"/eth/v1/config/deposit_contract": test.depositContract,
+			})
+			service, err := standardhttp.New(context.Background(),
+				standardhttp.WithTimeout(timeout),
+				standardhttp.WithAddress(server.URL),
+			)
+			require.NoError(t, err)
+
+			network, err := service.Network(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, test.expected, network)
+		})
+	}
+}
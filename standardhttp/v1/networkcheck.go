@@ -0,0 +1,86 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/pkg/errors"
+)
+
+// checkNetwork periodically confirms that the node's genesis validators root has not changed, until either the
+// context is done or a change is detected.
+func (s *Service) checkNetwork(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			changed, err := s.networkChanged(ctx)
+			if err != nil {
+				s.log.Debug().Err(err).Msg("Failed to check network")
+				continue
+			}
+			if changed {
+				return
+			}
+		}
+	}
+}
+
+// networkChanged checks the node's genesis validators root against that obtained when the service started,
+// returning true if it has changed.
+func (s *Service) networkChanged(ctx context.Context) (bool, error) {
+	// Genesis is cached by the service, so go direct to the node.
+	data, err := s.doGet(ctx, "/eth/v1/beacon/genesis", "")
+	if err != nil {
+		return false, errors.Wrap(err, "failed to request genesis")
+	}
+	if data == nil {
+		return false, errors.New("genesis not returned")
+	}
+	var resp genesisJSON
+	if err := s.decodeJSON(bytes.NewReader(data), &resp); err != nil {
+		return false, errors.Wrap(err, "failed to parse genesis")
+	}
+	if resp.Data == nil {
+		return false, errors.New("genesis data missing")
+	}
+	if resp.Data.GenesisValidatorsRoot == s.genesis.GenesisValidatorsRoot {
+		return false, nil
+	}
+
+	err = &client.NetworkChangedError{
+		Previous: s.genesis.GenesisValidatorsRoot,
+		Current:  resp.Data.GenesisValidatorsRoot,
+	}
+	s.log.Error().Err(err).Msg("Node is on a different network; client must be recreated")
+	s.networkChangedErr.Store(err)
+
+	return true, nil
+}
+
+// checkNetworkUnchanged returns an error if the node has been found to be on a different network.
+func (s *Service) checkNetworkUnchanged() error {
+	if err, changed := s.networkChangedErr.Load().(error); changed {
+		return err
+	}
+
+	return nil
+}
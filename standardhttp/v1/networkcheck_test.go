@@ -0,0 +1,79 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	client "github.com/attestantio/go-eth2-client"
+	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNetworkCheck(t *testing.T) {
+	changed := int32(0)
+	server := newTestServerWithHandlers(t, map[string]http.HandlerFunc{
+		"/eth/v1/beacon/genesis": func(w http.ResponseWriter, r *http.Request) {
+			if atomic.LoadInt32(&changed) == 1 {
+				respondWith(`{"data":{"genesis_time":"1616508000","genesis_validators_root":"0x043db0d9a83813551ee2f33450d23797757d430911a9320530ad8a0eabc43efb","genesis_fork_version":"0x00001020"}}`)(w, r)
+				return
+			}
+			respondWith(staticResponses["/eth/v1/beacon/genesis"])(w, r)
+		},
+		"/eth/v1/node/syncing": respondWith(`{"data":{"head_slot":"1","sync_distance":"0","is_syncing":false}}`),
+	})
+
+	_, err := standardhttp.New(context.Background(),
+		standardhttp.WithTimeout(timeout),
+		standardhttp.WithAddress(server.URL),
+		standardhttp.WithNetworkCheckInterval(-time.Second),
+	)
+	require.EqualError(t, err, "problem with parameters: invalid network check interval specified")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	service, err := standardhttp.New(ctx,
+		standardhttp.WithTimeout(timeout),
+		standardhttp.WithAddress(server.URL),
+		standardhttp.WithNetworkCheckInterval(10*time.Millisecond),
+	)
+	require.NoError(t, err)
+
+	// Calls succeed whilst the network is unchanged.
+	time.Sleep(50 * time.Millisecond)
+	_, err = service.NodeSyncing(ctx)
+	require.NoError(t, err)
+
+	// Once the network changes all calls fail.
+	atomic.StoreInt32(&changed, 1)
+	require.Eventually(t, func() bool {
+		_, err := service.NodeSyncing(ctx)
+		return err != nil
+	}, 5*time.Second, 10*time.Millisecond)
+	_, err = service.NodeSyncing(ctx)
+	require.EqualError(t, err, "failed to request syncing: node genesis validators root changed from 0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95 to 0x043db0d9a83813551ee2f33450d23797757d430911a9320530ad8a0eabc43efb; network changed")
+	var networkChangedErr *client.NetworkChangedError
+	require.True(t, errors.As(err, &networkChangedErr))
+
+	// A change back does not clear the error.
+	atomic.StoreInt32(&changed, 0)
+	time.Sleep(50 * time.Millisecond)
+	_, err = service.NodeSyncing(ctx)
+	require.True(t, errors.As(err, &networkChangedErr))
+}
@@ -15,7 +15,6 @@ package v1
 
 import (
 	"context"
-	"encoding/json"
 
 	api "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/pkg/errors"
@@ -36,7 +35,7 @@ func (s *Service) NodeSyncing(ctx context.Context) (*api.SyncState, error) {
 	}
 
 	var resp syncingJSON
-	if err := json.NewDecoder(respBodyReader).Decode(&resp); err != nil {
+	if err := s.decodeJSON(respBodyReader, &resp); err != nil {
 		return nil, errors.Wrap(err, "failed to parse syncing")
 	}
 	return resp.Data, nil
@@ -15,7 +15,6 @@ package v1
 
 import (
 	"context"
-	"encoding/json"
 
 	"github.com/pkg/errors"
 )
@@ -40,7 +39,7 @@ func (s *Service) NodeVersion(ctx context.Context) (string, error) {
 		}
 
 		var resp nodeVersionJSON
-		if err := json.NewDecoder(respBodyReader).Decode(&resp); err != nil {
+		if err := s.decodeJSON(respBodyReader, &resp); err != nil {
 			return "", errors.Wrap(err, "failed to parse node version")
 		}
 		s.nodeVersion = resp.Data.Version
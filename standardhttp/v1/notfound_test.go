@@ -0,0 +1,119 @@
+// Copyright © 2020 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotFound(t *testing.T) {
+	ctx := context.Background()
+	server := newTestServer(t, nil)
+	service, err := standardhttp.New(ctx,
+		standardhttp.WithTimeout(timeout),
+		standardhttp.WithAddress(server.URL),
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		call func() error
+	}{
+		{
+			name: "SignedBeaconBlock",
+			call: func() error {
+				_, err := service.SignedBeaconBlock(ctx, "head")
+				return err
+			},
+		},
+		{
+			name: "BeaconState",
+			call: func() error {
+				_, err := service.BeaconState(ctx, "head")
+				return err
+			},
+		},
+		{
+			name: "BeaconBlockHeader",
+			call: func() error {
+				_, err := service.BeaconBlockHeader(ctx, "head")
+				return err
+			},
+		},
+		{
+			name: "Validators",
+			call: func() error {
+				_, err := service.Validators(ctx, "head", []spec.ValidatorIndex{1})
+				return err
+			},
+		},
+		{
+			name: "ValidatorsByPubKey",
+			call: func() error {
+				_, err := service.ValidatorsByPubKey(ctx, "head", nil)
+				return err
+			},
+		},
+		{
+			name: "ValidatorBalances",
+			call: func() error {
+				_, err := service.ValidatorBalances(ctx, "head", nil)
+				return err
+			},
+		},
+		{
+			name: "AggregateAttestation",
+			call: func() error {
+				_, err := service.AggregateAttestation(ctx, 1, spec.Root{})
+				return err
+			},
+		},
+		{
+			name: "StateRoot",
+			call: func() error {
+				_, err := service.StateRoot(ctx, "head")
+				return err
+			},
+		},
+		{
+			name: "Fork",
+			call: func() error {
+				_, err := service.Fork(ctx, "head")
+				return err
+			},
+		},
+		{
+			name: "Finality",
+			call: func() error {
+				_, err := service.Finality(ctx, "head")
+				return err
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.call()
+			require.Error(t, err)
+			require.True(t, errors.Is(err, client.ErrNotFound))
+		})
+	}
+}
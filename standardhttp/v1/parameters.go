@@ -14,8 +14,10 @@
 package v1
 
 import (
+	"net/http"
 	"time"
 
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
 )
@@ -24,6 +26,55 @@ type parameters struct {
 	logLevel zerolog.Level
 	address  string
 	timeout  time.Duration
+
+	connectionTimeout time.Duration
+	http2             bool
+	transport         http.RoundTripper
+	extraHeaders      map[string]string
+
+	maxResponseBytes int64
+
+	lenientIntegerParsing bool
+
+	expectedGenesisValidatorsRoot *spec.Root
+	networkCheckInterval          time.Duration
+
+	retryJitter bool
+	backoff     Backoff
+
+	preferSSZ   bool
+	sszFallback bool
+
+	apiVersion string
+
+	dutiesCacheTTL time.Duration
+	dutiesRefresh  bool
+
+	strictJSON bool
+
+	finalizedOnly bool
+
+	submissionValidation bool
+
+	submissionTracking       bool
+	strictSubmissionTracking bool
+
+	requestCoalescing bool
+
+	responseCacheSize int
+
+	validatorsChunkSize int
+
+	fastValidatorsParsing bool
+
+	onReconnect func(backend string, endpoint string, attempt int)
+	onConnected func(backend string, endpoint string)
+
+	maxReconnects  int
+	onStreamFailed func(backend string, endpoint string, err error)
+
+	eventBufferSize int
+	eventHistory    int
 }
 
 // Parameter is the interface for service parameters.
@@ -58,11 +109,295 @@ func WithTimeout(timeout time.Duration) Parameter {
 	})
 }
 
+// WithLenientIntegerParsing allows integers in responses to be quoted or unquoted.
+// This is required for beacon nodes, such as Nimbus, that do not quote all integers.
+func WithLenientIntegerParsing(lenient bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.lenientIntegerParsing = lenient
+	})
+}
+
+// WithMaxResponseBytes sets the maximum size of a response body from the endpoint.
+// This does not apply to event streams.
+func WithMaxResponseBytes(maxResponseBytes int64) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.maxResponseBytes = maxResponseBytes
+	})
+}
+
+// WithExpectedGenesisValidatorsRoot sets the genesis validators root that the node must report.
+// This ensures that the node is on the expected network.
+func WithExpectedGenesisValidatorsRoot(root spec.Root) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.expectedGenesisValidatorsRoot = &root
+	})
+}
+
+// WithNetworkCheckInterval periodically confirms that the node's genesis validators root has not changed, for
+// example because the node was restarted on a different network.  If it has changed an error is logged and all
+// subsequent calls return an error that wraps client.NetworkChangedError, and the client should be recreated.
+// A value of 0, the default, disables the check.
+func WithNetworkCheckInterval(interval time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.networkCheckInterval = interval
+	})
+}
+
+// WithConnectionTimeout sets the maximum duration to establish a connection to the endpoint.
+// This is separate from, and should be lower than, the request timeout.
+func WithConnectionTimeout(timeout time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.connectionTimeout = timeout
+	})
+}
+
+// WithHTTP2 uses HTTP/2 to talk to the node, allowing many requests to share a single connection.
+// For http addresses this uses HTTP/2 with prior knowledge (h2c), falling back to HTTP/1.1 if the
+// node does not support it.  For https addresses HTTP/2 is negotiated as part of the TLS handshake.
+func WithHTTP2(enabled bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.http2 = enabled
+	})
+}
+
+// WithTransport sets the transport used for requests to the node, for example to record or replay responses.
+// If this is supplied then the connection parameters are ignored.
+func WithTransport(transport http.RoundTripper) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.transport = transport
+	})
+}
+
+// WithExtraHeaders sets additional headers to send with every request to the node.
+// Headers supplied for individual requests with WithRequestHeaders take precedence over these.
+func WithExtraHeaders(headers map[string]string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		for k, v := range headers {
+			p.extraHeaders[http.CanonicalHeaderKey(k)] = v
+		}
+	})
+}
+
+// WithHeader sets an additional header to send with every request to the node.
+// This can be supplied multiple times; later values for the same header override earlier ones.
+func WithHeader(key string, value string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.extraHeaders[http.CanonicalHeaderKey(key)] = value
+	})
+}
+
+// WithRetryJitter randomises the delays between reconnection attempts.
+// This avoids large numbers of clients reconnecting in lockstep when a node restarts.
+func WithRetryJitter(jitter bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.retryJitter = jitter
+	})
+}
+
+// WithBackoff sets the strategy for the delays between reconnection attempts.
+// If not supplied the delay grows exponentially from 500ms to one minute, with jitter as set by WithRetryJitter.
+func WithBackoff(backoff Backoff) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.backoff = backoff
+	})
+}
+
+// WithPreferSSZ requests SSZ rather than JSON encoding for methods that support it.
+// SSZ is more compact and faster to decode than JSON for large objects such as blocks and states.
+func WithPreferSSZ(preferSSZ bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.preferSSZ = preferSSZ
+	})
+}
+
+// WithSSZFallback retries a request with JSON if a preferred SSZ response cannot be decoded, or if the node
+// rejects an SSZ submission.
+// This provides resilience where the node's SSZ schema does not match that of the client.
+func WithSSZFallback(fallback bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.sszFallback = fallback
+	})
+}
+
+// WithOnReconnect sets a function to be called on each attempt to reconnect an event stream.
+// It is supplied with the address of the node, the endpoint of the stream and the number of attempts since the
+// stream was last connected.
+func WithOnReconnect(onReconnect func(backend string, endpoint string, attempt int)) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.onReconnect = onReconnect
+	})
+}
+
+// WithOnConnected sets a function to be called each time an event stream is connected or reconnected.
+// It is supplied with the address of the node and the endpoint of the stream.
+func WithOnConnected(onConnected func(backend string, endpoint string)) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.onConnected = onConnected
+	})
+}
+
+// WithMaxReconnects sets the maximum number of consecutive failed attempts to reconnect an event stream, after which
+// the stream is abandoned, an error is sent on the channel provided by StreamErrors and the function supplied with
+// WithOnStreamFailed is called.
+// A value of 0, the default, keeps reconnecting until the context is done.
+func WithMaxReconnects(maxReconnects int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.maxReconnects = maxReconnects
+	})
+}
+
+// WithOnStreamFailed sets a function to be called when an event stream is abandoned.
+// It is supplied with the address of the node, the endpoint of the stream and the final error.
+func WithOnStreamFailed(onStreamFailed func(backend string, endpoint string, err error)) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.onStreamFailed = onStreamFailed
+	})
+}
+
+// WithEventBufferSize sets the maximum size in bytes of a single message in an event stream.
+// Messages larger than this cause the stream to fail and reconnect.  The default is 16MiB.
+func WithEventBufferSize(size int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.eventBufferSize = size
+	})
+}
+
+// WithEventHistory retains the most recent n events for each topic, and replays them to handlers when they
+// subscribe with Events.  This allows components that start after events have been received to obtain, for
+// example, the latest head without waiting for the next one.
+// A value of 0, the default, disables the history.
+func WithEventHistory(n int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.eventHistory = n
+	})
+}
+
+// WithAPIVersion forces the version of the API used for endpoints that are available in multiple versions,
+// for example "v1" or "v2".  If not supplied the version is negotiated with the node.
+func WithAPIVersion(version string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.apiVersion = version
+	})
+}
+
+// WithDutiesCacheTTL enables caching of attester duties for the given duration.
+// Duties for an epoch are served from the cache until they expire.  They are discarded earlier if a change of
+// dependent root for the epoch is noticed by a request for uncached validators, by DutiesDependentRoot or by the
+// duties refresh enabled with WithDutiesRefresh; callers that must react promptly to reorgs should use one of these.
+// A duration of 0, the default, disables the cache.
+func WithDutiesCacheTTL(ttl time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.dutiesCacheTTL = ttl
+	})
+}
+
+// WithDutiesRefresh keeps proposer duties, and attester duties for validators previously requested, for the current
+// and next epochs in the cache, refreshing them in the background at the start of each epoch and when the dependent
+// roots for duties change.  This requires the duties cache to be enabled with WithDutiesCacheTTL.
+func WithDutiesRefresh(refresh bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.dutiesRefresh = refresh
+	})
+}
+
+// WithStrictJSON rejects responses containing fields that are not understood by the client.
+// This is useful for catching changes in the schema of node responses, but as it breaks forward
+// compatibility it should not generally be enabled in production.
+func WithStrictJSON(strict bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.strictJSON = strict
+	})
+}
+
+// WithFinalizedOnly adds the finalized query parameter to requests for state information, for nodes that support it
+// to return only finalized data.  This avoids ingesting data that could be reorganised.
+// Nodes that do not support the parameter ignore it, so callers that require finalized data should also request
+// finalized states.
+func WithFinalizedOnly(finalizedOnly bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.finalizedOnly = finalizedOnly
+	})
+}
+
+// WithSubmissionValidation checks submissions on the client before sending them to the node.
+// Attestations are rejected if their slot is more than ATTESTATION_PROPAGATION_SLOT_RANGE slots
+// behind the current slot, as the node would not propagate them.
+func WithSubmissionValidation(validate bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.submissionValidation = validate
+	})
+}
+
+// WithSubmissionTracking records attestations and blocks submitted through the client, and warns if a validator
+// submits conflicting data for a slot for which it has already submitted.
+// This is an aid to debugging, and is not a replacement for slashing protection.
+func WithSubmissionTracking(track bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.submissionTracking = track
+	})
+}
+
+// WithStrictSubmissionTracking rejects conflicting submissions with an error rather than warning about them.
+// This requires submission tracking to be enabled with WithSubmissionTracking.
+func WithStrictSubmissionTracking(strict bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.strictSubmissionTracking = strict
+	})
+}
+
+// WithRequestCoalescing shares a single call to the node between concurrent identical GET requests.
+// This reduces load on the node when many callers request the same information at the same time, for
+// example attestation data at the start of a slot.  Requests are only shared between callers sending the same
+// headers.  The shared call is bounded by the service's timeout, and each caller stops waiting for it when its own
+// context is done.
+func WithRequestCoalescing(coalescing bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.requestCoalescing = coalescing
+	})
+}
+
+// WithResponseCacheSize enables caching of up to the given number of responses for blocks and states requested by root.
+// Responses for aliases such as "head", or for slots, are never cached as they can change.  Metadata in cached
+// responses, such as whether the block is finalized, is as at the time of the original request.
+// A size of 0, the default, disables the cache.
+func WithResponseCacheSize(size int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.responseCacheSize = size
+	})
+}
+
+// WithValidatorsChunkSize sets the maximum number of validators requested in a single call when fetching validators
+// by index.  Larger requests are split into multiple calls and the results merged.
+func WithValidatorsChunkSize(size int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.validatorsChunkSize = size
+	})
+}
+
+// WithFastValidatorsParsing parses validators responses with a hand-written parser rather than the reflection-based
+// standard decoder, which is significantly faster for large validator sets.  Responses that the parser does not handle
+// fall back to the standard decoder, so results are the same either way.  This has no effect if strict JSON is enabled.
+func WithFastValidatorsParsing(fast bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.fastValidatorsParsing = fast
+	})
+}
+
 // parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
 func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	parameters := parameters{
-		logLevel: zerolog.GlobalLevel(),
-		timeout:  2 * time.Second,
+		logLevel:          zerolog.GlobalLevel(),
+		timeout:           2 * time.Second,
+		connectionTimeout: 30 * time.Second,
+		maxResponseBytes:  256 * 1024 * 1024,
+		retryJitter:       true,
+		sszFallback:       true,
+
+		validatorsChunkSize: 1000,
+
+		eventBufferSize: 16 * 1024 * 1024,
+
+		extraHeaders: make(map[string]string),
 	}
 	for _, p := range params {
 		if params != nil {
@@ -73,9 +408,48 @@ func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	if parameters.address == "" {
 		return nil, errors.New("no address specified")
 	}
+	if parameters.connectionTimeout <= 0 {
+		return nil, errors.New("no connection timeout specified")
+	}
+	if parameters.maxResponseBytes <= 0 {
+		return nil, errors.New("no maximum response size specified")
+	}
 	if parameters.timeout == 0 {
 		return nil, errors.New("no timeout specified")
 	}
+	if _, exists := parameters.extraHeaders[""]; exists {
+		return nil, errors.New("empty header name specified")
+	}
+	if parameters.networkCheckInterval < 0 {
+		return nil, errors.New("invalid network check interval specified")
+	}
+	if parameters.dutiesCacheTTL < 0 {
+		return nil, errors.New("invalid duties cache TTL specified")
+	}
+	if parameters.dutiesRefresh && parameters.dutiesCacheTTL == 0 {
+		return nil, errors.New("duties refresh requires a duties cache TTL")
+	}
+	if parameters.strictSubmissionTracking && !parameters.submissionTracking {
+		return nil, errors.New("strict submission tracking requires submission tracking")
+	}
+	if parameters.responseCacheSize < 0 {
+		return nil, errors.New("invalid response cache size specified")
+	}
+	if parameters.maxReconnects < 0 {
+		return nil, errors.New("invalid maximum reconnects specified")
+	}
+	if parameters.eventBufferSize <= 0 {
+		return nil, errors.New("no event buffer size specified")
+	}
+	if parameters.eventHistory < 0 {
+		return nil, errors.New("invalid event history size specified")
+	}
+	if parameters.validatorsChunkSize <= 0 {
+		return nil, errors.New("no validators chunk size specified")
+	}
+	if parameters.apiVersion != "" && parameters.apiVersion != "v1" && parameters.apiVersion != "v2" {
+		return nil, errors.New("invalid API version specified")
+	}
 
 	return &parameters, nil
 }
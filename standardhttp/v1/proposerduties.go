@@ -15,7 +15,6 @@ package v1
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	api "github.com/attestantio/go-eth2-client/api/v1"
@@ -24,12 +23,52 @@ import (
 )
 
 type proposerDutiesJSON struct {
-	Data []*api.ProposerDuty `json:"data"`
+	DependentRoot       string              `json:"dependent_root"`
+	ExecutionOptimistic bool                `json:"execution_optimistic"`
+	Data                []*api.ProposerDuty `json:"data"`
 }
 
 // ProposerDuties obtains proposer duties for the given epoch.
 // If validators is empty all duties are returned, otherwise only matching duties are returned.
+// If duties refresh is enabled, duties for the current and next epochs are served from the cache.
 func (s *Service) ProposerDuties(ctx context.Context, epoch spec.Epoch, validatorIndices []spec.ValidatorIndex) ([]*api.ProposerDuty, error) {
+	var allDuties []*api.ProposerDuty
+	if s.dutiesRefresh {
+		var err error
+		allDuties, err = s.cachedProposerDuties(ctx, epoch)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		resp, err := s.proposerDuties(ctx, epoch)
+		if err != nil {
+			return nil, err
+		}
+		allDuties = resp.Data
+	}
+
+	if len(validatorIndices) == 0 {
+		// Return all duties.
+		return allDuties, nil
+	}
+
+	// Filter duties based on supplied validators.
+	validatorIndexMap := make(map[spec.ValidatorIndex]bool, len(validatorIndices))
+	for _, index := range validatorIndices {
+		validatorIndexMap[index] = true
+	}
+	duties := make([]*api.ProposerDuty, 0, len(allDuties))
+	for _, duty := range allDuties {
+		if _, exists := validatorIndexMap[duty.ValidatorIndex]; exists {
+			duties = append(duties, duty)
+		}
+	}
+
+	return duties, nil
+}
+
+// proposerDuties obtains the full proposer duties response.
+func (s *Service) proposerDuties(ctx context.Context, epoch spec.Epoch) (*proposerDutiesJSON, error) {
 	respBodyReader, err := s.get(ctx, fmt.Sprintf("/eth/v1/validator/duties/proposer/%d", epoch))
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to request proposer duties")
@@ -39,9 +78,12 @@ func (s *Service) ProposerDuties(ctx context.Context, epoch spec.Epoch, validato
 	}
 
 	var resp proposerDutiesJSON
-	if err := json.NewDecoder(respBodyReader).Decode(&resp); err != nil {
+	if err := s.decodeJSON(respBodyReader, &resp); err != nil {
 		return nil, errors.Wrap(err, "failed to parse proposer duties response")
 	}
+	for _, duty := range resp.Data {
+		duty.ExecutionOptimistic = resp.ExecutionOptimistic
+	}
 
 	// Validate the duties.
 	slotsPerEpoch, err := s.SlotsPerEpoch(ctx)
@@ -56,22 +98,5 @@ func (s *Service) ProposerDuties(ctx context.Context, epoch spec.Epoch, validato
 		}
 	}
 
-	if len(validatorIndices) == 0 {
-		// Return all duties.
-		return resp.Data, nil
-	}
-
-	// Filter duties based on supplied validators.
-	validatorIndexMap := make(map[spec.ValidatorIndex]bool, len(validatorIndices))
-	for _, index := range validatorIndices {
-		validatorIndexMap[index] = true
-	}
-	duties := make([]*api.ProposerDuty, 0, len(resp.Data))
-	for _, duty := range resp.Data {
-		if _, exists := validatorIndexMap[duty.ValidatorIndex]; exists {
-			duties = append(duties, duty)
-		}
-	}
-
-	return duties, nil
+	return &resp, nil
 }
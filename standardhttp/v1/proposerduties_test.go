@@ -26,6 +26,8 @@ import (
 )
 
 func TestProposerDuties(t *testing.T) {
+	skipWithoutNode(t)
+
 	tests := []struct {
 		name             string
 		epoch            int64 // -1 for current
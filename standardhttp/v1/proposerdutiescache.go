@@ -0,0 +1,79 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+	"time"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// proposerDutiesCacheEntry holds the proposer duties obtained for an epoch.
+type proposerDutiesCacheEntry struct {
+	dependentRoot string
+	expiry        time.Time
+	duties        []*api.ProposerDuty
+}
+
+// cachedProposerDuties obtains all proposer duties for an epoch, serving them from the cache if present.
+func (s *Service) cachedProposerDuties(ctx context.Context, epoch spec.Epoch) ([]*api.ProposerDuty, error) {
+	s.proposerDutiesCacheMutex.Lock()
+	entry, exists := s.proposerDutiesCache[epoch]
+	s.proposerDutiesCacheMutex.Unlock()
+	var cachedDuties []*api.ProposerDuty
+	if exists && time.Now().Before(entry.expiry) {
+		cachedDuties = entry.duties
+	} else {
+		var err error
+		cachedDuties, err = s.refreshProposerDuties(ctx, epoch)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	duties := make([]*api.ProposerDuty, 0, len(cachedDuties))
+	for _, duty := range cachedDuties {
+		// Copy the duty, so that callers cannot alter the cache.
+		dutyCopy := *duty
+		duties = append(duties, &dutyCopy)
+	}
+
+	return duties, nil
+}
+
+// refreshProposerDuties obtains all proposer duties for an epoch from the node and stores them in the cache.
+func (s *Service) refreshProposerDuties(ctx context.Context, epoch spec.Epoch) ([]*api.ProposerDuty, error) {
+	resp, err := s.proposerDuties(ctx, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	s.proposerDutiesCacheMutex.Lock()
+	defer s.proposerDutiesCacheMutex.Unlock()
+	now := time.Now()
+	for cachedEpoch, entry := range s.proposerDutiesCache {
+		if now.After(entry.expiry) {
+			delete(s.proposerDutiesCache, cachedEpoch)
+		}
+	}
+	s.proposerDutiesCache[epoch] = &proposerDutiesCacheEntry{
+		dependentRoot: resp.DependentRoot,
+		expiry:        now.Add(s.dutiesCacheTTL),
+		duties:        resp.Data,
+	}
+
+	return resp.Data, nil
+}
@@ -0,0 +1,48 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	client "github.com/attestantio/go-eth2-client"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// ProposerForSlot provides the index of the validator assigned to propose at the given slot.
+// N.B if the node does not return a duty for the slot this will return an error that wraps client.ErrNotFound.
+func (s *Service) ProposerForSlot(ctx context.Context, slot spec.Slot) (spec.ValidatorIndex, error) {
+	slotsPerEpoch, err := s.SlotsPerEpoch(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to obtain slots per epoch")
+	}
+	if slotsPerEpoch == 0 {
+		return 0, errors.New("slots per epoch of 0")
+	}
+	epoch := spec.Epoch(uint64(slot) / slotsPerEpoch)
+
+	duties, err := s.ProposerDuties(ctx, epoch, nil)
+	if err != nil {
+		return 0, err
+	}
+	for _, duty := range duties {
+		if duty.Slot == slot {
+			return duty.ValidatorIndex, nil
+		}
+	}
+
+	return 0, errors.Wrap(client.ErrNotFound, fmt.Sprintf("no proposer duty for slot %d", slot))
+}
@@ -0,0 +1,90 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"context"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProposerForSlot(t *testing.T) {
+	server := newTestServer(t, map[string]string{
+		"/eth/v1/validator/duties/proposer/1": `{"data":[{"pubkey":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f","slot":"32","validator_index":"5"},{"pubkey":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f","slot":"33","validator_index":"7"}]}`,
+	})
+	service, err := standardhttp.New(context.Background(),
+		standardhttp.WithTimeout(timeout),
+		standardhttp.WithAddress(server.URL),
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		slot     spec.Slot
+		expected spec.ValidatorIndex
+		err      string
+	}{
+		{
+			name:     "FirstSlot",
+			slot:     32,
+			expected: 5,
+		},
+		{
+			name:     "SecondSlot",
+			slot:     33,
+			expected: 7,
+		},
+		{
+			name: "NoDuty",
+			slot: 34,
+			err:  "no proposer duty for slot 34: not found",
+		},
+		{
+			name: "NoDuties",
+			slot: 64,
+			err:  "failed to obtain proposer duties",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			index, err := service.ProposerForSlot(context.Background(), test.slot)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expected, index)
+		})
+	}
+}
+
+func TestProposerForSlotNotFound(t *testing.T) {
+	server := newTestServer(t, map[string]string{
+		"/eth/v1/validator/duties/proposer/0": `{"data":[]}`,
+	})
+	service, err := standardhttp.New(context.Background(),
+		standardhttp.WithTimeout(timeout),
+		standardhttp.WithAddress(server.URL),
+	)
+	require.NoError(t, err)
+
+	_, err = service.ProposerForSlot(context.Background(), 1)
+	require.True(t, errors.Is(err, client.ErrNotFound))
+}
@@ -0,0 +1,45 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"regexp"
+
+	client "github.com/attestantio/go-eth2-client"
+)
+
+// stateIDEndpoint obtains the state ID from an endpoint that fetches information from a state.
+var stateIDEndpoint = regexp.MustCompile(`^/eth/v[0-9]+/(?:debug/)?beacon/states/([^/?]+)`)
+
+// prunedStateMessage matches the messages with which nodes report that a state is unavailable because it has been
+// pruned, for example "historical state is pruned", "state not available" or "requires an archive node".
+var prunedStateMessage = regexp.MustCompile(`(?i)(prun|historic(al)? state|state (is )?(not available|unavailable)|archiv)`)
+
+// prunedStateError returns a client.StatePrunedError if the error response from a state endpoint reports that the
+// state has been pruned, otherwise nil.
+func prunedStateError(endpoint string, body []byte) error {
+	match := stateIDEndpoint.FindStringSubmatch(endpoint)
+	if match == nil {
+		return nil
+	}
+	_, message := parseErrorBody(body)
+	if !prunedStateMessage.MatchString(message) {
+		return nil
+	}
+
+	return &client.StatePrunedError{
+		StateID: match[1],
+		Message: message,
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
+	"github.com/stretchr/testify/require"
+)
+
+// respondWithError returns a handler that responds with the supplied status code and JSON.
+func respondWithError(statusCode int, response string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		_, _ = w.Write([]byte(response))
+	}
+}
+
+func TestStatePruned(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		handler  http.HandlerFunc
+		pruned   string
+		notFound bool
+		err      string
+	}{
+		{
+			name:    "PrunedNotFound",
+			handler: respondWithError(http.StatusNotFound, `{"code":404,"message":"NOT_FOUND: beacon state at slot 1000 has been pruned"}`),
+			pruned:  "NOT_FOUND: beacon state at slot 1000 has been pruned",
+		},
+		{
+			name:    "HistoricalStateNotFound",
+			handler: respondWithError(http.StatusNotFound, `{"code":404,"message":"Historical state not available"}`),
+			pruned:  "Historical state not available",
+		},
+		{
+			name:    "ArchiveServerError",
+			handler: respondWithError(http.StatusInternalServerError, `{"code":500,"message":"State requires an archive node"}`),
+			pruned:  "State requires an archive node",
+		},
+		{
+			name:     "NotFound",
+			handler:  respondWithError(http.StatusNotFound, `{"code":404,"message":"State not found"}`),
+			notFound: true,
+		},
+		{
+			name:    "ServerError",
+			handler: respondWithError(http.StatusInternalServerError, `{"code":500,"message":"Internal error"}`),
+			err:     "failed to request state root: GET failed with status 500: Internal error",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := newTestServerWithHandlers(t, map[string]http.HandlerFunc{
+				"/eth/v1/beacon/states/1000/root": test.handler,
+			})
+			service, err := standardhttp.New(ctx,
+				standardhttp.WithTimeout(timeout),
+				standardhttp.WithAddress(server.URL),
+			)
+			require.NoError(t, err)
+
+			_, err = service.StateRoot(ctx, "1000")
+			require.Error(t, err)
+			var prunedErr *client.StatePrunedError
+			switch {
+			case test.pruned != "":
+				require.True(t, errors.As(err, &prunedErr))
+				require.Equal(t, "1000", prunedErr.StateID)
+				require.Equal(t, test.pruned, prunedErr.Message)
+				require.False(t, errors.Is(err, client.ErrNotFound))
+			case test.notFound:
+				require.True(t, errors.Is(err, client.ErrNotFound))
+				require.False(t, errors.As(err, &prunedErr))
+			default:
+				require.EqualError(t, err, test.err)
+				require.False(t, errors.As(err, &prunedErr))
+			}
+		})
+	}
+}
@@ -0,0 +1,32 @@
+// Copyright © 2020 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"fmt"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// checkPubKeys ensures that the supplied public keys are plausible, to catch caller errors
+// before they are sent to the node.
+func checkPubKeys(pubKeys []spec.BLSPubKey) error {
+	var zeroPubKey spec.BLSPubKey
+	for i := range pubKeys {
+		if pubKeys[i] == zeroPubKey {
+			return fmt.Errorf("public key %d is zero", i)
+		}
+	}
+	return nil
+}
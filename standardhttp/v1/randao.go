@@ -0,0 +1,69 @@
+// Copyright © 2020 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	client "github.com/attestantio/go-eth2-client"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+type randaoJSON struct {
+	Data *randaoDataJSON `json:"data"`
+}
+
+type randaoDataJSON struct {
+	RANDAO string `json:"randao"`
+}
+
+// RANDAO provides the RANDAO mix for an epoch given a state ID.
+// If epoch is nil the RANDAO mix for the epoch of the state is returned.
+// N.B if the requested state is not available this will return an error that wraps client.ErrNotFound.
+func (s *Service) RANDAO(ctx context.Context, stateID string, epoch *spec.Epoch) (spec.Root, error) {
+	if stateID == "" {
+		return spec.Root{}, errors.New("no state ID specified")
+	}
+
+	url := fmt.Sprintf("/eth/v1/beacon/states/%s/randao", stateID)
+	if epoch != nil {
+		url = fmt.Sprintf("%s?epoch=%d", url, *epoch)
+	}
+	respBodyReader, err := s.get(ctx, url)
+	if err != nil {
+		return spec.Root{}, errors.Wrap(err, "failed to request RANDAO")
+	}
+	if respBodyReader == nil {
+		return spec.Root{}, errors.Wrap(client.ErrNotFound, "failed to obtain RANDAO")
+	}
+
+	var resp randaoJSON
+	if err := s.decodeJSON(respBodyReader, &resp); err != nil {
+		return spec.Root{}, errors.Wrap(err, "failed to parse RANDAO")
+	}
+	if resp.Data == nil || resp.Data.RANDAO == "" {
+		return spec.Root{}, errors.New("RANDAO missing")
+	}
+
+	data, err := parseHex(resp.Data.RANDAO, spec.RootLength)
+	if err != nil {
+		return spec.Root{}, errors.Wrap(err, "failed to parse RANDAO value")
+	}
+
+	var randao spec.Root
+	copy(randao[:], data)
+	return randao, nil
+}
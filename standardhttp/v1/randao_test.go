@@ -0,0 +1,88 @@
+// Copyright © 2020 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRANDAO(t *testing.T) {
+	server := newTestServerWithHandlers(t, map[string]http.HandlerFunc{
+		"/eth/v1/beacon/states/head/randao": func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Query().Get("epoch") {
+			case "":
+				respondWith(`{"data":{"randao":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f"}}`)(w, r)
+			case "10":
+				respondWith(`{"data":{"randao":"0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f"}}`)(w, r)
+			case "11":
+				respondWith(`{"data":{"randao":"0x2021"}}`)(w, r)
+			default:
+				respondWith(`{"data":{}}`)(w, r)
+			}
+		},
+	})
+	service, err := standardhttp.New(context.Background(),
+		standardhttp.WithTimeout(timeout),
+		standardhttp.WithAddress(server.URL),
+	)
+	require.NoError(t, err)
+
+	epoch := func(e spec.Epoch) *spec.Epoch { return &e }
+
+	tests := []struct {
+		name   string
+		epoch  *spec.Epoch
+		randao string
+		err    string
+	}{
+		{
+			name:   "StateEpoch",
+			randao: "0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f",
+		},
+		{
+			name:   "Epoch",
+			epoch:  epoch(10),
+			randao: "0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f",
+		},
+		{
+			name:  "Short",
+			epoch: epoch(11),
+			err:   "failed to parse RANDAO value: incorrect length 2",
+		},
+		{
+			name:  "Missing",
+			epoch: epoch(12),
+			err:   "RANDAO missing",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			randao, err := service.RANDAO(context.Background(), "head", test.epoch)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.randao, fmt.Sprintf("%#x", randao))
+		})
+	}
+}
@@ -21,5 +21,5 @@ import (
 
 // RANDAODomain provides the RANDAO domain of the chain.
 func (s *Service) RANDAODomain(ctx context.Context) (spec.DomainType, error) {
-	return s.spec["DOMAIN_RANDAO"].(spec.DomainType), nil
+	return s.chainConfig.DomainRANDAO, nil
 }
@@ -0,0 +1,84 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+
+	client "github.com/attestantio/go-eth2-client"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// ResolveValidatorIndices resolves validator public keys to indices for a given state.
+// stateID can be a slot number or state root, or one of the special values "genesis", "head", "justified" or "finalized".
+// Public keys are resolved in as few calls as possible, and the results cached.  As the index of a validator never
+// changes once assigned, cached indices are returned regardless of the state requested.
+// If any of the public keys are unknown the indices of the known validators are returned along with an error
+// that wraps client.UnknownValidatorsError.
+func (s *Service) ResolveValidatorIndices(ctx context.Context, stateID string, pubKeys []spec.BLSPubKey) (map[spec.BLSPubKey]spec.ValidatorIndex, error) {
+	if stateID == "" {
+		return nil, errors.New("no state ID specified")
+	}
+
+	res := make(map[spec.BLSPubKey]spec.ValidatorIndex, len(pubKeys))
+	unresolved := make([]spec.BLSPubKey, 0)
+	seen := make(map[spec.BLSPubKey]bool, len(pubKeys))
+	s.validatorIndicesMutex.RLock()
+	for _, pubKey := range pubKeys {
+		if index, exists := s.validatorIndices[pubKey]; exists {
+			res[pubKey] = index
+		} else if !seen[pubKey] {
+			unresolved = append(unresolved, pubKey)
+		}
+		seen[pubKey] = true
+	}
+	s.validatorIndicesMutex.RUnlock()
+
+	for start := 0; start < len(unresolved); start += s.validatorsChunkSize {
+		end := start + s.validatorsChunkSize
+		if end > len(unresolved) {
+			end = len(unresolved)
+		}
+		validators, err := s.ValidatorsByPubKey(ctx, stateID, unresolved[start:end])
+		if err != nil {
+			if errors.Is(err, client.ErrNotFound) {
+				// The state is unknown, rather than the validators.
+				return nil, err
+			}
+			return nil, errors.Wrapf(err, "failed to obtain validators %d to %d", start, end-1)
+		}
+		s.validatorIndicesMutex.Lock()
+		for _, validator := range validators {
+			if validator.Validator == nil {
+				continue
+			}
+			res[validator.Validator.PublicKey] = validator.Index
+			s.validatorIndices[validator.Validator.PublicKey] = validator.Index
+		}
+		s.validatorIndicesMutex.Unlock()
+	}
+
+	unknown := make([]spec.BLSPubKey, 0)
+	for _, pubKey := range unresolved {
+		if _, exists := res[pubKey]; !exists {
+			unknown = append(unknown, pubKey)
+		}
+	}
+	if len(unknown) > 0 {
+		return res, errors.Wrap(&client.UnknownValidatorsError{PubKeys: unknown}, "failed to resolve all validator indices")
+	}
+
+	return res, nil
+}
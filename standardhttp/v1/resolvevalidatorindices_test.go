@@ -0,0 +1,90 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveValidatorIndices(t *testing.T) {
+	validatorJSON := `{"index":"%d","balance":"32000000000","status":"active_ongoing","validator":{"pubkey":"%s","withdrawal_credentials":"0x0000000000000000000000000000000000000000000000000000000000000000","effective_balance":"32000000000","slashed":false,"activation_eligibility_epoch":"0","activation_epoch":"0","exit_epoch":"18446744073709551615","withdrawable_epoch":"18446744073709551615"}}`
+	pubKey := func(b byte) spec.BLSPubKey {
+		var res spec.BLSPubKey
+		res[0] = 0xa0
+		res[47] = b
+		return res
+	}
+	known := map[string]int{
+		fmt.Sprintf("%#x", pubKey(1)): 10,
+		fmt.Sprintf("%#x", pubKey(2)): 20,
+		fmt.Sprintf("%#x", pubKey(3)): 30,
+	}
+	requests := make([][]string, 0)
+	server := newTestServerWithHandlers(t, map[string]http.HandlerFunc{
+		"/eth/v1/beacon/states/head/validators": func(w http.ResponseWriter, r *http.Request) {
+			ids := r.URL.Query()["id"]
+			requests = append(requests, ids)
+			validators := make([]string, 0, len(ids))
+			for _, id := range ids {
+				if index, exists := known[id]; exists {
+					validators = append(validators, fmt.Sprintf(validatorJSON, index, id))
+				}
+			}
+			respondWith(fmt.Sprintf(`{"data":[%s]}`, strings.Join(validators, ",")))(w, r)
+		},
+	})
+	service, err := standardhttp.New(context.Background(),
+		standardhttp.WithTimeout(timeout),
+		standardhttp.WithAddress(server.URL),
+		standardhttp.WithValidatorsChunkSize(2),
+	)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	_, err = service.ResolveValidatorIndices(ctx, "", []spec.BLSPubKey{pubKey(1)})
+	require.EqualError(t, err, "no state ID specified")
+
+	// Resolve known validators in chunks.
+	indices, err := service.ResolveValidatorIndices(ctx, "head", []spec.BLSPubKey{pubKey(1), pubKey(2), pubKey(3), pubKey(1)})
+	require.NoError(t, err)
+	require.Equal(t, map[spec.BLSPubKey]spec.ValidatorIndex{
+		pubKey(1): 10,
+		pubKey(2): 20,
+		pubKey(3): 30,
+	}, indices)
+	require.Len(t, requests, 2)
+
+	// Cached validators do not require a call; unknown validators are reported.
+	indices, err = service.ResolveValidatorIndices(ctx, "head", []spec.BLSPubKey{pubKey(2), pubKey(4)})
+	require.Equal(t, map[spec.BLSPubKey]spec.ValidatorIndex{
+		pubKey(2): 20,
+	}, indices)
+	require.True(t, errors.Is(err, client.ErrNotFound))
+	var unknownErr *client.UnknownValidatorsError
+	require.True(t, errors.As(err, &unknownErr))
+	require.Equal(t, []spec.BLSPubKey{pubKey(4)}, unknownErr.PubKeys)
+	require.EqualError(t, err, fmt.Sprintf("failed to resolve all validator indices: 1 unknown validators: %#x", pubKey(4)))
+	require.Len(t, requests, 3)
+	require.Equal(t, []string{fmt.Sprintf("%#x", pubKey(4))}, requests[2])
+}
@@ -0,0 +1,78 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"container/list"
+	"regexp"
+	"sync"
+)
+
+// cacheableEndpoint matches endpoints that fetch a block or state by root.  The responses from these
+// never change, unlike those fetched by aliases such as "head" or by slot.
+var cacheableEndpoint = regexp.MustCompile(`^/eth/v[0-9]+/(debug/)?beacon/(blocks|states)/0x[0-9a-fA-F]{64}$`)
+
+// responseCacheEntry is an entry in the response cache.
+type responseCacheEntry struct {
+	key  string
+	data []byte
+}
+
+// responseCache is a bounded cache of responses, evicting the least recently used entry when full.
+type responseCache struct {
+	mutex   sync.Mutex
+	size    int
+	entries map[string]*list.Element
+	order   *list.List
+}
+
+// newResponseCache creates a response cache holding up to size entries.
+func newResponseCache(size int) *responseCache {
+	return &responseCache{
+		size:    size,
+		entries: make(map[string]*list.Element, size),
+		order:   list.New(),
+	}
+}
+
+// get returns the cached response for the key, or nil if it is not present.
+func (c *responseCache) get(key string) []byte {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	element, exists := c.entries[key]
+	if !exists {
+		return nil
+	}
+	c.order.MoveToFront(element)
+	return element.Value.(*responseCacheEntry).data
+}
+
+// set caches the response for the key, evicting the least recently used entry if the cache is full.
+func (c *responseCache) set(key string, data []byte) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if element, exists := c.entries[key]; exists {
+		element.Value.(*responseCacheEntry).data = data
+		c.order.MoveToFront(element)
+		return
+	}
+	c.entries[key] = c.order.PushFront(&responseCacheEntry{key: key, data: data})
+	if c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*responseCacheEntry).key)
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResponseCache(t *testing.T) {
+	block := &spec.SignedBeaconBlock{
+		Message: &spec.BeaconBlock{
+			Slot:          1,
+			ProposerIndex: 2,
+			Body: &spec.BeaconBlockBody{
+				ETH1Data: &spec.ETH1Data{
+					BlockHash: make([]byte, 32),
+				},
+				Graffiti:          make([]byte, 32),
+				ProposerSlashings: []*spec.ProposerSlashing{},
+				AttesterSlashings: []*spec.AttesterSlashing{},
+				Attestations:      []*spec.Attestation{},
+				Deposits:          []*spec.Deposit{},
+				VoluntaryExits:    []*spec.SignedVoluntaryExit{},
+			},
+		},
+	}
+	blockJSON, err := json.Marshal(block)
+	require.NoError(t, err)
+
+	rootA := fmt.Sprintf("%#x", spec.Root{0x0a})
+	rootB := fmt.Sprintf("%#x", spec.Root{0x0b})
+
+	tests := []struct {
+		name      string
+		cacheSize int
+		blockIDs  []string
+		requests  int
+	}{
+		{
+			name:     "Disabled",
+			blockIDs: []string{rootA, rootA},
+			requests: 2,
+		},
+		{
+			name:      "Root",
+			cacheSize: 2,
+			blockIDs:  []string{rootA, rootA, rootB, rootB, rootA},
+			requests:  2,
+		},
+		{
+			name:      "Alias",
+			cacheSize: 2,
+			blockIDs:  []string{"head", "head"},
+			requests:  2,
+		},
+		{
+			name:      "Slot",
+			cacheSize: 2,
+			blockIDs:  []string{"1", "1"},
+			requests:  2,
+		},
+		{
+			name:      "Eviction",
+			cacheSize: 1,
+			blockIDs:  []string{rootA, rootB, rootA},
+			requests:  3,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			requests := 0
+			handlers := make(map[string]http.HandlerFunc)
+			for _, blockID := range test.blockIDs {
+				handlers[fmt.Sprintf("/eth/v2/beacon/blocks/%s", blockID)] = func(w http.ResponseWriter, r *http.Request) {
+					requests++
+					respondWith(fmt.Sprintf(`{"data":%s}`, string(blockJSON)))(w, r)
+				}
+			}
+			server := newTestServerWithHandlers(t, handlers)
+			service, err := standardhttp.New(context.Background(),
+				standardhttp.WithTimeout(timeout),
+				standardhttp.WithAddress(server.URL),
+				standardhttp.WithResponseCacheSize(test.cacheSize),
+			)
+			require.NoError(t, err)
+
+			for _, blockID := range test.blockIDs {
+				res, err := service.SignedBeaconBlock(context.Background(), blockID)
+				require.NoError(t, err)
+				require.Equal(t, spec.Slot(1), res.Message.Slot)
+			}
+			require.Equal(t, test.requests, requests)
+		})
+	}
+}
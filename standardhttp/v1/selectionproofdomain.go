@@ -21,5 +21,5 @@ import (
 
 // SelectionProofDomain provides the selection proof domain of the chain.
 func (s *Service) SelectionProofDomain(ctx context.Context) (spec.DomainType, error) {
-	return s.spec["DOMAIN_SELECTION_PROOF"].(spec.DomainType), nil
+	return s.chainConfig.DomainSelectionProof, nil
 }
@@ -0,0 +1,110 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+	"time"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/pkg/errors"
+)
+
+// selfTest is a single test carried out as part of a self-test.
+type selfTest struct {
+	name     string
+	endpoint string
+	run      func(ctx context.Context, s *Service) error
+}
+
+// selfTests are the tests carried out as part of a self-test, in order.
+var selfTests = []*selfTest{
+	{
+		name:     "genesis",
+		endpoint: "/eth/v1/beacon/genesis",
+		run: func(ctx context.Context, s *Service) error {
+			// Genesis is cached by the service, so go direct to the node.
+			respBodyReader, err := s.get(ctx, "/eth/v1/beacon/genesis")
+			if err != nil {
+				return err
+			}
+			if respBodyReader == nil {
+				return errors.New("genesis not returned")
+			}
+			var resp genesisJSON
+			if err := s.decodeJSON(respBodyReader, &resp); err != nil {
+				return errors.Wrap(err, "failed to parse genesis")
+			}
+			if resp.Data == nil {
+				return errors.New("genesis data missing")
+			}
+
+			return nil
+		},
+	},
+	{
+		name:     "syncing",
+		endpoint: "/eth/v1/node/syncing",
+		run: func(ctx context.Context, s *Service) error {
+			_, err := s.NodeSyncing(ctx)
+			return err
+		},
+	},
+	{
+		name:     "validators count",
+		endpoint: "/eth/v1/beacon/states/head/validators",
+		run: func(ctx context.Context, s *Service) error {
+			_, err := s.ActiveValidatorCount(ctx, "head")
+			return err
+		},
+	},
+	{
+		name:     "head",
+		endpoint: "/eth/v1/beacon/headers/head",
+		run: func(ctx context.Context, s *Service) error {
+			_, err := s.Head(ctx)
+			return err
+		},
+	},
+}
+
+// SelfTest exercises a representative set of endpoints on the node and reports the outcome of each.
+// A failure of an individual endpoint is recorded in the report rather than returned as an error, so
+// that the report gives a full picture of the state of the connection.
+func (s *Service) SelfTest(ctx context.Context) (*api.SelfTestReport, error) {
+	report := &api.SelfTestReport{
+		Results: make([]*api.SelfTestResult, 0, len(selfTests)),
+	}
+	for _, test := range selfTests {
+		if ctx.Err() != nil {
+			return nil, errors.Wrap(ctx.Err(), "self-test interrupted")
+		}
+		result := &api.SelfTestResult{
+			Name:     test.name,
+			Endpoint: test.endpoint,
+		}
+		started := time.Now()
+		err := test.run(ctx, s)
+		result.Latency = time.Since(started)
+		if err != nil {
+			result.Error = err.Error()
+		} else {
+			result.Success = true
+		}
+		s.log.Trace().Str("test", test.name).Dur("latency", result.Latency).Bool("success", result.Success).Msg("Self-test result")
+		report.Results = append(report.Results, result)
+	}
+
+	return report, nil
+}
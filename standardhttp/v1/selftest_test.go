@@ -0,0 +1,80 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"context"
+	"testing"
+
+	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelfTest(t *testing.T) {
+	tests := []struct {
+		name      string
+		responses map[string]string
+		failed    []string
+	}{
+		{
+			name: "Good",
+			responses: map[string]string{
+				"/eth/v1/node/syncing":                  `{"data":{"head_slot":"100","sync_distance":"0","is_syncing":false}}`,
+				"/eth/v1/beacon/states/head/validators": `{"data":[{"index":"1"},{"index":"2"}]}`,
+				"/eth/v1/beacon/headers/head":           `{"data":{"root":"0xbc354f1a5f27f8d096eee9e6b6139e1b730385f9752513832a57c9849a149df7","canonical":true,"header":{"message":{"slot":"100","proposer_index":"29787","parent_root":"0xba4d784293df28bab771a14df58cdbed9d8d64afd0ddf1c52dff3e25fcdd51df","state_root":"0x4e405274abd4f59c6a2268b4e6ca93dba01e15ae6b56401fb20a1ad9701b036d","body_root":"0x57bb79520694c132a35dc887cac2e4dad9acc5ded58b5ae66b491644ab8835c8"},"signature":"0xa8d684242ee025ee96e877b28433d93176072b8c8e8295609501863147bb1d174b8a16aed661d001f30859c9e42c0f9d18ea35786a9bdf115dff1877980046e19e0e4c9310e281f8129f2692ddc4680673ab78b7f8db72f91be7863dd9fe1e55"}}}`,
+			},
+		},
+		{
+			name: "HeadMissing",
+			responses: map[string]string{
+				"/eth/v1/node/syncing":                  `{"data":{"head_slot":"100","sync_distance":"0","is_syncing":false}}`,
+				"/eth/v1/beacon/states/head/validators": `{"data":[{"index":"1"},{"index":"2"}]}`,
+			},
+			failed: []string{"head"},
+		},
+		{
+			name:   "AllMissing",
+			failed: []string{"syncing", "validators count", "head"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := newTestServer(t, test.responses)
+			service, err := standardhttp.New(context.Background(),
+				standardhttp.WithTimeout(timeout),
+				standardhttp.WithAddress(server.URL),
+			)
+			require.NoError(t, err)
+
+			report, err := service.SelfTest(context.Background())
+			require.NoError(t, err)
+			require.Len(t, report.Results, 4)
+			failed := make([]string, 0)
+			for _, result := range report.Results {
+				if !result.Success {
+					require.NotEmpty(t, result.Error)
+					failed = append(failed, result.Name)
+				}
+			}
+			if len(test.failed) == 0 {
+				require.True(t, report.Success())
+				require.Empty(t, failed)
+			} else {
+				require.False(t, report.Success())
+				require.Equal(t, test.failed, failed)
+			}
+		})
+	}
+}
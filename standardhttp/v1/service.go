@@ -20,6 +20,8 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	api "github.com/attestantio/go-eth2-client/api/v1"
@@ -33,25 +35,119 @@ import (
 type Service struct {
 	// Hold the initialising context to use for streams.
 	ctx context.Context
+	// runCtx is done when either the initialising context is done or the service is closed.
+	runCtx context.Context
+	cancel context.CancelFunc
+	// log is the service's logger.  It is held per-service so that creating another service does not
+	// change the logger used by goroutines of this one.
+	log zerolog.Logger
+	// background tracks goroutines that run for the lifetime of the service.
+	background *supervisor
 
 	base    *url.URL
 	address string
 	client  *http.Client
 	timeout time.Duration
 
+	// extraHeaders are sent with every request.
+	extraHeaders map[string]string
+
+	// maxResponseBytes is the largest response body that will be read.
+	maxResponseBytes int64
+
+	// lenientIntegerParsing quotes unquoted integers in responses.
+	lenientIntegerParsing bool
+
+	// retryJitter randomises reconnection delays.
+	retryJitter bool
+	// backoff is the strategy for delays between reconnection attempts.
+	backoff Backoff
+
+	// preferSSZ requests SSZ responses, and sends SSZ submissions, where supported.
+	preferSSZ bool
+	// sszFallback retries with JSON if an SSZ response cannot be decoded or an SSZ submission is rejected.
+	sszFallback bool
+
+	// apiVersion is the forced version of versioned endpoints; if empty the version is negotiated.
+	apiVersion string
+	// negotiatedVersions are the versions of versioned endpoints supported by the node.
+	negotiatedVersions      map[string]string
+	negotiatedVersionsMutex sync.RWMutex
+
+	// dutiesCacheTTL is the duration for which attester duties are cached; if 0 they are not cached.
+	dutiesCacheTTL           time.Duration
+	attesterDutiesCache      map[spec.Epoch]*attesterDutiesCacheEntry
+	attesterDutiesCacheMutex sync.Mutex
+	// dutiesRefresh keeps duties for the current and next epochs in the cache.
+	dutiesRefresh            bool
+	proposerDutiesCache      map[spec.Epoch]*proposerDutiesCacheEntry
+	proposerDutiesCacheMutex sync.Mutex
+
+	// strictJSON rejects responses with unknown fields.
+	strictJSON bool
+
+	// submissionValidation checks submissions before sending them to the node.
+	submissionValidation bool
+	// submissionTracker detects conflicting submissions; if nil submissions are not tracked.
+	submissionTracker *submissionTracker
+
+	// finalizedOnly requests only finalized data from state endpoints.
+	finalizedOnly bool
+
+	// requestCoalescing shares calls between concurrent identical GET requests.
+	requestCoalescing bool
+	coalescedRequests requestCoalescer
+
+	// responseCache holds responses for blocks and states requested by root; if nil responses are not cached.
+	responseCache *responseCache
+
+	// validatorsChunkSize is the maximum number of validators requested by index in a single call.
+	validatorsChunkSize int
+	// fastValidatorsParsing parses validators responses by hand rather than with the standard decoder.
+	fastValidatorsParsing bool
+
+	// validatorIndices caches validator indices by public key; these never change once assigned.
+	validatorIndices      map[spec.BLSPubKey]spec.ValidatorIndex
+	validatorIndicesMutex sync.RWMutex
+
+	// validatorCountUnsupported is set to 1 once the node is found not to provide a validator count endpoint.
+	validatorCountUnsupported int32
+
+	// sharedSlotTicker is the slot ticker shared by callers waiting for slots, started on first use.
+	sharedSlotTicker      *sharedSlotTicker
+	sharedSlotTickerMutex sync.Mutex
+
+	// onReconnect is called on each event stream reconnection attempt.
+	onReconnect func(backend string, endpoint string, attempt int)
+	// onConnected is called on each successful event stream connection.
+	onConnected func(backend string, endpoint string)
+	// maxReconnects is the number of consecutive failed reconnection attempts before an event stream is
+	// abandoned; if 0 streams reconnect until their context is done.
+	maxReconnects int
+	// onStreamFailed is called when an event stream is abandoned.
+	onStreamFailed func(backend string, endpoint string, err error)
+	// streamErrors receives an error when an event stream is abandoned.
+	streamErrors chan error
+	// eventBufferSize is the maximum size of a single message in an event stream.
+	eventBufferSize int
+	// eventHistory retains recent events for replay to new subscribers; if nil events are not retained.
+	eventHistory *eventHistory
+
+	// networkChangedErr is set to the error to return for all calls once the node is found to be on a different network.
+	networkChangedErr atomic.Value
+
 	// Various information from the node that does not change during the
 	// lifetime of a beacon node.
 	genesis         *api.Genesis
 	spec            map[string]interface{}
+	chainConfig     *api.ChainConfig
 	depositContract *api.DepositContract
 	forkSchedule    []*spec.Fork
 	nodeVersion     string
 }
 
-// log is a service-wide logger.
-var log zerolog.Logger
-
 // New creates a new Ethereum 2 client service, connecting with a standard HTTP.
+// Cancelling the supplied context stops all background work, such as event streams, and closes the connection.
 func New(ctx context.Context, params ...Parameter) (*Service, error) {
 	parameters, err := parseAndCheckParameters(params...)
 	if err != nil {
@@ -59,24 +155,11 @@ func New(ctx context.Context, params ...Parameter) (*Service, error) {
 	}
 
 	// Set logging.
-	log = zerologger.With().Str("service", "client").Str("impl", "standardv1").Logger()
+	log := zerologger.With().Str("service", "client").Str("impl", "standardv1").Logger()
 	if parameters.logLevel != log.GetLevel() {
 		log = log.Level(parameters.logLevel)
 	}
 
-	client := &http.Client{
-		Transport: &http.Transport{
-			DialContext: (&net.Dialer{
-				Timeout:   30 * time.Second,
-				KeepAlive: 30 * time.Second,
-				DualStack: true,
-			}).DialContext,
-			MaxIdleConns:        64,
-			MaxIdleConnsPerHost: 64,
-			IdleConnTimeout:     384 * time.Second,
-		},
-	}
-
 	address := parameters.address
 	if !strings.HasPrefix(address, "http") {
 		address = fmt.Sprintf("http://%s", parameters.address)
@@ -86,25 +169,120 @@ func New(ctx context.Context, params ...Parameter) (*Service, error) {
 		return nil, errors.Wrap(err, "invalid URL")
 	}
 
+	dialer := &net.Dialer{
+		Timeout:   parameters.connectionTimeout,
+		KeepAlive: 30 * time.Second,
+		DualStack: true,
+	}
+	transport := &http.Transport{
+		DialContext:         dialer.DialContext,
+		MaxIdleConns:        64,
+		MaxIdleConnsPerHost: 64,
+		IdleConnTimeout:     384 * time.Second,
+		ForceAttemptHTTP2:   parameters.http2,
+	}
+	client := &http.Client{
+		Transport: transport,
+	}
+	if parameters.http2 && base.Scheme == "http" {
+		client.Transport = newH2CTransport(dialer, transport, log)
+	}
+	if parameters.transport != nil {
+		client.Transport = parameters.transport
+	}
+
+	runCtx, cancel := context.WithCancel(ctx)
 	s := &Service{
-		ctx:     ctx,
-		base:    base,
-		address: parameters.address,
-		client:  client,
-		timeout: parameters.timeout,
+		ctx:        ctx,
+		runCtx:     runCtx,
+		cancel:     cancel,
+		log:        log,
+		background: newSupervisor(log),
+		base:       base,
+		address:    parameters.address,
+		client:     client,
+		timeout:    parameters.timeout,
+
+		extraHeaders: parameters.extraHeaders,
+
+		maxResponseBytes: parameters.maxResponseBytes,
+
+		lenientIntegerParsing: parameters.lenientIntegerParsing,
+		retryJitter:           parameters.retryJitter,
+		backoff:               parameters.backoff,
+
+		preferSSZ:   parameters.preferSSZ,
+		sszFallback: parameters.sszFallback,
+
+		apiVersion:         parameters.apiVersion,
+		negotiatedVersions: make(map[string]string),
+
+		dutiesCacheTTL:      parameters.dutiesCacheTTL,
+		attesterDutiesCache: make(map[spec.Epoch]*attesterDutiesCacheEntry),
+		dutiesRefresh:       parameters.dutiesRefresh,
+		proposerDutiesCache: make(map[spec.Epoch]*proposerDutiesCacheEntry),
+
+		strictJSON: parameters.strictJSON,
+
+		submissionValidation: parameters.submissionValidation,
+
+		finalizedOnly: parameters.finalizedOnly,
+
+		requestCoalescing: parameters.requestCoalescing,
+
+		validatorsChunkSize:   parameters.validatorsChunkSize,
+		fastValidatorsParsing: parameters.fastValidatorsParsing,
+		validatorIndices:      make(map[spec.BLSPubKey]spec.ValidatorIndex),
+
+		onReconnect: parameters.onReconnect,
+		onConnected: parameters.onConnected,
+
+		maxReconnects:  parameters.maxReconnects,
+		onStreamFailed: parameters.onStreamFailed,
+		streamErrors:   make(chan error, streamErrorsBufferSize),
+
+		eventBufferSize: parameters.eventBufferSize,
+	}
+	if parameters.eventHistory > 0 {
+		s.eventHistory = newEventHistory(parameters.eventHistory)
+	}
+	if parameters.submissionTracking {
+		s.submissionTracker = newSubmissionTracker(parameters.strictSubmissionTracking, log)
+	}
+	if parameters.responseCacheSize > 0 {
+		s.responseCache = newResponseCache(parameters.responseCacheSize)
 	}
 
-	// Fetch static values to confirm the connection is good.
+	// Fetch static values to confirm the connection is good.  Each request is bounded by the request timeout, so
+	// an unresponsive node cannot block creation indefinitely even if the supplied context has no deadline.
 	if err := s.fetchStaticValues(ctx); err != nil {
+		cancel()
 		return nil, errors.Wrap(err, "failed to confirm node connection")
 	}
 
+	// Confirm the node is on the expected network.
+	if parameters.expectedGenesisValidatorsRoot != nil && s.genesis.GenesisValidatorsRoot != *parameters.expectedGenesisValidatorsRoot {
+		cancel()
+		return nil, fmt.Errorf("node genesis validators root %#x does not match expected %#x; wrong network?", s.genesis.GenesisValidatorsRoot, *parameters.expectedGenesisValidatorsRoot)
+	}
+
+	if parameters.networkCheckInterval > 0 {
+		s.background.run("network check", func() {
+			s.checkNetwork(s.runCtx, parameters.networkCheckInterval)
+		})
+	}
+	if s.dutiesRefresh {
+		s.background.run("duties refresh", func() {
+			s.refreshDuties(s.runCtx)
+		})
+	}
+
 	// Close the service on context done.
-	go func(s *Service) {
-		<-ctx.Done()
+	s.background.run("close", func() {
+		<-s.runCtx.Done()
 		log.Trace().Msg("Context done; closing connection")
 		s.close()
-	}(s)
+	})
 
 	return s, nil
 }
@@ -118,18 +296,28 @@ func (s *Service) fetchStaticValues(ctx context.Context) error {
 	if _, err := s.Spec(ctx); err != nil {
 		return errors.Wrap(err, "failed to fetch spec")
 	}
+	if _, err := s.ChainConfig(ctx); err != nil {
+		// The chain configuration is strictly parsed from the spec, so a node with unexpected
+		// values should not stop the service from starting; it will be retried when requested.
+		s.log.Warn().Err(err).Msg("Failed to obtain chain configuration")
+	}
 	if _, err := s.DepositContract(ctx); err != nil {
 		return errors.Wrap(err, "failed to fetch deposit contract")
 	}
-	// if _, err := s.ForkSchedule(ctx); err != nil {
-	// 	return errors.Wrap(err, "failed to fetch fork schedule")
-	// }
-	s.forkSchedule = []*spec.Fork{
-		{
-			PreviousVersion: spec.Version([4]byte{0x00, 0x00, 0x00, 0x01}),
-			CurrentVersion:  spec.Version([4]byte{0x00, 0x00, 0x00, 0x01}),
-			Epoch:           0,
-		},
+	if _, err := s.ForkSchedule(ctx); err != nil {
+		if ctx.Err() != nil || errors.Is(err, context.DeadlineExceeded) {
+			// The node did not respond in time, which is not the same as not providing the schedule.
+			return errors.Wrap(err, "failed to fetch fork schedule")
+		}
+		// Not all nodes provide the fork schedule, so fall back to a single fork.
+		s.log.Debug().Err(err).Msg("Failed to fetch fork schedule; using default")
+		s.forkSchedule = []*spec.Fork{
+			{
+				PreviousVersion: spec.Version([4]byte{0x00, 0x00, 0x00, 0x01}),
+				CurrentVersion:  spec.Version([4]byte{0x00, 0x00, 0x00, 0x01}),
+				Epoch:           0,
+			},
+		}
 	}
 
 	return nil
@@ -145,6 +333,24 @@ func (s *Service) Address() string {
 	return s.address
 }
 
+// Context provides a context that is done when the service is closed, either by Close or by the context supplied
+// on creation being done, so can be used to tie goroutines to the lifecycle of the service.
+func (s *Service) Context() context.Context {
+	return s.runCtx
+}
+
+// Close closes the service, stopping event streams and other background activity, and waits for background
+// goroutines to finish or for the supplied context to be done.
+// The service is also closed when the context supplied on creation is done.
+func (s *Service) Close(ctx context.Context) error {
+	s.cancel()
+	if err := s.background.wait(ctx); err != nil {
+		return errors.Wrap(err, "failed to wait for background goroutines")
+	}
+
+	return nil
+}
+
 // close closes the service, freeing up resources.
 func (s *Service) close() {
 }
@@ -15,17 +15,22 @@ package v1_test
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
 	"time"
 
 	client "github.com/attestantio/go-eth2-client"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 	v1 "github.com/attestantio/go-eth2-client/standardhttp/v1"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
 func TestService(t *testing.T) {
+	skipWithoutNode(t)
+
 	ctx := context.Background()
 
 	tests := []struct {
@@ -53,6 +58,24 @@ func TestService(t *testing.T) {
 			},
 			err: "problem with parameters: no timeout specified",
 		},
+		{
+			name: "ConnectionTimeoutZero",
+			parameters: []v1.Parameter{
+				v1.WithAddress(os.Getenv("HTTP_ADDRESS")),
+				v1.WithTimeout(5 * time.Second),
+				v1.WithConnectionTimeout(0),
+			},
+			err: "problem with parameters: no connection timeout specified",
+		},
+		{
+			name: "ConnectionTimeoutNegative",
+			parameters: []v1.Parameter{
+				v1.WithAddress(os.Getenv("HTTP_ADDRESS")),
+				v1.WithTimeout(5 * time.Second),
+				v1.WithConnectionTimeout(-1),
+			},
+			err: "problem with parameters: no connection timeout specified",
+		},
 		{
 			name: "AddressInvalid",
 			parameters: []v1.Parameter{
@@ -82,7 +105,48 @@ func TestService(t *testing.T) {
 	}
 }
 
+func TestExpectedGenesisValidatorsRoot(t *testing.T) {
+	ctx := context.Background()
+	server := newTestServer(t, nil)
+
+	tests := []struct {
+		name string
+		root spec.Root
+		err  string
+	}{
+		{
+			name: "Mismatch",
+			root: spec.Root{0x01},
+			err:  "node genesis validators root 0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95 does not match expected 0x0100000000000000000000000000000000000000000000000000000000000000; wrong network?",
+		},
+		{
+			name: "Good",
+			root: spec.Root{
+				0x4b, 0x36, 0x3d, 0xb9, 0x4e, 0x28, 0x61, 0x20, 0xd7, 0x6e, 0xb9, 0x05, 0x34, 0x0f, 0xdd, 0x4e,
+				0x54, 0xbf, 0xe9, 0xf0, 0x6b, 0xf3, 0x3f, 0xf6, 0xcf, 0x5a, 0xd2, 0x7f, 0x51, 0x1b, 0xfe, 0x95,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := v1.New(ctx,
+				v1.WithAddress(server.URL),
+				v1.WithTimeout(5*time.Second),
+				v1.WithExpectedGenesisValidatorsRoot(test.root),
+			)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestInterfaces(t *testing.T) {
+	skipWithoutNode(t)
+
 	ctx := context.Background()
 	s, err := v1.New(ctx, v1.WithAddress(os.Getenv("HTTP_ADDRESS")), v1.WithTimeout(5*time.Second))
 	require.NoError(t, err)
@@ -91,28 +155,113 @@ func TestInterfaces(t *testing.T) {
 	assert.Implements(t, (*client.AggregateAttestationProvider)(nil), s)
 	assert.Implements(t, (*client.AggregateAttestationsSubmitter)(nil), s)
 	assert.Implements(t, (*client.AttestationDataProvider)(nil), s)
+	assert.Implements(t, (*client.AttestationRewardsProvider)(nil), s)
 	assert.Implements(t, (*client.AttestationSubmitter)(nil), s)
 	assert.Implements(t, (*client.AttestationsSubmitter)(nil), s)
 	assert.Implements(t, (*client.AttesterDutiesProvider)(nil), s)
+	assert.Implements(t, (*client.BLSToExecutionChangesSubmitter)(nil), s)
 	assert.Implements(t, (*client.BeaconBlockHeadersProvider)(nil), s)
 	assert.Implements(t, (*client.BeaconBlockProposalProvider)(nil), s)
 	assert.Implements(t, (*client.BeaconBlockSubmitter)(nil), s)
 	assert.Implements(t, (*client.BeaconCommitteeSubscriptionsSubmitter)(nil), s)
 	assert.Implements(t, (*client.BeaconStateProvider)(nil), s)
+	assert.Implements(t, (*client.BlobSidecarsProvider)(nil), s)
+	assert.Implements(t, (*client.BlockRewardsProvider)(nil), s)
+	assert.Implements(t, (*client.DepositSnapshotProvider)(nil), s)
 	assert.Implements(t, (*client.EventsProvider)(nil), s)
+	assert.Implements(t, (*client.ExpectedWithdrawalsProvider)(nil), s)
 	assert.Implements(t, (*client.ForkProvider)(nil), s)
 	assert.Implements(t, (*client.ForkScheduleProvider)(nil), s)
 	assert.Implements(t, (*client.GenesisProvider)(nil), s)
+	assert.Implements(t, (*client.LightClientBootstrapProvider)(nil), s)
+	assert.Implements(t, (*client.LightClientUpdatesProvider)(nil), s)
 	assert.Implements(t, (*client.NodeSyncingProvider)(nil), s)
 	assert.Implements(t, (*client.ProposerDutiesProvider)(nil), s)
 	assert.Implements(t, (*client.SpecProvider)(nil), s)
+	assert.Implements(t, (*client.SyncCommitteeContributionsSubmitter)(nil), s)
+	assert.Implements(t, (*client.SyncCommitteeRewardsProvider)(nil), s)
+	assert.Implements(t, (*client.SyncCommitteeSubscriptionsSubmitter)(nil), s)
+	assert.Implements(t, (*client.SyncCommitteesProvider)(nil), s)
 	// assert.Implements(t, (*client.SyncStateProvider)(nil), s)
 	assert.Implements(t, (*client.ValidatorBalancesProvider)(nil), s)
+	assert.Implements(t, (*client.ValidatorLivenessProvider)(nil), s)
 	assert.Implements(t, (*client.ValidatorsProvider)(nil), s)
+	assert.Implements(t, (*client.ValidatorRegistrationsSubmitter)(nil), s)
 	assert.Implements(t, (*client.VoluntaryExitSubmitter)(nil), s)
 
 	// Non-standard extensions.
+	assert.Implements(t, (*client.ActiveValidatorCountProvider)(nil), s)
+	assert.Implements(t, (*client.BeaconBlockProposalV2Provider)(nil), s)
+	assert.Implements(t, (*client.BeaconStateWithMetadataProvider)(nil), s)
+	assert.Implements(t, (*client.BeaconStateWithRawProvider)(nil), s)
+	assert.Implements(t, (*client.BlockWithdrawalsProvider)(nil), s)
+	assert.Implements(t, (*client.ChainConfigProvider)(nil), s)
+	assert.Implements(t, (*client.ClockSyncChecker)(nil), s)
+	assert.Implements(t, (*client.CommitteeAssignmentComputer)(nil), s)
+	assert.Implements(t, (*client.CommitteeCountProvider)(nil), s)
+	assert.Implements(t, (*client.CommitteesPerSlotProvider)(nil), s)
 	assert.Implements(t, (*client.DomainProvider)(nil), s)
+	assert.Implements(t, (*client.DutiesForEpochsProvider)(nil), s)
+	assert.Implements(t, (*client.DutiesValidityProvider)(nil), s)
+	assert.Implements(t, (*client.ForkChoiceProvider)(nil), s)
+	assert.Implements(t, (*client.ForksProvider)(nil), s)
 	assert.Implements(t, (*client.GenesisTimeProvider)(nil), s)
+	assert.Implements(t, (*client.HeadProvider)(nil), s)
+	assert.Implements(t, (*client.HeadSlotProvider)(nil), s)
+	assert.Implements(t, (*client.NetworkProvider)(nil), s)
+	assert.Implements(t, (*client.ObjectSigner)(nil), s)
+	assert.Implements(t, (*client.ProposerForSlotProvider)(nil), s)
+	assert.Implements(t, (*client.RANDAOProvider)(nil), s)
+	assert.Implements(t, (*client.SelfTester)(nil), s)
+	assert.Implements(t, (*client.SignedBeaconBlockWithMetadataProvider)(nil), s)
+	assert.Implements(t, (*client.SignedBeaconBlockWithRawProvider)(nil), s)
+	assert.Implements(t, (*client.SlotTickerProvider)(nil), s)
+	assert.Implements(t, (*client.SlotWaiter)(nil), s)
+	assert.Implements(t, (*client.StateRootVerifier)(nil), s)
+	assert.Implements(t, (*client.StreamErrorsProvider)(nil), s)
+	assert.Implements(t, (*client.SupportedEventTopicsProvider)(nil), s)
+	assert.Implements(t, (*client.SyncWaiter)(nil), s)
+	assert.Implements(t, (*client.ValidatorBalancesAtSlotsProvider)(nil), s)
+	assert.Implements(t, (*client.ValidatorEffectiveBalanceProvider)(nil), s)
+	assert.Implements(t, (*client.ValidatorIndicesResolver)(nil), s)
+	assert.Implements(t, (*client.ValidatorProvider)(nil), s)
+	assert.Implements(t, (*client.ValidatorWithdrawalCredentialsProvider)(nil), s)
+	assert.Implements(t, (*client.ValidatorsAtEpochProvider)(nil), s)
+	assert.Implements(t, (*client.ValidatorsWithFieldsProvider)(nil), s)
+	assert.Implements(t, (*client.ValidatorsWithStatusesProvider)(nil), s)
+
+}
+
+func TestServiceUnresponsive(t *testing.T) {
+	// The server accepts connections but never responds.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	started := time.Now()
+	_, err := v1.New(context.Background(),
+		v1.WithAddress(server.URL),
+		v1.WithTimeout(100*time.Millisecond),
+	)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "context deadline exceeded")
+	require.Less(t, int64(time.Since(started)), int64(5*time.Second))
+}
+
+func TestServiceForkScheduleTimeout(t *testing.T) {
+	// The server provides all static values promptly apart from the fork schedule, which never responds.
+	server := newTestServerWithHandlers(t, map[string]http.HandlerFunc{
+		"/eth/v1/config/fork_schedule": func(w http.ResponseWriter, r *http.Request) {
+			<-r.Context().Done()
+		},
+	})
 
+	_, err := v1.New(context.Background(),
+		v1.WithAddress(server.URL),
+		v1.WithTimeout(100*time.Millisecond),
+	)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "failed to fetch fork schedule")
+	require.Contains(t, err.Error(), "context deadline exceeded")
 }
@@ -15,32 +15,91 @@ package v1
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
+	"io/ioutil"
 
+	client "github.com/attestantio/go-eth2-client"
+	api "github.com/attestantio/go-eth2-client/api/v1"
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 )
 
 type signedBeaconBlockJSON struct {
-	Data *spec.SignedBeaconBlock `json:"data"`
+	Version             string                  `json:"version"`
+	ExecutionOptimistic bool                    `json:"execution_optimistic"`
+	Finalized           bool                    `json:"finalized"`
+	Data                *spec.SignedBeaconBlock `json:"data"`
 }
 
 // SignedBeaconBlock fetches a signed beacon block given a block ID.
-// N.B if a signed beacon block for the block ID is not available this will return nil without an error.
+// If SSZ is preferred it is requested as SSZ, with a single retry as JSON if the SSZ cannot be decoded and fallback is enabled.
+// N.B if a signed beacon block for the block ID is not available this will return an error that wraps client.ErrNotFound.
 func (s *Service) SignedBeaconBlock(ctx context.Context, blockID string) (*spec.SignedBeaconBlock, error) {
-	respBodyReader, err := s.get(ctx, fmt.Sprintf("/eth/v1/beacon/blocks/%s", blockID))
+	if s.preferSSZ {
+		var signedBeaconBlock spec.SignedBeaconBlock
+		decoded, err := s.getSSZObject(ctx, "blocks", fmt.Sprintf("/beacon/blocks/%s", blockID), "signed beacon block", &signedBeaconBlock)
+		if err != nil {
+			return nil, err
+		}
+		if decoded {
+			return &signedBeaconBlock, nil
+		}
+	}
+
+	signedBeaconBlock, _, err := s.SignedBeaconBlockWithMetadata(ctx, blockID)
+	return signedBeaconBlock, err
+}
+
+// SignedBeaconBlockWithMetadata fetches a signed beacon block given a block ID, along with the response metadata.
+// N.B if a signed beacon block for the block ID is not available this will return an error that wraps client.ErrNotFound.
+func (s *Service) SignedBeaconBlockWithMetadata(ctx context.Context, blockID string) (*spec.SignedBeaconBlock, *api.ResponseMetadata, error) {
+	resp, _, err := s.signedBeaconBlock(ctx, blockID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resp.Data, &api.ResponseMetadata{
+		ExecutionOptimistic: resp.ExecutionOptimistic,
+		Finalized:           resp.Finalized,
+	}, nil
+}
+
+// SignedBeaconBlockWithRaw fetches a signed beacon block given a block ID, along with the raw response body.
+// N.B if a signed beacon block for the block ID is not available this will return an error that wraps client.ErrNotFound.
+func (s *Service) SignedBeaconBlockWithRaw(ctx context.Context, blockID string) (*spec.SignedBeaconBlock, []byte, error) {
+	resp, raw, err := s.signedBeaconBlock(ctx, blockID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resp.Data, raw, nil
+}
+
+// signedBeaconBlock fetches and parses a signed beacon block, returning the parsed response and the raw response body.
+func (s *Service) signedBeaconBlock(ctx context.Context, blockID string) (*signedBeaconBlockJSON, []byte, error) {
+	respBodyReader, err := s.getVersionedRaw(ctx, "blocks", fmt.Sprintf("/beacon/blocks/%s", blockID), "")
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to request signed beacon block")
+		return nil, nil, errors.Wrap(err, "failed to request signed beacon block")
 	}
 	if respBodyReader == nil {
-		return nil, nil
+		return nil, nil, errors.Wrap(client.ErrNotFound, "failed to obtain signed beacon block")
+	}
+
+	raw, err := ioutil.ReadAll(respBodyReader)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to read signed beacon block")
+	}
+
+	// The raw body is returned as sent by the node, so any lenient parsing is applied to a copy.
+	data, err := s.lenientData(raw)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	var resp signedBeaconBlockJSON
-	if err := json.NewDecoder(respBodyReader).Decode(&resp); err != nil {
-		return nil, errors.Wrap(err, "failed to parse signed beacon block")
+	if err := s.unmarshalJSON(data, &resp); err != nil {
+		return nil, nil, errors.Wrap(err, "failed to parse signed beacon block")
 	}
 
-	return resp.Data, nil
+	return &resp, raw, nil
 }
@@ -15,7 +15,9 @@ package v1_test
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"strings"
 	"testing"
 
 	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
@@ -23,6 +25,8 @@ import (
 )
 
 func TestSignedBeaconBlock(t *testing.T) {
+	skipWithoutNode(t)
+
 	tests := []struct {
 		name    string
 		stateID string
@@ -51,3 +55,41 @@ func TestSignedBeaconBlock(t *testing.T) {
 		})
 	}
 }
+
+func TestSignedBeaconBlockWithRaw(t *testing.T) {
+	// Whitespace is deliberately non-canonical to ensure the body is returned as received.
+	response := fmt.Sprintf(`{ "execution_optimistic": false, "data": %s }`, metadataBlock)
+	server := newTestServer(t, map[string]string{
+		"/eth/v1/beacon/blocks/head": response,
+	})
+	service, err := standardhttp.New(context.Background(),
+		standardhttp.WithTimeout(timeout),
+		standardhttp.WithAddress(server.URL),
+	)
+	require.NoError(t, err)
+
+	block, raw, err := service.SignedBeaconBlockWithRaw(context.Background(), "head")
+	require.NoError(t, err)
+	require.NotNil(t, block)
+	require.Equal(t, response, string(raw))
+}
+
+func TestSignedBeaconBlockWithRawLenient(t *testing.T) {
+	// The slot is unquoted, so requires lenient parsing; the raw body should still be returned as received.
+	response := fmt.Sprintf(`{"execution_optimistic":false,"data":%s}`, strings.Replace(metadataBlock, `"slot":"1"`, `"slot":1`, 1))
+	server := newTestServer(t, map[string]string{
+		"/eth/v1/beacon/blocks/head": response,
+	})
+	service, err := standardhttp.New(context.Background(),
+		standardhttp.WithTimeout(timeout),
+		standardhttp.WithAddress(server.URL),
+		standardhttp.WithLenientIntegerParsing(true),
+	)
+	require.NoError(t, err)
+
+	block, raw, err := service.SignedBeaconBlockWithRaw(context.Background(), "head")
+	require.NoError(t, err)
+	require.NotNil(t, block)
+	require.Equal(t, uint64(1), uint64(block.Message.Slot))
+	require.Equal(t, response, string(raw))
+}
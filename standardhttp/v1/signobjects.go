@@ -0,0 +1,195 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+
+	client "github.com/attestantio/go-eth2-client"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/pkg/errors"
+	bitfield "github.com/prysmaticlabs/go-bitfield"
+)
+
+// SignedAttestationData signs attestation data with the signer and provides the resultant attestation.
+func (s *Service) SignedAttestationData(ctx context.Context,
+	signer client.Signer,
+	pubKey spec.BLSPubKey,
+	data *spec.AttestationData,
+	aggregationBits bitfield.Bitlist,
+) (
+	*spec.Attestation,
+	error,
+) {
+	if data == nil {
+		return nil, errors.New("attestation data missing")
+	}
+	if data.Target == nil {
+		return nil, errors.New("attestation data target missing")
+	}
+	domainType, err := s.BeaconAttesterDomain(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain beacon attester domain")
+	}
+
+	signature, err := s.sign(ctx, signer, pubKey, data, domainType, data.Target.Epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	return &spec.Attestation{
+		AggregationBits: aggregationBits,
+		Data:            data,
+		Signature:       signature,
+	}, nil
+}
+
+// SignAggregateAndProof signs an aggregate and proof with the signer and provides the resultant signed aggregate and proof.
+func (s *Service) SignAggregateAndProof(ctx context.Context,
+	signer client.Signer,
+	pubKey spec.BLSPubKey,
+	aggregateAndProof *spec.AggregateAndProof,
+) (
+	*spec.SignedAggregateAndProof,
+	error,
+) {
+	if aggregateAndProof == nil {
+		return nil, errors.New("aggregate and proof missing")
+	}
+	if aggregateAndProof.Aggregate == nil || aggregateAndProof.Aggregate.Data == nil {
+		return nil, errors.New("aggregate data missing")
+	}
+	domainType, err := s.AggregateAndProofDomain(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain aggregate and proof domain")
+	}
+	epoch, err := s.epochAtSlot(ctx, aggregateAndProof.Aggregate.Data.Slot)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := s.sign(ctx, signer, pubKey, aggregateAndProof, domainType, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	return &spec.SignedAggregateAndProof{
+		Message:   aggregateAndProof,
+		Signature: signature,
+	}, nil
+}
+
+// SignBeaconBlock signs a beacon block with the signer and provides the resultant signed beacon block.
+func (s *Service) SignBeaconBlock(ctx context.Context,
+	signer client.Signer,
+	pubKey spec.BLSPubKey,
+	block *spec.BeaconBlock,
+) (
+	*spec.SignedBeaconBlock,
+	error,
+) {
+	if block == nil {
+		return nil, errors.New("beacon block missing")
+	}
+	domainType, err := s.BeaconProposerDomain(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain beacon proposer domain")
+	}
+	epoch, err := s.epochAtSlot(ctx, block.Slot)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := s.sign(ctx, signer, pubKey, block, domainType, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	return &spec.SignedBeaconBlock{
+		Message:   block,
+		Signature: signature,
+	}, nil
+}
+
+// SignVoluntaryExit signs a voluntary exit with the signer and provides the resultant signed voluntary exit.
+func (s *Service) SignVoluntaryExit(ctx context.Context,
+	signer client.Signer,
+	pubKey spec.BLSPubKey,
+	voluntaryExit *spec.VoluntaryExit,
+) (
+	*spec.SignedVoluntaryExit,
+	error,
+) {
+	if voluntaryExit == nil {
+		return nil, errors.New("voluntary exit missing")
+	}
+	domainType, err := s.VoluntaryExitDomain(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain voluntary exit domain")
+	}
+
+	signature, err := s.sign(ctx, signer, pubKey, voluntaryExit, domainType, voluntaryExit.Epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	return &spec.SignedVoluntaryExit{
+		Message:   voluntaryExit,
+		Signature: signature,
+	}, nil
+}
+
+// sign computes the signing root of an object for the given domain type and epoch, and signs it with the signer.
+func (s *Service) sign(ctx context.Context,
+	signer client.Signer,
+	pubKey spec.BLSPubKey,
+	object ssz.HashRoot,
+	domainType spec.DomainType,
+	epoch spec.Epoch,
+) (
+	spec.BLSSignature,
+	error,
+) {
+	if signer == nil {
+		return spec.BLSSignature{}, errors.New("no signer specified")
+	}
+	domain, err := s.Domain(ctx, domainType, epoch)
+	if err != nil {
+		return spec.BLSSignature{}, errors.Wrap(err, "failed to obtain domain")
+	}
+	signingRoot, err := spec.SigningRoot(object, domain)
+	if err != nil {
+		return spec.BLSSignature{}, err
+	}
+	signature, err := signer.Sign(ctx, pubKey, signingRoot)
+	if err != nil {
+		return spec.BLSSignature{}, errors.Wrap(err, "failed to sign")
+	}
+
+	return signature, nil
+}
+
+// epochAtSlot calculates the epoch of the given slot.
+func (s *Service) epochAtSlot(ctx context.Context, slot spec.Slot) (spec.Epoch, error) {
+	slotsPerEpoch, err := s.SlotsPerEpoch(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to obtain slots per epoch")
+	}
+	if slotsPerEpoch == 0 {
+		return 0, errors.New("slots per epoch of 0")
+	}
+
+	return spec.Epoch(uint64(slot) / slotsPerEpoch), nil
+}
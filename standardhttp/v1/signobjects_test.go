@@ -0,0 +1,171 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/stretchr/testify/require"
+)
+
+// testSigner is a signer that records the signing roots it is asked to sign.
+type testSigner struct {
+	signature spec.BLSSignature
+	err       error
+	pubKeys   []spec.BLSPubKey
+	roots     []spec.Root
+}
+
+// Sign implements client.Signer.
+func (s *testSigner) Sign(_ context.Context, pubKey spec.BLSPubKey, signingRoot spec.Root) (spec.BLSSignature, error) {
+	s.pubKeys = append(s.pubKeys, pubKey)
+	s.roots = append(s.roots, signingRoot)
+	return s.signature, s.err
+}
+
+func newSignObjectsService(t *testing.T) *standardhttp.Service {
+	server := newTestServer(t, map[string]string{
+		"/eth/v1/config/spec":          `{"data":{"SECONDS_PER_SLOT":"12","SLOTS_PER_EPOCH":"32","DOMAIN_BEACON_PROPOSER":"0x00000000","DOMAIN_BEACON_ATTESTER":"0x01000000","DOMAIN_VOLUNTARY_EXIT":"0x04000000","DOMAIN_AGGREGATE_AND_PROOF":"0x06000000"}}`,
+		"/eth/v1/config/fork_schedule": `{"data":[{"previous_version":"0x00000000","current_version":"0x00000000","epoch":"0"},{"previous_version":"0x00000000","current_version":"0x01000000","epoch":"10"}]}`,
+	})
+	service, err := standardhttp.New(context.Background(),
+		standardhttp.WithTimeout(timeout),
+		standardhttp.WithAddress(server.URL),
+	)
+	require.NoError(t, err)
+
+	return service
+}
+
+// expectedRoot calculates the signing root that the signer should be asked to sign.
+func expectedRoot(t *testing.T, service *standardhttp.Service, object ssz.HashRoot, domainType spec.DomainType, epoch spec.Epoch) spec.Root {
+	domain, err := service.Domain(context.Background(), domainType, epoch)
+	require.NoError(t, err)
+	root, err := spec.SigningRoot(object, domain)
+	require.NoError(t, err)
+
+	return root
+}
+
+func TestSignedAttestationData(t *testing.T) {
+	ctx := context.Background()
+	service := newSignObjectsService(t)
+	pubKey := spec.BLSPubKey{0x01}
+	signer := &testSigner{signature: spec.BLSSignature{0x02}}
+	data := &spec.AttestationData{
+		Slot:   352,
+		Index:  1,
+		Source: &spec.Checkpoint{Epoch: 10},
+		Target: &spec.Checkpoint{Epoch: 11},
+	}
+	aggregationBits := bitfield.NewBitlist(4)
+	aggregationBits.SetBitAt(1, true)
+
+	attestation, err := service.SignedAttestationData(ctx, signer, pubKey, data, aggregationBits)
+	require.NoError(t, err)
+	require.Equal(t, data, attestation.Data)
+	require.Equal(t, aggregationBits, attestation.AggregationBits)
+	require.Equal(t, signer.signature, attestation.Signature)
+	require.Equal(t, []spec.BLSPubKey{pubKey}, signer.pubKeys)
+	require.Equal(t, []spec.Root{expectedRoot(t, service, data, spec.DomainType{0x01, 0x00, 0x00, 0x00}, 11)}, signer.roots)
+
+	_, err = service.SignedAttestationData(ctx, signer, pubKey, &spec.AttestationData{}, aggregationBits)
+	require.EqualError(t, err, "attestation data target missing")
+
+	_, err = service.SignedAttestationData(ctx, nil, pubKey, data, aggregationBits)
+	require.EqualError(t, err, "no signer specified")
+
+	_, err = service.SignedAttestationData(ctx, &testSigner{err: errors.New("remote signer unavailable")}, pubKey, data, aggregationBits)
+	require.EqualError(t, err, "failed to sign: remote signer unavailable")
+}
+
+func TestSignAggregateAndProof(t *testing.T) {
+	ctx := context.Background()
+	service := newSignObjectsService(t)
+	pubKey := spec.BLSPubKey{0x01}
+	signer := &testSigner{signature: spec.BLSSignature{0x02}}
+	aggregateAndProof := &spec.AggregateAndProof{
+		AggregatorIndex: 3,
+		Aggregate: &spec.Attestation{
+			AggregationBits: bitfield.NewBitlist(4),
+			Data: &spec.AttestationData{
+				Slot:   320,
+				Source: &spec.Checkpoint{},
+				Target: &spec.Checkpoint{Epoch: 10},
+			},
+		},
+	}
+
+	signed, err := service.SignAggregateAndProof(ctx, signer, pubKey, aggregateAndProof)
+	require.NoError(t, err)
+	require.Equal(t, aggregateAndProof, signed.Message)
+	require.Equal(t, signer.signature, signed.Signature)
+	require.Equal(t, []spec.Root{expectedRoot(t, service, aggregateAndProof, spec.DomainType{0x06, 0x00, 0x00, 0x00}, 10)}, signer.roots)
+
+	_, err = service.SignAggregateAndProof(ctx, signer, pubKey, &spec.AggregateAndProof{})
+	require.EqualError(t, err, "aggregate data missing")
+}
+
+func TestSignBeaconBlock(t *testing.T) {
+	ctx := context.Background()
+	service := newSignObjectsService(t)
+	pubKey := spec.BLSPubKey{0x01}
+	signer := &testSigner{signature: spec.BLSSignature{0x02}}
+	block := &spec.BeaconBlock{
+		Slot:          319,
+		ProposerIndex: 4,
+		Body: &spec.BeaconBlockBody{
+			ETH1Data: &spec.ETH1Data{
+				BlockHash: make([]byte, 32),
+			},
+			Graffiti: make([]byte, 32),
+		},
+	}
+
+	signed, err := service.SignBeaconBlock(ctx, signer, pubKey, block)
+	require.NoError(t, err)
+	require.Equal(t, block, signed.Message)
+	require.Equal(t, signer.signature, signed.Signature)
+	// Slot 319 is in epoch 9, prior to the fork.
+	require.Equal(t, []spec.Root{expectedRoot(t, service, block, spec.DomainType{0x00, 0x00, 0x00, 0x00}, 9)}, signer.roots)
+
+	_, err = service.SignBeaconBlock(ctx, signer, pubKey, nil)
+	require.EqualError(t, err, "beacon block missing")
+}
+
+func TestSignVoluntaryExit(t *testing.T) {
+	ctx := context.Background()
+	service := newSignObjectsService(t)
+	pubKey := spec.BLSPubKey{0x01}
+	signer := &testSigner{signature: spec.BLSSignature{0x02}}
+	voluntaryExit := &spec.VoluntaryExit{
+		Epoch:          12,
+		ValidatorIndex: 5,
+	}
+
+	signed, err := service.SignVoluntaryExit(ctx, signer, pubKey, voluntaryExit)
+	require.NoError(t, err)
+	require.Equal(t, voluntaryExit, signed.Message)
+	require.Equal(t, signer.signature, signed.Signature)
+	require.Equal(t, []spec.Root{expectedRoot(t, service, voluntaryExit, spec.DomainType{0x04, 0x00, 0x00, 0x00}, 12)}, signer.roots)
+
+	_, err = service.SignVoluntaryExit(ctx, signer, pubKey, nil)
+	require.EqualError(t, err, "voluntary exit missing")
+}
@@ -20,5 +20,5 @@ import (
 
 // SlotDuration provides the duration of a slot for the chain.
 func (s *Service) SlotDuration(ctx context.Context) (time.Duration, error) {
-	return s.spec["SECONDS_PER_SLOT"].(time.Duration), nil
+	return s.chainConfig.SecondsPerSlot, nil
 }
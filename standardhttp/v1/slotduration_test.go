@@ -24,6 +24,8 @@ import (
 )
 
 func TestSlotDuration(t *testing.T) {
+	skipWithoutNode(t)
+
 	tests := []struct {
 		name string
 	}{
@@ -23,6 +23,8 @@ import (
 )
 
 func TestSlotsPerEpoch(t *testing.T) {
+	skipWithoutNode(t)
+
 	tests := []struct {
 		name string
 	}{
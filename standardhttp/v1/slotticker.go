@@ -0,0 +1,113 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+	"time"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// SlotTicker provides a channel that receives the current slot at the start of each slot.
+// Slot boundaries are calculated from the genesis time and slot duration.  Head events from the node are
+// used to correct for a local clock that is behind, by emitting a slot as soon as the node's head reaches it.
+// Slots are emitted in increasing order; if the receiver is not ready for a slot it is dropped.
+// The channel is closed when the context is done or the service is closed.
+func (s *Service) SlotTicker(ctx context.Context) (<-chan spec.Slot, error) {
+	genesisTime, err := s.GenesisTime(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain genesis time")
+	}
+	slotDuration, err := s.SlotDuration(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain slot duration")
+	}
+	if slotDuration == 0 {
+		return nil, errors.New("slot duration of 0")
+	}
+
+	heads := make(chan spec.Slot, 1)
+	if err := s.Events(ctx, []string{"head"}, func(event *api.Event) {
+		if headEvent, isHeadEvent := event.Data.(*api.HeadEvent); isHeadEvent {
+			select {
+			case heads <- headEvent.Slot:
+			default:
+				// A head is already waiting to be processed; a later one will follow.
+			}
+		}
+	}); err != nil {
+		s.log.Debug().Err(err).Msg("Head events not available; slot ticker will not correct for drift")
+	}
+
+	// Stop the ticker when the service is closed, as well as when the context is done.
+	ctx, cancel := s.withServiceContext(ctx)
+	ticks := make(chan spec.Slot, 1)
+	if err := s.background.run("slot ticker", func() {
+		defer cancel()
+		s.runSlotTicker(ctx, genesisTime, slotDuration, heads, ticks)
+	}); err != nil {
+		cancel()
+		return nil, err
+	}
+
+	return ticks, nil
+}
+
+// runSlotTicker sends slots to the ticks channel until the context is done.
+func (s *Service) runSlotTicker(ctx context.Context,
+	genesisTime time.Time,
+	slotDuration time.Duration,
+	heads <-chan spec.Slot,
+	ticks chan<- spec.Slot,
+) {
+	defer close(ticks)
+
+	emitted := false
+	lastSlot := spec.Slot(0)
+	emit := func(slot spec.Slot) {
+		if emitted && slot <= lastSlot {
+			// Already emitted this slot, for example from an earlier head event.
+			return
+		}
+		emitted = true
+		lastSlot = slot
+		select {
+		case ticks <- slot:
+		default:
+			s.log.Trace().Uint64("slot", uint64(slot)).Msg("Slot ticker receiver not ready; dropping slot")
+		}
+	}
+
+	for {
+		now := time.Now()
+		nextSlot := spec.Slot(0)
+		if !now.Before(genesisTime) {
+			nextSlot = spec.Slot(now.Sub(genesisTime)/slotDuration) + 1
+		}
+		timer := time.NewTimer(genesisTime.Add(time.Duration(nextSlot) * slotDuration).Sub(now))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+			emit(nextSlot)
+		case headSlot := <-heads:
+			timer.Stop()
+			emit(headSlot)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
+	"github.com/stretchr/testify/require"
+)
+
+// genesisResponse returns a genesis response with the given genesis time.
+func genesisResponse(genesisTime time.Time) string {
+	return fmt.Sprintf(`{"data":{"genesis_time":"%d","genesis_validators_root":"0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95","genesis_fork_version":"0x00000000"}}`, genesisTime.Unix())
+}
+
+func TestSlotTicker(t *testing.T) {
+	server := newTestServer(t, map[string]string{
+		"/eth/v1/beacon/genesis": genesisResponse(time.Now().Add(-10 * time.Second)),
+		"/eth/v1/config/spec":    `{"data":{"SECONDS_PER_SLOT":"1","SLOTS_PER_EPOCH":"32"}}`,
+	})
+	service, err := standardhttp.New(context.Background(),
+		standardhttp.WithTimeout(timeout),
+		standardhttp.WithAddress(server.URL),
+	)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ticks, err := service.SlotTicker(ctx)
+	require.NoError(t, err)
+
+	first := <-ticks
+	require.True(t, first >= 10)
+	select {
+	case second := <-ticks:
+		require.Equal(t, first+1, second)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "second tick not received")
+	}
+
+	// Cancelling the context closes the channel.
+	cancel()
+	for range ticks {
+		// Drain any remaining tick until the channel is closed.
+	}
+}
+
+func TestSlotTickerHeadEvents(t *testing.T) {
+	headEvent := `{"slot":"5","block":"0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20","state":"0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20","epoch_transition":false}`
+	server := newTestServerWithHandlers(t, map[string]http.HandlerFunc{
+		// Genesis is far in the future, so only the head event can generate a tick.
+		"/eth/v1/beacon/genesis": respondWith(genesisResponse(time.Now().Add(time.Hour))),
+		"/eth/v1/events": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "text/event-stream")
+			fmt.Fprintf(w, "event: head\ndata: %s\n\n", headEvent)
+			w.(http.Flusher).Flush()
+			<-r.Context().Done()
+		},
+	})
+	service, err := standardhttp.New(context.Background(),
+		standardhttp.WithTimeout(timeout),
+		standardhttp.WithAddress(server.URL),
+	)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ticks, err := service.SlotTicker(ctx)
+	require.NoError(t, err)
+
+	select {
+	case slot := <-ticks:
+		require.Equal(t, spec.Slot(5), slot)
+	case <-time.After(10 * time.Second):
+		require.Fail(t, "tick from head event not received")
+	}
+}
@@ -16,7 +16,6 @@ package v1
 import (
 	"context"
 	"encoding/hex"
-	"encoding/json"
 	"strconv"
 	"strings"
 	"time"
@@ -41,7 +40,7 @@ func (s *Service) Spec(ctx context.Context) (map[string]interface{}, error) {
 		}
 
 		var specJSON specJSON
-		if err := json.NewDecoder(respBodyReader).Decode(&specJSON); err != nil {
+		if err := s.decodeJSON(respBodyReader, &specJSON); err != nil {
 			return nil, errors.Wrap(err, "failed to parse spec")
 		}
 
@@ -28,6 +28,8 @@ import (
 )
 
 func TestSpecConformance(t *testing.T) {
+	skipWithoutNode(t)
+
 	expected := map[string]interface{}{
 		"BASE_REWARD_FACTOR":                    uint64(0),
 		"BLS_WITHDRAWAL_PREFIX":                 []byte{},
@@ -24,6 +24,8 @@ import (
 )
 
 func TestSpec(t *testing.T) {
+	skipWithoutNode(t)
+
 	tests := []struct {
 		name string
 	}{
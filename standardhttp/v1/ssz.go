@@ -0,0 +1,113 @@
+// Copyright © 2020 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/pkg/errors"
+)
+
+// sszUnmarshaler is the interface for objects that can be decoded from SSZ.
+type sszUnmarshaler interface {
+	UnmarshalSSZ(buf []byte) error
+}
+
+// sszMarshaler is the interface for objects that can be encoded as SSZ.
+type sszMarshaler interface {
+	MarshalSSZ() ([]byte, error)
+}
+
+// sszRejectedStatuses are the statuses with which nodes reject SSZ-encoded requests that they do not support.
+var sszRejectedStatuses = map[int]bool{
+	http.StatusBadRequest:           true,
+	http.StatusUnsupportedMediaType: true,
+}
+
+// postSSZObject posts an SSZ-encoded object of the given consensus version to an endpoint.
+// If the node rejects the encoding and SSZ fallback is enabled this returns false with no
+// error, to allow the caller to retry the request with JSON.
+func (s *Service) postSSZObject(ctx context.Context, path string, name string, version string, obj sszMarshaler) (bool, error) {
+	data, err := obj.MarshalSSZ()
+	if err != nil {
+		return false, errors.Wrap(err, fmt.Sprintf("failed to encode SSZ %s", name))
+	}
+
+	_, err = s.postWithHeaders(ctx, path, map[string]string{
+		"Content-Type":          sszContentType,
+		"Eth-Consensus-Version": version,
+	}, bytes.NewReader(data))
+	if err != nil {
+		var httpErr *httpError
+		if s.sszFallback && errors.As(err, &httpErr) && sszRejectedStatuses[httpErr.statusCode] {
+			s.log.Warn().Str("endpoint", path).Err(err).Msg("Node rejected SSZ request; retrying with JSON")
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+// getSSZObject fetches an SSZ-encoded object from a versioned endpoint and decodes it in to the supplied object.
+// If the response cannot be decoded and SSZ fallback is enabled this returns false with no
+// error, to allow the caller to retry the request with JSON.
+func (s *Service) getSSZObject(ctx context.Context, endpoint string, path string, name string, obj sszUnmarshaler) (bool, error) {
+	respBodyReader, err := s.getVersioned(ctx, endpoint, path, sszContentType)
+	if err != nil {
+		return false, errors.Wrap(err, fmt.Sprintf("failed to request %s", name))
+	}
+
+	return s.decodeSSZObject(respBodyReader, path, name, obj)
+}
+
+// getUnversionedSSZObject fetches an SSZ-encoded object from an endpoint that is available in a single
+// version of the API, and decodes it in to the supplied object.
+// If the response cannot be decoded and SSZ fallback is enabled this returns false with no
+// error, to allow the caller to retry the request with JSON.
+func (s *Service) getUnversionedSSZObject(ctx context.Context, path string, name string, obj sszUnmarshaler) (bool, error) {
+	respBodyReader, err := s.getWithAccept(ctx, path, sszContentType)
+	if err != nil {
+		return false, errors.Wrap(err, fmt.Sprintf("failed to request %s", name))
+	}
+
+	return s.decodeSSZObject(respBodyReader, path, name, obj)
+}
+
+// decodeSSZObject decodes an SSZ-encoded response in to the supplied object.
+func (s *Service) decodeSSZObject(respBodyReader io.Reader, path string, name string, obj sszUnmarshaler) (bool, error) {
+	if respBodyReader == nil {
+		return false, errors.Wrap(client.ErrNotFound, fmt.Sprintf("failed to obtain %s", name))
+	}
+
+	data, err := ioutil.ReadAll(respBodyReader)
+	if err != nil {
+		return false, errors.Wrap(err, fmt.Sprintf("failed to read %s", name))
+	}
+	if err := obj.UnmarshalSSZ(data); err != nil {
+		if !s.sszFallback {
+			return false, errors.Wrap(err, fmt.Sprintf("failed to decode SSZ %s", name))
+		}
+		s.log.Warn().Str("endpoint", path).Err(err).Msg("Failed to decode SSZ response; retrying with JSON")
+		return false, nil
+	}
+
+	return true, nil
+}
@@ -0,0 +1,111 @@
+// Copyright © 2020 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSSZFallback(t *testing.T) {
+	block := &spec.SignedBeaconBlock{
+		Message: &spec.BeaconBlock{
+			Slot:          1,
+			ProposerIndex: 2,
+			Body: &spec.BeaconBlockBody{
+				ETH1Data: &spec.ETH1Data{
+					BlockHash: make([]byte, 32),
+				},
+				Graffiti:          make([]byte, 32),
+				ProposerSlashings: []*spec.ProposerSlashing{},
+				AttesterSlashings: []*spec.AttesterSlashing{},
+				Attestations:      []*spec.Attestation{},
+				Deposits:          []*spec.Deposit{},
+				VoluntaryExits:    []*spec.SignedVoluntaryExit{},
+			},
+		},
+	}
+	blockJSON, err := json.Marshal(block)
+	require.NoError(t, err)
+	blockSSZ, err := block.MarshalSSZ()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		sszResponse  []byte
+		noFallback   bool
+		jsonRequests int
+		err          string
+	}{
+		{
+			name:        "SSZ",
+			sszResponse: blockSSZ,
+		},
+		{
+			name:         "Fallback",
+			sszResponse:  blockSSZ[:10],
+			jsonRequests: 1,
+		},
+		{
+			name:        "NoFallback",
+			sszResponse: blockSSZ[:10],
+			noFallback:  true,
+			err:         "failed to decode SSZ signed beacon block",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			jsonRequests := 0
+			server := newTestServerWithHandlers(t, map[string]http.HandlerFunc{
+				"/eth/v1/beacon/blocks/head": func(w http.ResponseWriter, r *http.Request) {
+					if r.Header.Get("Accept") == "application/octet-stream" {
+						w.Header().Set("Content-Type", "application/octet-stream")
+						_, _ = w.Write(test.sszResponse)
+						return
+					}
+					jsonRequests++
+					respondWith(fmt.Sprintf(`{"data":%s}`, string(blockJSON)))(w, r)
+				},
+			})
+			service, err := standardhttp.New(context.Background(),
+				standardhttp.WithTimeout(timeout),
+				standardhttp.WithAddress(server.URL),
+				standardhttp.WithPreferSSZ(true),
+				standardhttp.WithSSZFallback(!test.noFallback),
+			)
+			require.NoError(t, err)
+
+			res, err := service.SignedBeaconBlock(context.Background(), "head")
+			if test.err != "" {
+				require.Error(t, err)
+				require.True(t, strings.HasPrefix(err.Error(), test.err))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.jsonRequests, jsonRequests)
+			resSSZ, err := res.MarshalSSZ()
+			require.NoError(t, err)
+			require.Equal(t, blockSSZ, resSSZ)
+		})
+	}
+}
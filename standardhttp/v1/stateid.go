@@ -46,7 +46,7 @@ func (s *Service) SlotFromStateID(ctx context.Context, stateID string) (spec.Slo
 		slot = spec.Slot(tmp)
 	}
 
-	log.Trace().Str("state", stateID).Uint64("slot", uint64(slot)).Msg("Calculated from state ID")
+	s.log.Trace().Str("state", stateID).Uint64("slot", uint64(slot)).Msg("Calculated from state ID")
 	return slot, nil
 }
 
@@ -85,6 +85,6 @@ func (s *Service) EpochFromStateID(ctx context.Context, stateID string) (spec.Ep
 		epoch = spec.Epoch(tmp / slotsPerEpoch)
 	}
 
-	log.Trace().Str("state", stateID).Uint64("epoch", uint64(epoch)).Msg("Calculated from state ID")
+	s.log.Trace().Str("state", stateID).Uint64("epoch", uint64(epoch)).Msg("Calculated from state ID")
 	return epoch, nil
 }
@@ -15,11 +15,10 @@ package v1
 
 import (
 	"context"
-	"encoding/hex"
-	"encoding/json"
 	"fmt"
-	"strings"
 
+	client "github.com/attestantio/go-eth2-client"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 )
 
@@ -42,15 +41,15 @@ func (s *Service) StateRoot(ctx context.Context, stateID string) ([]byte, error)
 		return nil, errors.Wrap(err, "failed to request state root")
 	}
 	if respBodyReader == nil {
-		return nil, errors.New("failed to obtain state root")
+		return nil, errors.Wrap(client.ErrNotFound, "failed to obtain state root")
 	}
 
 	var stateRootJSON stateRootJSON
-	if err := json.NewDecoder(respBodyReader).Decode(&stateRootJSON); err != nil {
+	if err := s.decodeJSON(respBodyReader, &stateRootJSON); err != nil {
 		return nil, errors.Wrap(err, "failed to parse state root")
 	}
 
-	stateRoot, err := hex.DecodeString(strings.TrimPrefix(stateRootJSON.Data.Root, "0x"))
+	stateRoot, err := parseHex(stateRootJSON.Data.Root, spec.RootLength)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to parse state root value")
 	}
@@ -24,6 +24,8 @@ import (
 )
 
 func TestStateRoot(t *testing.T) {
+	skipWithoutNode(t)
+
 	tests := []struct {
 		name              string
 		stateID           string
@@ -0,0 +1,174 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"fmt"
+	"sync"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+// submissionTrackingSlots is the number of slots behind the most recent submission for which submissions are tracked.
+const submissionTrackingSlots = 64
+
+// attestationSubmissionKey identifies the validator making an unaggregated attestation by its position in its committee.
+type attestationSubmissionKey struct {
+	slot           spec.Slot
+	committeeIndex spec.CommitteeIndex
+	position       int
+}
+
+// blockSubmissionKey identifies the validator proposing a block.
+type blockSubmissionKey struct {
+	slot          spec.Slot
+	proposerIndex spec.ValidatorIndex
+}
+
+// submissionTracker records the roots of data submitted through the client, to detect conflicting submissions
+// from the same validator for the same slot.
+// This is a debugging aid, and not a replacement for slashing protection.
+type submissionTracker struct {
+	log          zerolog.Logger
+	strict       bool
+	mu           sync.Mutex
+	highestSlot  spec.Slot
+	attestations map[attestationSubmissionKey]spec.Root
+	blocks       map[blockSubmissionKey]spec.Root
+}
+
+// newSubmissionTracker creates a new submission tracker.
+func newSubmissionTracker(strict bool, log zerolog.Logger) *submissionTracker {
+	return &submissionTracker{
+		log:          log,
+		strict:       strict,
+		attestations: make(map[attestationSubmissionKey]spec.Root),
+		blocks:       make(map[blockSubmissionKey]spec.Root),
+	}
+}
+
+// trackAttestations records unaggregated attestations, warning about or in strict mode rejecting any that conflict
+// with attestations previously submitted by the same validator for the same slot.
+// In strict mode nothing is recorded if any attestation conflicts.
+func (t *submissionTracker) trackAttestations(attestations []*spec.Attestation) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	pending := make(map[attestationSubmissionKey]spec.Root)
+	for i, attestation := range attestations {
+		if attestation == nil || attestation.Data == nil || attestation.AggregationBits.Count() != 1 {
+			// Only unaggregated attestations identify their validator.
+			continue
+		}
+		root, err := attestation.Data.HashTreeRoot()
+		if err != nil {
+			return errors.Wrap(err, "failed to obtain attestation data root")
+		}
+		key := attestationSubmissionKey{
+			slot:           attestation.Data.Slot,
+			committeeIndex: attestation.Data.Index,
+			position:       attestation.AggregationBits.BitIndices()[0],
+		}
+		previousRoot, exists := t.attestations[key]
+		if !exists {
+			previousRoot, exists = pending[key]
+		}
+		if exists && previousRoot != root {
+			t.log.Warn().
+				Uint64("slot", uint64(key.slot)).
+				Uint64("committee_index", uint64(key.committeeIndex)).
+				Int("position", key.position).
+				Str("previous_root", fmt.Sprintf("%#x", previousRoot)).
+				Str("root", fmt.Sprintf("%#x", root)).
+				Msg("Conflicting attestation submitted")
+			if t.strict {
+				return fmt.Errorf("attestation %d conflicts with previous attestation for slot %d committee %d position %d", i, key.slot, key.committeeIndex, key.position)
+			}
+			continue
+		}
+		if !exists {
+			pending[key] = root
+		}
+	}
+
+	for key, root := range pending {
+		t.attestations[key] = root
+		t.updateHighestSlot(key.slot)
+	}
+
+	return nil
+}
+
+// trackBlock records a block, warning about or in strict mode rejecting it if it conflicts with a block previously
+// submitted by the same proposer for the same slot.
+func (t *submissionTracker) trackBlock(block *spec.BeaconBlock) error {
+	if block == nil {
+		return nil
+	}
+	root, err := block.HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain block root")
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	key := blockSubmissionKey{
+		slot:          block.Slot,
+		proposerIndex: block.ProposerIndex,
+	}
+	if previousRoot, exists := t.blocks[key]; exists {
+		if previousRoot != root {
+			t.log.Warn().
+				Uint64("slot", uint64(key.slot)).
+				Uint64("proposer_index", uint64(key.proposerIndex)).
+				Str("previous_root", fmt.Sprintf("%#x", previousRoot)).
+				Str("root", fmt.Sprintf("%#x", root)).
+				Msg("Conflicting block submitted")
+			if t.strict {
+				return fmt.Errorf("block conflicts with previous block for slot %d proposer %d", key.slot, key.proposerIndex)
+			}
+		}
+		return nil
+	}
+	t.blocks[key] = root
+	t.updateHighestSlot(key.slot)
+
+	return nil
+}
+
+// updateHighestSlot updates the highest slot seen, dropping submissions that are no longer tracked.
+// This must be called with the lock held.
+func (t *submissionTracker) updateHighestSlot(slot spec.Slot) {
+	if slot <= t.highestSlot {
+		return
+	}
+	t.highestSlot = slot
+	if t.highestSlot < submissionTrackingSlots {
+		return
+	}
+	minSlot := t.highestSlot - submissionTrackingSlots
+	for key := range t.attestations {
+		if key.slot < minSlot {
+			delete(t.attestations, key)
+		}
+	}
+	for key := range t.blocks {
+		if key.slot < minSlot {
+			delete(t.blocks, key)
+		}
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"context"
+	"testing"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/stretchr/testify/require"
+)
+
+func trackedAttestation(position uint64, root byte) *spec.Attestation {
+	aggregationBits := bitfield.NewBitlist(8)
+	aggregationBits.SetBitAt(position, true)
+
+	return &spec.Attestation{
+		AggregationBits: aggregationBits,
+		Data: &spec.AttestationData{
+			Slot:            10,
+			Index:           1,
+			BeaconBlockRoot: spec.Root{root},
+			Source:          &spec.Checkpoint{},
+			Target:          &spec.Checkpoint{},
+		},
+	}
+}
+
+func trackedBlock(root byte) *spec.SignedBeaconBlock {
+	return &spec.SignedBeaconBlock{
+		Message: &spec.BeaconBlock{
+			Slot:          10,
+			ProposerIndex: 2,
+			ParentRoot:    spec.Root{root},
+			Body: &spec.BeaconBlockBody{
+				ETH1Data: &spec.ETH1Data{
+					BlockHash: make([]byte, 32),
+				},
+				Graffiti: make([]byte, 32),
+			},
+		},
+	}
+}
+
+func TestSubmissionTracking(t *testing.T) {
+	server := newTestServer(t, map[string]string{
+		"/eth/v1/beacon/pool/attestations": "",
+		"/eth/v1/beacon/blocks":            "",
+	})
+
+	tests := []struct {
+		name   string
+		params []standardhttp.Parameter
+		err    string
+		strict bool
+	}{
+		{
+			name: "StrictWithoutTracking",
+			params: []standardhttp.Parameter{
+				standardhttp.WithStrictSubmissionTracking(true),
+			},
+			err: "problem with parameters: strict submission tracking requires submission tracking",
+		},
+		{
+			name: "Warn",
+			params: []standardhttp.Parameter{
+				standardhttp.WithSubmissionTracking(true),
+			},
+		},
+		{
+			name: "Strict",
+			params: []standardhttp.Parameter{
+				standardhttp.WithSubmissionTracking(true),
+				standardhttp.WithStrictSubmissionTracking(true),
+			},
+			strict: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			params := append([]standardhttp.Parameter{
+				standardhttp.WithTimeout(timeout),
+				standardhttp.WithAddress(server.URL),
+			}, test.params...)
+			service, err := standardhttp.New(context.Background(), params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			ctx := context.Background()
+
+			// Identical and non-conflicting submissions are always accepted.
+			require.NoError(t, service.SubmitAttestation(ctx, trackedAttestation(2, 1)))
+			require.NoError(t, service.SubmitAttestation(ctx, trackedAttestation(2, 1)))
+			require.NoError(t, service.SubmitAttestations(ctx, &[]spec.Attestation{*trackedAttestation(3, 2)}))
+			require.NoError(t, service.SubmitBeaconBlock(ctx, trackedBlock(1)))
+			require.NoError(t, service.SubmitBeaconBlock(ctx, trackedBlock(1)))
+
+			// Conflicting submissions are rejected only in strict mode.
+			err = service.SubmitAttestations(ctx, &[]spec.Attestation{*trackedAttestation(4, 1), *trackedAttestation(2, 2)})
+			if test.strict {
+				require.EqualError(t, err, "attestation 1 conflicts with previous attestation for slot 10 committee 1 position 2")
+			} else {
+				require.NoError(t, err)
+			}
+			err = service.SubmitBeaconBlock(ctx, trackedBlock(2))
+			if test.strict {
+				require.EqualError(t, err, "block conflicts with previous block for slot 10 proposer 2")
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -31,7 +31,7 @@ func (s *Service) SubmitAggregateAttestations(ctx context.Context, aggregateAndP
 
 	_, err = s.post(ctx, "/eth/v1/validator/aggregate_and_proofs", bytes.NewBuffer(specJSON))
 	if err != nil {
-		return errors.Wrap(err, "failed to submit aggregate and proofs")
+		return errors.Wrap(submissionError(err), "failed to submit aggregate and proofs")
 	}
 
 	return nil
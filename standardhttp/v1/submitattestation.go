@@ -24,6 +24,20 @@ import (
 
 // SubmitAttestation submits an attestation.
 func (s *Service) SubmitAttestation(ctx context.Context, attestation *spec.Attestation) error {
+	if attestation == nil {
+		return errors.New("no attestation specified")
+	}
+	if s.submissionValidation {
+		if err := s.validateAttestationSlots(ctx, []spec.Attestation{*attestation}); err != nil {
+			return err
+		}
+	}
+	if s.submissionTracker != nil {
+		if err := s.submissionTracker.trackAttestations([]*spec.Attestation{attestation}); err != nil {
+			return err
+		}
+	}
+
 	specJSON, err := json.Marshal(attestation)
 	if err != nil {
 		return errors.Wrap(err, "failed to marshal JSON")
@@ -31,7 +45,7 @@ func (s *Service) SubmitAttestation(ctx context.Context, attestation *spec.Attes
 
 	_, err = s.post(ctx, "/eth/v1/beacon/pool/attestations", bytes.NewBuffer(specJSON))
 	if err != nil {
-		return errors.Wrap(err, "failed to submit beacon attestation")
+		return errors.Wrap(submissionError(err), "failed to submit beacon attestation")
 	}
 
 	return nil
@@ -26,6 +26,8 @@ import (
 )
 
 func TestSubmitAttestation(t *testing.T) {
+	skipWithoutNode(t)
+
 	tests := []struct {
 		name string
 	}{
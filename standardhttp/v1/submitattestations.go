@@ -17,13 +17,33 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 )
 
 // SubmitAttestations submits attestations.
+// If the node rejects individual attestations this will return an error that wraps client.SubmissionError.
 func (s *Service) SubmitAttestations(ctx context.Context, attestations *[]spec.Attestation) error {
+	if attestations == nil {
+		return errors.New("no attestations specified")
+	}
+	if s.submissionValidation {
+		if err := s.validateAttestationSlots(ctx, *attestations); err != nil {
+			return err
+		}
+	}
+	if s.submissionTracker != nil {
+		tracked := make([]*spec.Attestation, len(*attestations))
+		for i := range *attestations {
+			tracked[i] = &(*attestations)[i]
+		}
+		if err := s.submissionTracker.trackAttestations(tracked); err != nil {
+			return err
+		}
+	}
+
 	specJSON, err := json.Marshal(attestations)
 	if err != nil {
 		return errors.Wrap(err, "failed to marshal JSON")
@@ -31,7 +51,31 @@ func (s *Service) SubmitAttestations(ctx context.Context, attestations *[]spec.A
 
 	_, err = s.post(ctx, "/eth/v1/beacon/pool/attestations", bytes.NewBuffer(specJSON))
 	if err != nil {
-		return errors.Wrap(err, "failed to submit beacon attestations")
+		return errors.Wrap(submissionError(err), "failed to submit beacon attestations")
+	}
+
+	return nil
+}
+
+// validateAttestationSlots ensures that attestations are recent enough to be propagated by the node.
+func (s *Service) validateAttestationSlots(ctx context.Context, attestations []spec.Attestation) error {
+	config, err := s.ChainConfig(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain chain configuration")
+	}
+	currentSlot, err := s.currentSlot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain current slot")
+	}
+
+	for i := range attestations {
+		if attestations[i].Data == nil {
+			return fmt.Errorf("attestation %d has no data", i)
+		}
+		slot := attestations[i].Data.Slot
+		if slot+spec.Slot(config.AttestationPropagationSlotRange) < currentSlot {
+			return fmt.Errorf("attestation %d for slot %d is more than %d slots behind current slot %d", i, slot, config.AttestationPropagationSlotRange, currentSlot)
+		}
 	}
 
 	return nil
@@ -0,0 +1,115 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubmitAttestationsValidation(t *testing.T) {
+	submissions := 0
+	server := newTestServerWithHandlers(t, map[string]http.HandlerFunc{
+		// Genesis 100 slots ago, so the current slot is 100.
+		"/eth/v1/beacon/genesis": respondWith(genesisResponse(time.Now().Add(-100*12*time.Second - 6*time.Second))),
+		"/eth/v1/beacon/pool/attestations": func(w http.ResponseWriter, r *http.Request) {
+			submissions++
+		},
+	})
+
+	tests := []struct {
+		name        string
+		validate    bool
+		slot        spec.Slot
+		err         string
+		submissions int
+	}{
+		{
+			name:        "Recent",
+			validate:    true,
+			slot:        68,
+			submissions: 1,
+		},
+		{
+			name:     "Stale",
+			validate: true,
+			slot:     67,
+			err:      "attestation 0 for slot 67 is more than 32 slots behind current slot 100",
+		},
+		{
+			name:        "StaleUnvalidated",
+			slot:        67,
+			submissions: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			service, err := standardhttp.New(context.Background(),
+				standardhttp.WithTimeout(timeout),
+				standardhttp.WithAddress(server.URL),
+				standardhttp.WithSubmissionValidation(test.validate),
+			)
+			require.NoError(t, err)
+
+			submissions = 0
+			attestations := []spec.Attestation{
+				{
+					AggregationBits: []byte{0x01, 0x01},
+					Data: &spec.AttestationData{
+						Slot:   test.slot,
+						Source: &spec.Checkpoint{},
+						Target: &spec.Checkpoint{},
+					},
+				},
+			}
+			err = service.SubmitAttestations(context.Background(), &attestations)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, test.submissions, submissions)
+
+			// The single attestation path is validated in the same way.
+			submissions = 0
+			err = service.SubmitAttestation(context.Background(), &attestations[0])
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, test.submissions, submissions)
+		})
+	}
+}
+
+func TestSubmitAttestationsNil(t *testing.T) {
+	server := newTestServer(t, nil)
+	service, err := standardhttp.New(context.Background(),
+		standardhttp.WithTimeout(timeout),
+		standardhttp.WithAddress(server.URL),
+		standardhttp.WithSubmissionValidation(true),
+	)
+	require.NoError(t, err)
+
+	require.EqualError(t, service.SubmitAttestations(context.Background(), nil), "no attestations specified")
+	require.EqualError(t, service.SubmitAttestation(context.Background(), nil), "no attestation specified")
+}
@@ -23,7 +23,24 @@ import (
 )
 
 // SubmitBeaconBlock submits a beacon block.
+// If SSZ is preferred the block is submitted as SSZ, retrying as JSON if the node rejects SSZ and SSZ fallback is enabled.
 func (s *Service) SubmitBeaconBlock(ctx context.Context, block *spec.SignedBeaconBlock) error {
+	if s.submissionTracker != nil && block != nil {
+		if err := s.submissionTracker.trackBlock(block.Message); err != nil {
+			return err
+		}
+	}
+
+	if s.preferSSZ {
+		submitted, err := s.postSSZObject(ctx, "/eth/v1/beacon/blocks", "beacon block", "phase0", block)
+		if err != nil {
+			return errors.Wrap(submissionError(err), "failed to submit beacon block")
+		}
+		if submitted {
+			return nil
+		}
+	}
+
 	specJSON, err := json.Marshal(block)
 	if err != nil {
 		return errors.Wrap(err, "failed to marshal JSON")
@@ -31,7 +48,7 @@ func (s *Service) SubmitBeaconBlock(ctx context.Context, block *spec.SignedBeaco
 
 	_, err = s.post(ctx, "/eth/v1/beacon/blocks", bytes.NewBuffer(specJSON))
 	if err != nil {
-		return errors.Wrap(err, "failed to submit beacon block")
+		return errors.Wrap(submissionError(err), "failed to submit beacon block")
 	}
 
 	return nil
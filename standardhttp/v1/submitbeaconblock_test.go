@@ -15,6 +15,9 @@ package v1_test
 
 import (
 	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
 	"os"
 	"testing"
 	"time"
@@ -25,6 +28,8 @@ import (
 )
 
 func TestSubmitBeaconBlock(t *testing.T) {
+	skipWithoutNode(t)
+
 	tests := []struct {
 		name         string
 		randaoReveal spec.BLSSignature
@@ -78,3 +83,107 @@ func TestSubmitBeaconBlock(t *testing.T) {
 		})
 	}
 }
+
+func TestSubmitBeaconBlockSSZ(t *testing.T) {
+	block := &spec.SignedBeaconBlock{
+		Message: &spec.BeaconBlock{
+			Slot:          10,
+			ProposerIndex: 2,
+			Body: &spec.BeaconBlockBody{
+				ETH1Data: &spec.ETH1Data{
+					BlockHash: make([]byte, 32),
+				},
+				Graffiti:          make([]byte, 32),
+				ProposerSlashings: []*spec.ProposerSlashing{},
+				AttesterSlashings: []*spec.AttesterSlashing{},
+				Attestations:      []*spec.Attestation{},
+				Deposits:          []*spec.Deposit{},
+				VoluntaryExits:    []*spec.SignedVoluntaryExit{},
+			},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		params       []standardhttp.Parameter
+		acceptSSZ    bool
+		sszRequests  int
+		jsonRequests int
+		err          string
+	}{
+		{
+			name:         "JSON",
+			acceptSSZ:    true,
+			jsonRequests: 1,
+		},
+		{
+			name: "SSZ",
+			params: []standardhttp.Parameter{
+				standardhttp.WithPreferSSZ(true),
+			},
+			acceptSSZ:   true,
+			sszRequests: 1,
+		},
+		{
+			name: "SSZRejected",
+			params: []standardhttp.Parameter{
+				standardhttp.WithPreferSSZ(true),
+			},
+			sszRequests:  1,
+			jsonRequests: 1,
+		},
+		{
+			name: "SSZRejectedNoFallback",
+			params: []standardhttp.Parameter{
+				standardhttp.WithPreferSSZ(true),
+				standardhttp.WithSSZFallback(false),
+			},
+			sszRequests: 1,
+			err:         "failed to submit beacon block: POST failed with status 415: unsupported content type",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sszRequests := 0
+			jsonRequests := 0
+			server := newTestServerWithHandlers(t, map[string]http.HandlerFunc{
+				"/eth/v1/beacon/blocks": func(w http.ResponseWriter, r *http.Request) {
+					body, err := ioutil.ReadAll(r.Body)
+					require.NoError(t, err)
+					received := &spec.SignedBeaconBlock{}
+					if r.Header.Get("Content-Type") == "application/octet-stream" {
+						sszRequests++
+						if !test.acceptSSZ {
+							w.WriteHeader(http.StatusUnsupportedMediaType)
+							_, _ = w.Write([]byte(`{"code":415,"message":"unsupported content type"}`))
+							return
+						}
+						require.Equal(t, "phase0", r.Header.Get("Eth-Consensus-Version"))
+						require.NoError(t, received.UnmarshalSSZ(body))
+					} else {
+						jsonRequests++
+						require.NoError(t, json.Unmarshal(body, received))
+					}
+					require.Equal(t, block.Message.Slot, received.Message.Slot)
+				},
+			})
+
+			params := append([]standardhttp.Parameter{
+				standardhttp.WithTimeout(timeout),
+				standardhttp.WithAddress(server.URL),
+			}, test.params...)
+			service, err := standardhttp.New(context.Background(), params...)
+			require.NoError(t, err)
+
+			err = service.SubmitBeaconBlock(context.Background(), block)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, test.sszRequests, sszRequests)
+			require.Equal(t, test.jsonRequests, jsonRequests)
+		})
+	}
+}
@@ -32,7 +32,7 @@ func (s *Service) SubmitBeaconCommitteeSubscriptions(ctx context.Context, subscr
 
 	_, err := s.post(ctx, "/eth/v1/validator/beacon_committee_subscriptions", &reqBodyReader)
 	if err != nil {
-		return errors.Wrap(err, "failed to request beacon committee subscriptions")
+		return errors.Wrap(submissionError(err), "failed to request beacon committee subscriptions")
 	}
 
 	return nil
@@ -0,0 +1,109 @@
+// Copyright © 2020 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubmitBLSToExecutionChanges(t *testing.T) {
+	blsToExecutionChanges := []*capella.SignedBLSToExecutionChange{
+		{
+			Message: &capella.BLSToExecutionChange{
+				ValidatorIndex: 1,
+			},
+		},
+		{
+			Message: &capella.BLSToExecutionChange{
+				ValidatorIndex: 2,
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		status   int
+		response string
+		err      string
+		failures []*client.SubmissionFailure
+	}{
+		{
+			name:   "Good",
+			status: http.StatusOK,
+		},
+		{
+			name:     "Failures",
+			status:   http.StatusBadRequest,
+			response: `{"code":400,"message":"some failures","failures":[{"index":1,"message":"invalid signature"}]}`,
+			err:      "failed to submit BLS to execution changes: some failures: item 1: invalid signature",
+			failures: []*client.SubmissionFailure{
+				{
+					Index:   1,
+					Message: "invalid signature",
+				},
+			},
+		},
+		{
+			name:     "NoFailures",
+			status:   http.StatusInternalServerError,
+			response: `{"code":500,"message":"internal error"}`,
+			err:      `failed to submit BLS to execution changes: POST failed with status 500: internal error`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := newTestServerWithHandlers(t, map[string]http.HandlerFunc{
+				"/eth/v1/beacon/pool/bls_to_execution_changes": func(w http.ResponseWriter, r *http.Request) {
+					body, err := ioutil.ReadAll(r.Body)
+					require.NoError(t, err)
+					var submitted []*capella.SignedBLSToExecutionChange
+					require.NoError(t, json.Unmarshal(body, &submitted))
+					require.Len(t, submitted, len(blsToExecutionChanges))
+					w.WriteHeader(test.status)
+					_, _ = w.Write([]byte(test.response))
+				},
+			})
+			service, err := standardhttp.New(context.Background(),
+				standardhttp.WithTimeout(timeout),
+				standardhttp.WithAddress(server.URL),
+			)
+			require.NoError(t, err)
+
+			err = service.SubmitBLSToExecutionChanges(context.Background(), blsToExecutionChanges)
+			if test.err == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, test.err)
+			var submissionErr *client.SubmissionError
+			if test.failures == nil {
+				require.False(t, errors.As(err, &submissionErr))
+				return
+			}
+			require.True(t, errors.As(err, &submissionErr))
+			require.Equal(t, test.failures, submissionErr.Failures)
+		})
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright © 2020 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/pkg/errors"
+)
+
+// SubmitSyncCommitteeSubscriptions subscribes to sync committees.
+func (s *Service) SubmitSyncCommitteeSubscriptions(ctx context.Context, subscriptions []*api.SyncCommitteeSubscription) error {
+	currentEpoch, err := s.currentEpoch(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain current epoch")
+	}
+	for i := range subscriptions {
+		if subscriptions[i] == nil {
+			return fmt.Errorf("subscription %d is nil", i)
+		}
+		if subscriptions[i].UntilEpoch <= currentEpoch {
+			return fmt.Errorf("subscription %d until epoch %d is not after current epoch %d", i, subscriptions[i].UntilEpoch, currentEpoch)
+		}
+	}
+
+	var reqBodyReader bytes.Buffer
+	if err := json.NewEncoder(&reqBodyReader).Encode(subscriptions); err != nil {
+		return errors.Wrap(err, "failed to encode sync committee subscriptions")
+	}
+
+	_, err = s.post(ctx, "/eth/v1/validator/sync_committee_subscriptions", &reqBodyReader)
+	if err != nil {
+		return errors.Wrap(submissionError(err), "failed to request sync committee subscriptions")
+	}
+
+	return nil
+}
@@ -0,0 +1,91 @@
+// Copyright © 2020 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubmitSyncCommitteeSubscriptions(t *testing.T) {
+	// Genesis time, slot duration and slots per epoch match the static responses.
+	currentEpoch := spec.Epoch(uint64(time.Since(time.Unix(1606824023, 0))/(12*time.Second)) / 32)
+
+	tests := []struct {
+		name       string
+		untilEpoch spec.Epoch
+		err        string
+	}{
+		{
+			name:       "Good",
+			untilEpoch: currentEpoch + 256,
+		},
+		{
+			name:       "UntilEpochPast",
+			untilEpoch: 1,
+			err:        fmt.Sprintf("subscription 0 until epoch 1 is not after current epoch %d", currentEpoch),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			submitted := false
+			server := newTestServerWithHandlers(t, map[string]http.HandlerFunc{
+				"/eth/v1/validator/sync_committee_subscriptions": func(w http.ResponseWriter, r *http.Request) {
+					submitted = true
+				},
+			})
+			service, err := standardhttp.New(context.Background(),
+				standardhttp.WithTimeout(timeout),
+				standardhttp.WithAddress(server.URL),
+			)
+			require.NoError(t, err)
+
+			err = service.SubmitSyncCommitteeSubscriptions(context.Background(), []*api.SyncCommitteeSubscription{
+				{
+					ValidatorIndex:       1,
+					SyncCommitteeIndices: []spec.CommitteeIndex{0},
+					UntilEpoch:           test.untilEpoch,
+				},
+			})
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				require.False(t, submitted)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, submitted)
+		})
+	}
+}
+
+func TestSubmitSyncCommitteeSubscriptionsNil(t *testing.T) {
+	server := newTestServer(t, nil)
+	service, err := standardhttp.New(context.Background(),
+		standardhttp.WithTimeout(timeout),
+		standardhttp.WithAddress(server.URL),
+	)
+	require.NoError(t, err)
+
+	err = service.SubmitSyncCommitteeSubscriptions(context.Background(), []*api.SyncCommitteeSubscription{nil})
+	require.EqualError(t, err, "subscription 0 is nil")
+}
@@ -0,0 +1,53 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/pkg/errors"
+)
+
+// SubmitValidatorRegistrations submits validator registrations for block builders.
+// If the node rejects individual registrations this will return an error that wraps client.SubmissionError.
+func (s *Service) SubmitValidatorRegistrations(ctx context.Context, registrations []*api.SignedValidatorRegistration) error {
+	for i := range registrations {
+		if registrations[i] == nil || registrations[i].Message == nil {
+			return fmt.Errorf("registration %d missing", i)
+		}
+		if len(registrations[i].Message.FeeRecipient) != capella.ExecutionAddressLength {
+			return fmt.Errorf("registration %d has incorrect length %d for fee recipient", i, len(registrations[i].Message.FeeRecipient))
+		}
+		if registrations[i].Message.GasLimit == 0 {
+			return fmt.Errorf("registration %d has gas limit of 0", i)
+		}
+	}
+
+	var reqBodyReader bytes.Buffer
+	if err := json.NewEncoder(&reqBodyReader).Encode(registrations); err != nil {
+		return errors.Wrap(err, "failed to encode validator registrations")
+	}
+
+	_, err := s.post(ctx, "/eth/v1/validator/register_validator", &reqBodyReader)
+	if err != nil {
+		return errors.Wrap(submissionError(err), "failed to submit validator registrations")
+	}
+
+	return nil
+}
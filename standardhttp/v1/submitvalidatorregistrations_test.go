@@ -0,0 +1,130 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	client "github.com/attestantio/go-eth2-client"
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func validatorRegistration(feeRecipient []byte, gasLimit uint64) *api.SignedValidatorRegistration {
+	return &api.SignedValidatorRegistration{
+		Message: &api.ValidatorRegistration{
+			FeeRecipient: feeRecipient,
+			GasLimit:     gasLimit,
+			Timestamp:    time.Unix(1695902400, 0),
+			PubKey:       spec.BLSPubKey{0x01},
+		},
+		Signature: spec.BLSSignature{0x02},
+	}
+}
+
+func TestSubmitValidatorRegistrations(t *testing.T) {
+	feeRecipient := []byte{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10, 0x11, 0x12, 0x13}
+
+	tests := []struct {
+		name          string
+		registrations []*api.SignedValidatorRegistration
+		status        int
+		response      string
+		requests      int
+		err           string
+		failures      []*client.SubmissionFailure
+	}{
+		{
+			name:          "Good",
+			registrations: []*api.SignedValidatorRegistration{validatorRegistration(feeRecipient, 30000000), validatorRegistration(feeRecipient, 30000000)},
+			status:        http.StatusOK,
+			requests:      1,
+		},
+		{
+			name:          "RegistrationMissing",
+			registrations: []*api.SignedValidatorRegistration{validatorRegistration(feeRecipient, 30000000), nil},
+			err:           "registration 1 missing",
+		},
+		{
+			name:          "FeeRecipientShort",
+			registrations: []*api.SignedValidatorRegistration{validatorRegistration(feeRecipient[1:], 30000000)},
+			err:           "registration 0 has incorrect length 19 for fee recipient",
+		},
+		{
+			name:          "GasLimitZero",
+			registrations: []*api.SignedValidatorRegistration{validatorRegistration(feeRecipient, 0)},
+			err:           "registration 0 has gas limit of 0",
+		},
+		{
+			name:          "Failures",
+			registrations: []*api.SignedValidatorRegistration{validatorRegistration(feeRecipient, 30000000), validatorRegistration(feeRecipient, 30000000)},
+			status:        http.StatusBadRequest,
+			response:      `{"code":400,"message":"some failures","failures":[{"index":1,"message":"invalid signature"}]}`,
+			requests:      1,
+			err:           "failed to submit validator registrations: some failures: item 1: invalid signature",
+			failures: []*client.SubmissionFailure{
+				{
+					Index:   1,
+					Message: "invalid signature",
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			requests := 0
+			server := newTestServerWithHandlers(t, map[string]http.HandlerFunc{
+				"/eth/v1/validator/register_validator": func(w http.ResponseWriter, r *http.Request) {
+					requests++
+					body, err := ioutil.ReadAll(r.Body)
+					require.NoError(t, err)
+					var submitted []*api.SignedValidatorRegistration
+					require.NoError(t, json.Unmarshal(body, &submitted))
+					require.Equal(t, test.registrations, submitted)
+					w.WriteHeader(test.status)
+					_, _ = w.Write([]byte(test.response))
+				},
+			})
+			service, err := standardhttp.New(context.Background(),
+				standardhttp.WithTimeout(timeout),
+				standardhttp.WithAddress(server.URL),
+			)
+			require.NoError(t, err)
+
+			err = service.SubmitValidatorRegistrations(context.Background(), test.registrations)
+			require.Equal(t, test.requests, requests)
+			if test.err == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, test.err)
+			var submissionErr *client.SubmissionError
+			if test.failures == nil {
+				require.False(t, errors.As(err, &submissionErr))
+				return
+			}
+			require.True(t, errors.As(err, &submissionErr))
+			require.Equal(t, test.failures, submissionErr.Failures)
+		})
+	}
+}
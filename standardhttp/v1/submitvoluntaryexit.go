@@ -31,7 +31,7 @@ func (s *Service) SubmitVoluntaryExit(ctx context.Context, voluntaryExit *spec.S
 
 	_, err = s.post(ctx, "/eth/v1/beacon/pool/voluntary_exits", bytes.NewBuffer(specJSON))
 	if err != nil {
-		return errors.Wrap(err, "failed to submit voluntary exit")
+		return errors.Wrap(submissionError(err), "failed to submit voluntary exit")
 	}
 
 	return nil
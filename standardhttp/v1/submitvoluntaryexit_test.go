@@ -24,6 +24,8 @@ import (
 )
 
 func TestSubmitVoluntaryExit(t *testing.T) {
+	skipWithoutNode(t)
+
 	tests := []struct {
 		name string
 		exit *spec.SignedVoluntaryExit
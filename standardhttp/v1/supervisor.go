@@ -0,0 +1,105 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+	"runtime/debug"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+// errServiceClosed is returned when background work is requested after the service has been closed.
+var errServiceClosed = errors.New("service closed")
+
+// supervisor tracks the background goroutines started by the service, so that they can be waited on when the
+// service is closed.  A panic in a supervised goroutine is recovered and logged rather than crashing the process.
+type supervisor struct {
+	log zerolog.Logger
+
+	mu       sync.Mutex
+	stopping bool
+	wg       sync.WaitGroup
+}
+
+// newSupervisor creates a new supervisor.
+func newSupervisor(log zerolog.Logger) *supervisor {
+	return &supervisor{
+		log: log,
+	}
+}
+
+// run runs the supplied function in a supervised goroutine.
+// Once the supervisor has started waiting for its goroutines no more can be started, and this returns an error.
+func (s *supervisor) run(name string, f func()) error {
+	s.mu.Lock()
+	if s.stopping {
+		s.mu.Unlock()
+		return errServiceClosed
+	}
+	s.wg.Add(1)
+	s.mu.Unlock()
+
+	go func() {
+		defer s.wg.Done()
+		defer func() {
+			if r := recover(); r != nil {
+				s.log.Error().Str("goroutine", name).Interface("panic", r).Bytes("stack", debug.Stack()).Msg("Recovered from panic in background goroutine")
+			}
+		}()
+		f()
+	}()
+
+	return nil
+}
+
+// wait stops further goroutines from being started, and waits for all supervised goroutines to finish or for the
+// context to be done.
+func (s *supervisor) wait(ctx context.Context) error {
+	s.mu.Lock()
+	s.stopping = true
+	s.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// withServiceContext derives a context from the supplied context that is also done when the service is closed.
+func (s *Service) withServiceContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(ctx)
+	if err := s.background.run("service context", func() {
+		select {
+		case <-s.runCtx.Done():
+		case <-ctx.Done():
+		}
+		cancel()
+	}); err != nil {
+		// The service is closed.
+		cancel()
+	}
+
+	return ctx, cancel
+}
@@ -0,0 +1,97 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClose(t *testing.T) {
+	headEvent := `{"slot":"10","block":"0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20","state":"0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20","epoch_transition":false,"previous_duty_dependent_root":"0x0101010101010101010101010101010101010101010101010101010101010101","current_duty_dependent_root":"0x0101010101010101010101010101010101010101010101010101010101010101"}`
+	closed := make(chan struct{}, 2)
+	server := newTestServerWithHandlers(t, map[string]http.HandlerFunc{
+		"/eth/v1/events": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "text/event-stream")
+			fmt.Fprintf(w, "event: head\ndata: %s\n\n", headEvent)
+			w.(http.Flusher).Flush()
+			<-r.Context().Done()
+			closed <- struct{}{}
+		},
+	})
+
+	service, err := standardhttp.New(context.Background(),
+		standardhttp.WithTimeout(timeout),
+		standardhttp.WithAddress(server.URL),
+	)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	received := make(chan struct{}, 1)
+	require.NoError(t, service.Events(ctx, []string{"head"}, func(event *api.Event) {
+		select {
+		case received <- struct{}{}:
+		default:
+		}
+	}))
+	// A panic in a handler is recovered without stopping its stream or crashing the process.
+	panicked := make(chan struct{}, 1)
+	require.NoError(t, service.Events(ctx, []string{"head"}, func(event *api.Event) {
+		select {
+		case panicked <- struct{}{}:
+		default:
+		}
+		panic("handler failure")
+	}))
+	ticks, err := service.SlotTicker(ctx)
+	require.NoError(t, err)
+
+	for _, ch := range []chan struct{}{received, panicked} {
+		select {
+		case <-ch:
+		case <-time.After(10 * time.Second):
+			require.Fail(t, "event not received")
+		}
+	}
+	time.Sleep(100 * time.Millisecond)
+	require.Len(t, closed, 0)
+
+	closeCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	require.NoError(t, service.Close(closeCtx))
+	require.Error(t, service.Context().Err())
+
+	// Closing the service stops the event streams and the slot ticker.
+	for i := 0; i < 2; i++ {
+		select {
+		case <-closed:
+		case <-time.After(10 * time.Second):
+			require.Fail(t, "event stream not closed")
+		}
+	}
+	for range ticks {
+	}
+
+	// Background work cannot be started once the service is closed.
+	require.EqualError(t, service.Events(ctx, []string{"head"}, func(event *api.Event) {}), "service closed")
+	_, err = service.SlotTicker(ctx)
+	require.EqualError(t, err, "service closed")
+}
@@ -0,0 +1,95 @@
+// Copyright © 2020 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/pkg/errors"
+)
+
+// unknownEventTopic is a topic that no node should support.
+const unknownEventTopic = "unknown_event_topic"
+
+// SupportedEventTopics provides the event topics supported by both the node and this library.
+// The standard API does not advertise supported topics, so each topic is probed by briefly opening an event stream for it.
+// If the node does not reject unknown topics then probing is not possible, and the default list of topics in
+// api.SupportedEventTopics is returned.
+func (s *Service) SupportedEventTopics(ctx context.Context) ([]string, error) {
+	topics := make([]string, 0, len(api.SupportedEventTopics))
+	for topic := range api.SupportedEventTopics {
+		topics = append(topics, topic)
+	}
+	sort.Strings(topics)
+
+	supported, err := s.probeEventTopic(ctx, unknownEventTopic)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to probe unknown event topic")
+	}
+	if supported {
+		// The node does not validate topics, so return the default list.
+		return topics, nil
+	}
+
+	res := make([]string, 0, len(topics))
+	for _, topic := range topics {
+		supported, err := s.probeEventTopic(ctx, topic)
+		if err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("failed to probe event topic %s", topic))
+		}
+		if supported {
+			res = append(res, topic)
+		}
+	}
+
+	return res, nil
+}
+
+// probeEventTopic returns true if the node accepts an event stream request for the topic.
+func (s *Service) probeEventTopic(ctx context.Context, topic string) (bool, error) {
+	reference, err := url.Parse(fmt.Sprintf("/eth/v1/events?topics=%s", topic))
+	if err != nil {
+		return false, errors.Wrap(err, "invalid endpoint")
+	}
+	url := s.base.ResolveReference(reference).String()
+
+	opCtx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(opCtx, http.MethodGet, url, nil)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to create GET request")
+	}
+	s.addHeaders(req)
+	req.Header.Set("Accept", "text/event-stream")
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to call GET endpoint")
+	}
+	// The body is an event stream, so close it without reading.
+	resp.Body.Close()
+
+	switch {
+	case resp.StatusCode/100 == 2:
+		return true, nil
+	case resp.StatusCode == http.StatusBadRequest:
+		return false, nil
+	default:
+		return false, fmt.Errorf("GET failed with status %d", resp.StatusCode)
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright © 2020 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSupportedEventTopics(t *testing.T) {
+	tests := []struct {
+		name     string
+		accepted map[string]bool
+		status   int
+		topics   []string
+		err      string
+	}{
+		{
+			name:   "ServerError",
+			status: http.StatusInternalServerError,
+			err:    "failed to probe unknown event topic: GET failed with status 500",
+		},
+		{
+			name:   "NoValidation",
+			status: http.StatusOK,
+			topics: []string{
+				"attestation",
+				"attester_slashing",
+				"block",
+				"block_gossip",
+				"chain_reorg",
+				"finalized_checkpoint",
+				"head",
+				"light_client_finality_update",
+				"light_client_optimistic_update",
+				"proposer_slashing",
+				"voluntary_exit",
+			},
+		},
+		{
+			name: "Subset",
+			accepted: map[string]bool{
+				"block": true,
+				"head":  true,
+			},
+			status: http.StatusBadRequest,
+			topics: []string{"block", "head"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := newTestServerWithHandlers(t, map[string]http.HandlerFunc{
+				"/eth/v1/events": func(w http.ResponseWriter, r *http.Request) {
+					if test.accepted[r.URL.Query().Get("topics")] {
+						w.WriteHeader(http.StatusOK)
+						return
+					}
+					w.WriteHeader(test.status)
+				},
+			})
+			service, err := standardhttp.New(context.Background(),
+				standardhttp.WithTimeout(timeout),
+				standardhttp.WithAddress(server.URL),
+			)
+			require.NoError(t, err)
+
+			topics, err := service.SupportedEventTopics(context.Background())
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.topics, topics)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright © 2020 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	client "github.com/attestantio/go-eth2-client"
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+type syncCommitteeJSON struct {
+	Data *api.SyncCommittee `json:"data"`
+}
+
+// SyncCommittee fetches the sync committee for the epoch at the given state.
+// N.B if the requested sync committee is not available this will return an error that wraps client.ErrNotFound.
+func (s *Service) SyncCommittee(ctx context.Context, stateID string) (*api.SyncCommittee, error) {
+	return s.syncCommittee(ctx, fmt.Sprintf("/eth/v1/beacon/states/%s/sync_committees", stateID))
+}
+
+// SyncCommitteeAtEpoch fetches the sync committee for the given epoch at the given state.
+// This allows the sync committee for the next sync committee period to be obtained.
+// N.B if the requested sync committee is not available this will return an error that wraps client.ErrNotFound.
+func (s *Service) SyncCommitteeAtEpoch(ctx context.Context, stateID string, epoch spec.Epoch) (*api.SyncCommittee, error) {
+	return s.syncCommittee(ctx, fmt.Sprintf("/eth/v1/beacon/states/%s/sync_committees?epoch=%d", stateID, epoch))
+}
+
+func (s *Service) syncCommittee(ctx context.Context, url string) (*api.SyncCommittee, error) {
+	respBodyReader, err := s.get(ctx, url)
+	if err != nil {
+		s.log.Trace().Str("url", url).Err(err).Msg("Request failed")
+		return nil, errors.Wrap(err, "failed to request sync committee")
+	}
+	if respBodyReader == nil {
+		return nil, errors.Wrap(client.ErrNotFound, "failed to obtain sync committee")
+	}
+
+	var resp syncCommitteeJSON
+	if err := s.decodeJSON(respBodyReader, &resp); err != nil {
+		return nil, errors.Wrap(err, "failed to parse sync committee")
+	}
+	if resp.Data == nil {
+		return nil, errors.New("sync committee missing")
+	}
+
+	return resp.Data, nil
+}
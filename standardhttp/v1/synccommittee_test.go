@@ -0,0 +1,56 @@
+// Copyright © 2020 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyncCommittee(t *testing.T) {
+	server := newTestServerWithHandlers(t, map[string]http.HandlerFunc{
+		"/eth/v1/beacon/states/head/sync_committees": func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Query().Get("epoch") {
+			case "":
+				respondWith(`{"data":{"validators":["1","2"],"validator_aggregates":[["1"],["2"]]}}`)(w, r)
+			case "256":
+				respondWith(`{"data":{"validators":["3","4"],"validator_aggregates":[["3"],["4"]]}}`)(w, r)
+			default:
+				http.NotFound(w, r)
+			}
+		},
+	})
+	service, err := standardhttp.New(context.Background(),
+		standardhttp.WithTimeout(timeout),
+		standardhttp.WithAddress(server.URL),
+	)
+	require.NoError(t, err)
+
+	syncCommittee, err := service.SyncCommittee(context.Background(), "head")
+	require.NoError(t, err)
+	require.Equal(t, `{"validators":["1","2"],"validator_aggregates":[["1"],["2"]]}`, syncCommittee.String())
+
+	syncCommittee, err = service.SyncCommitteeAtEpoch(context.Background(), "head", 256)
+	require.NoError(t, err)
+	require.Equal(t, `{"validators":["3","4"],"validator_aggregates":[["3"],["4"]]}`, syncCommittee.String())
+
+	_, err = service.SyncCommitteeAtEpoch(context.Background(), "head", 512)
+	require.True(t, errors.Is(err, client.ErrNotFound))
+}
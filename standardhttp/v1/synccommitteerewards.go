@@ -0,0 +1,71 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+type syncCommitteeRewardsJSON struct {
+	ExecutionOptimistic bool                       `json:"execution_optimistic"`
+	Finalized           bool                       `json:"finalized"`
+	Data                []*api.SyncCommitteeReward `json:"data"`
+}
+
+// SyncCommitteeRewards provides the rewards received by sync committee members for the given block.
+// blockID can be a slot number or block root, or one of the special values "genesis", "head" or "finalized".
+// If validatorIndices is empty rewards for all sync committee members are returned, otherwise only matching rewards are returned.
+func (s *Service) SyncCommitteeRewards(ctx context.Context,
+	blockID string,
+	validatorIndices []spec.ValidatorIndex,
+) (*api.SyncCommitteeRewards, error) {
+	if blockID == "" {
+		return nil, errors.New("no block ID specified")
+	}
+
+	indices := make([]string, len(validatorIndices))
+	for i := range validatorIndices {
+		indices[i] = fmt.Sprintf("%d", validatorIndices[i])
+	}
+	var reqBodyReader bytes.Buffer
+	if err := json.NewEncoder(&reqBodyReader).Encode(indices); err != nil {
+		return nil, errors.Wrap(err, "failed to encode validator indices")
+	}
+
+	respBodyReader, err := s.post(ctx, fmt.Sprintf("/eth/v1/beacon/rewards/sync_committee/%s", blockID), &reqBodyReader)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request sync committee rewards")
+	}
+
+	var resp syncCommitteeRewardsJSON
+	if err := s.decodeJSON(respBodyReader, &resp); err != nil {
+		return nil, errors.Wrap(err, "failed to parse sync committee rewards")
+	}
+	if resp.Data == nil {
+		return nil, errors.New("no sync committee rewards returned")
+	}
+
+	return &api.SyncCommitteeRewards{
+		ExecutionOptimistic: resp.ExecutionOptimistic,
+		Finalized:           resp.Finalized,
+		Data:                resp.Data,
+	}, nil
+}
@@ -0,0 +1,91 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyncCommitteeRewards(t *testing.T) {
+	tests := []struct {
+		name     string
+		blockID  string
+		indices  []spec.ValidatorIndex
+		body     string
+		response string
+		expected *api.SyncCommitteeRewards
+		err      string
+	}{
+		{
+			name: "NoBlockID",
+			err:  "no block ID specified",
+		},
+		{
+			name:     "Good",
+			blockID:  "head",
+			indices:  []spec.ValidatorIndex{1, 2},
+			body:     `["1","2"]`,
+			response: `{"execution_optimistic":false,"finalized":true,"data":[{"validator_index":"1","reward":"2000"},{"validator_index":"2","reward":"-2000"}]}`,
+			expected: &api.SyncCommitteeRewards{
+				Finalized: true,
+				Data: []*api.SyncCommitteeReward{
+					{ValidatorIndex: 1, Reward: 2000},
+					{ValidatorIndex: 2, Reward: -2000},
+				},
+			},
+		},
+		{
+			name:     "NoData",
+			blockID:  "head",
+			body:     `[]`,
+			response: `{"execution_optimistic":false,"finalized":false}`,
+			err:      "no sync committee rewards returned",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := newTestServerWithHandlers(t, map[string]http.HandlerFunc{
+				"/eth/v1/beacon/rewards/sync_committee/head": func(w http.ResponseWriter, r *http.Request) {
+					body, err := ioutil.ReadAll(r.Body)
+					require.NoError(t, err)
+					require.JSONEq(t, test.body, string(body))
+					fmt.Fprint(w, test.response)
+				},
+			})
+			service, err := standardhttp.New(context.Background(),
+				standardhttp.WithTimeout(timeout),
+				standardhttp.WithAddress(server.URL),
+			)
+			require.NoError(t, err)
+
+			rewards, err := service.SyncCommitteeRewards(context.Background(), test.blockID, test.indices)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expected, rewards)
+		})
+	}
+}
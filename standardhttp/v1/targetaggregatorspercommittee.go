@@ -21,9 +21,9 @@ import (
 
 // TargetAggregatorsPerCommittee provides the target aggregators per committee of the chain.
 func (s *Service) TargetAggregatorsPerCommittee(ctx context.Context) (uint64, error) {
-	spec, err := s.Spec(ctx)
+	config, err := s.ChainConfig(ctx)
 	if err != nil {
-		return 0, errors.Wrap(err, "failed to obtain spec")
+		return 0, errors.Wrap(err, "failed to obtain chain configuration")
 	}
-	return spec["TARGET_AGGREGATORS_PER_COMMITTEE"].(uint64), nil
+	return config.TargetAggregatorsPerCommittee, nil
 }
@@ -0,0 +1,65 @@
+// Copyright © 2020 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// staticResponses are the responses required for a service to start.
+var staticResponses = map[string]string{
+	"/eth/v1/beacon/genesis":          `{"data":{"genesis_time":"1606824023","genesis_validators_root":"0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95","genesis_fork_version":"0x00000000"}}`,
+	"/eth/v1/config/spec":             `{"data":{"SECONDS_PER_SLOT":"12","SLOTS_PER_EPOCH":"32"}}`,
+	"/eth/v1/config/deposit_contract": `{"data":{"chain_id":"1","address":"0x00000000219ab540356cbb839cbe05303d7705fa"}}`,
+}
+
+// newTestServer creates a server that returns the supplied responses, keyed by path,
+// falling back to the static responses.  Unknown paths return 404.
+func newTestServer(t *testing.T, responses map[string]string) *httptest.Server {
+	handlers := make(map[string]http.HandlerFunc, len(responses))
+	for path, response := range responses {
+		handlers[path] = respondWith(response)
+	}
+
+	return newTestServerWithHandlers(t, handlers)
+}
+
+// newTestServerWithHandlers creates a server that calls the supplied handlers, keyed by path,
+// falling back to the static responses.  Unknown paths return 404.
+func newTestServerWithHandlers(t *testing.T, handlers map[string]http.HandlerFunc) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if handler, exists := handlers[r.URL.Path]; exists {
+			handler(w, r)
+			return
+		}
+		if response, exists := staticResponses[r.URL.Path]; exists {
+			respondWith(response)(w, r)
+			return
+		}
+		http.NotFound(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+// respondWith returns a handler that responds with the supplied JSON.
+func respondWith(response string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(response))
+	}
+}
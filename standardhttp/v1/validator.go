@@ -0,0 +1,83 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	client "github.com/attestantio/go-eth2-client"
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+type validatorJSON struct {
+	Data *api.Validator `json:"data"`
+}
+
+// Validator provides a single validator, with its balance and status, for a given state.
+// stateID can be a slot number or state root, or one of the special values "genesis", "head", "justified" or "finalized".
+// validatorID is either the decimal index of the validator or its 0x-prefixed public key.
+// N.B if the validator is not known this will return an error that wraps client.ErrNotFound.
+func (s *Service) Validator(ctx context.Context, stateID string, validatorID string) (*api.Validator, error) {
+	if stateID == "" {
+		return nil, errors.New("no state ID specified")
+	}
+	if err := checkValidatorID(validatorID); err != nil {
+		return nil, err
+	}
+
+	respBodyReader, err := s.get(ctx, fmt.Sprintf("/eth/v1/beacon/states/%s/validators/%s", stateID, validatorID))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request validator")
+	}
+	if respBodyReader == nil {
+		return nil, errors.Wrap(client.ErrNotFound, "failed to obtain validator")
+	}
+
+	var resp validatorJSON
+	if err := s.decodeJSON(respBodyReader, &resp); err != nil {
+		return nil, errors.Wrap(err, "failed to parse validator")
+	}
+	if resp.Data == nil {
+		return nil, errors.New("validator missing")
+	}
+
+	return resp.Data, nil
+}
+
+// checkValidatorID checks that a validator ID is either a decimal index or a 0x-prefixed public key.
+func checkValidatorID(validatorID string) error {
+	if strings.HasPrefix(validatorID, "0x") {
+		data, err := parseHex(validatorID, spec.PublicKeyLength)
+		if err != nil {
+			return errors.Wrapf(err, "invalid validator public key %q", validatorID)
+		}
+		var pubKey spec.BLSPubKey
+		copy(pubKey[:], data)
+		if err := checkPubKeys([]spec.BLSPubKey{pubKey}); err != nil {
+			return errors.Wrapf(err, "invalid validator public key %q", validatorID)
+		}
+
+		return nil
+	}
+	if _, err := strconv.ParseUint(validatorID, 10, 64); err != nil {
+		return fmt.Errorf("invalid validator ID %q", validatorID)
+	}
+
+	return nil
+}
@@ -0,0 +1,114 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"context"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidator(t *testing.T) {
+	validator := `{"data":{"index":"1","balance":"32000000000","status":"active_ongoing","validator":{"pubkey":"0xb89bebc699769726a318c8e9971bd3171297c61aea4a6578a7a4f94b547dcba5bac16a89108b6b6a1fe3695d1a874a0b","withdrawal_credentials":"0x00ec7ef7780c9d151597924036262dd28dc60e1228f4da6fecf9d402cb3f3594","effective_balance":"32000000000","slashed":false,"activation_eligibility_epoch":"0","activation_epoch":"0","exit_epoch":"18446744073709551615","withdrawable_epoch":"18446744073709551615"}}}`
+	server := newTestServer(t, map[string]string{
+		"/eth/v1/beacon/states/head/validators/1": validator,
+		"/eth/v1/beacon/states/head/validators/0xb89bebc699769726a318c8e9971bd3171297c61aea4a6578a7a4f94b547dcba5bac16a89108b6b6a1fe3695d1a874a0b": validator,
+	})
+	service, err := standardhttp.New(context.Background(),
+		standardhttp.WithTimeout(timeout),
+		standardhttp.WithAddress(server.URL),
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		stateID     string
+		validatorID string
+		err         string
+		notFound    bool
+	}{
+		{
+			name:        "Index",
+			stateID:     "head",
+			validatorID: "1",
+		},
+		{
+			name:        "PubKey",
+			stateID:     "head",
+			validatorID: "0xb89bebc699769726a318c8e9971bd3171297c61aea4a6578a7a4f94b547dcba5bac16a89108b6b6a1fe3695d1a874a0b",
+		},
+		{
+			name:        "StateIDMissing",
+			validatorID: "1",
+			err:         "no state ID specified",
+		},
+		{
+			name:    "IDMissing",
+			stateID: "head",
+			err:     `invalid validator ID ""`,
+		},
+		{
+			name:        "IDNegative",
+			stateID:     "head",
+			validatorID: "-1",
+			err:         `invalid validator ID "-1"`,
+		},
+		{
+			name:        "PubKeyShort",
+			stateID:     "head",
+			validatorID: "0xb89bebc699769726a318c8e9971bd3171297c61aea4a6578a7a4f94b547dcba5bac16a89108b6b6a1fe3695d1a874a",
+			err:         `invalid validator public key "0xb89bebc699769726a318c8e9971bd3171297c61aea4a6578a7a4f94b547dcba5bac16a89108b6b6a1fe3695d1a874a": incorrect length 47`,
+		},
+		{
+			name:        "PubKeyInvalid",
+			stateID:     "head",
+			validatorID: "0xzz9bebc699769726a318c8e9971bd3171297c61aea4a6578a7a4f94b547dcba5bac16a89108b6b6a1fe3695d1a874a0b",
+			err:         `invalid validator public key "0xzz9bebc699769726a318c8e9971bd3171297c61aea4a6578a7a4f94b547dcba5bac16a89108b6b6a1fe3695d1a874a0b": encoding/hex: invalid byte: U+007A 'z'`,
+		},
+		{
+			name:        "PubKeyZero",
+			stateID:     "head",
+			validatorID: "0x000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
+			err:         `invalid validator public key "0x000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000": public key 0 is zero`,
+		},
+		{
+			name:        "Unknown",
+			stateID:     "head",
+			validatorID: "2",
+			err:         "failed to obtain validator: not found",
+			notFound:    true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := service.Validator(context.Background(), test.stateID, test.validatorID)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				require.Equal(t, test.notFound, errors.Is(err, client.ErrNotFound))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, spec.ValidatorIndex(1), res.Index)
+			require.Equal(t, api.ValidatorStateActiveOngoing, res.Status)
+			require.Equal(t, spec.Gwei(32000000000), res.Balance)
+		})
+	}
+}
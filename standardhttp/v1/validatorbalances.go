@@ -15,10 +15,10 @@ package v1
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"strings"
 
+	client "github.com/attestantio/go-eth2-client"
 	api "github.com/attestantio/go-eth2-client/api/v1"
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
@@ -51,11 +51,11 @@ func (s *Service) ValidatorBalances(ctx context.Context, stateID string, validat
 		return nil, errors.Wrap(err, "failed to request validator balances")
 	}
 	if respBodyReader == nil {
-		return nil, errors.New("failed to obtain validator balances")
+		return nil, errors.Wrap(client.ErrNotFound, "failed to obtain validator balances")
 	}
 
 	var validatorBalancesJSON validatorBalancesJSON
-	if err := json.NewDecoder(respBodyReader).Decode(&validatorBalancesJSON); err != nil {
+	if err := s.decodeJSON(respBodyReader, &validatorBalancesJSON); err != nil {
 		return nil, errors.Wrap(err, "failed to parse validator balances")
 	}
 	if validatorBalancesJSON.Data == nil {
@@ -0,0 +1,76 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	client "github.com/attestantio/go-eth2-client"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// validatorBalancesAtSlotsConcurrency is the maximum number of slots for which balances are requested at the same time.
+const validatorBalancesAtSlotsConcurrency = 4
+
+// ValidatorBalancesAtSlots provides the balances of the given validators at each of the given slots.
+// If validatorIndices is empty balances are returned for all validators.
+// Balances are returned for each slot for which they could be obtained.  If balances at any slot could not be
+// obtained the returned error is a *client.ItemsError listing the failed slots.
+func (s *Service) ValidatorBalancesAtSlots(ctx context.Context,
+	slots []spec.Slot,
+	validatorIndices []spec.ValidatorIndex,
+) (
+	map[spec.Slot]map[spec.ValidatorIndex]spec.Gwei,
+	error,
+) {
+	if len(slots) == 0 {
+		return nil, errors.New("no slots specified")
+	}
+
+	// Only request each slot once.
+	uniqueSlots := make([]spec.Slot, 0, len(slots))
+	seen := make(map[spec.Slot]bool, len(slots))
+	for _, slot := range slots {
+		if !seen[slot] {
+			seen[slot] = true
+			uniqueSlots = append(uniqueSlots, slot)
+		}
+	}
+
+	slotBalances := make([]map[spec.ValidatorIndex]spec.Gwei, len(uniqueSlots))
+	slotErrs := make([]error, len(uniqueSlots))
+	forEachConcurrently(len(uniqueSlots), validatorBalancesAtSlotsConcurrency, func(i int) {
+		slotBalances[i], slotErrs[i] = s.ValidatorBalances(ctx, fmt.Sprintf("%d", uniqueSlots[i]), validatorIndices)
+	})
+
+	res := make(map[spec.Slot]map[spec.ValidatorIndex]spec.Gwei, len(uniqueSlots))
+	var failures []*client.ItemFailure
+	for i, slot := range uniqueSlots {
+		if slotErrs[i] != nil {
+			failures = append(failures, &client.ItemFailure{
+				Items: []uint64{uint64(slot)},
+				Err:   slotErrs[i],
+			})
+			continue
+		}
+		res[slot] = slotBalances[i]
+	}
+	if len(failures) > 0 {
+		return res, &client.ItemsError{ItemName: "slot", Failures: failures}
+	}
+
+	return res, nil
+}
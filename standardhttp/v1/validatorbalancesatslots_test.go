@@ -0,0 +1,78 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatorBalancesAtSlots(t *testing.T) {
+	var requests int32
+	balances := func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		parts := strings.Split(r.URL.Path, "/")
+		slot, _ := strconv.Atoi(parts[len(parts)-2])
+		if slot == 64 {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(`{"code":500,"message":"internal error"}`))
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"data":[{"index":"1","balance":"%d"},{"index":"2","balance":"%d"}]}`, 32000000000+slot, 31000000000+slot)
+	}
+	handlers := make(map[string]http.HandlerFunc)
+	for _, slot := range []int{32, 64, 96} {
+		handlers[fmt.Sprintf("/eth/v1/beacon/states/%d/validator_balances", slot)] = balances
+	}
+	server := newTestServerWithHandlers(t, handlers)
+
+	service, err := standardhttp.New(context.Background(),
+		standardhttp.WithTimeout(timeout),
+		standardhttp.WithAddress(server.URL),
+	)
+	require.NoError(t, err)
+
+	_, err = service.ValidatorBalancesAtSlots(context.Background(), nil, nil)
+	require.EqualError(t, err, "no slots specified")
+
+	res, err := service.ValidatorBalancesAtSlots(context.Background(), []spec.Slot{32, 64, 96, 32}, []spec.ValidatorIndex{1, 2})
+	require.EqualError(t, err, "failed to obtain 1 of the requested slots: slot 64: failed to request validator balances: GET failed with status 500: internal error")
+	var balancesErr *client.ItemsError
+	require.True(t, errors.As(err, &balancesErr))
+	require.Len(t, balancesErr.Failures, 1)
+	require.Equal(t, []uint64{64}, balancesErr.Failures[0].Items)
+	// The per-slot cause is reachable.
+	var serverErr *client.ServerError
+	require.True(t, errors.As(err, &serverErr))
+
+	// Duplicate slots are only requested once.
+	require.Equal(t, int32(3), atomic.LoadInt32(&requests))
+
+	// Balances at successful slots are returned.
+	require.Equal(t, map[spec.Slot]map[spec.ValidatorIndex]spec.Gwei{
+		32: {1: 32000000032, 2: 31000000032},
+		96: {1: 32000000096, 2: 31000000096},
+	}, res)
+}
@@ -0,0 +1,68 @@
+// Copyright © 2020 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+type validatorLivenessJSON struct {
+	Data []*validatorLivenessDataJSON `json:"data"`
+}
+
+type validatorLivenessDataJSON struct {
+	Index  spec.ValidatorIndex `json:"index"`
+	Epoch  spec.Epoch          `json:"epoch"`
+	IsLive bool                `json:"is_live"`
+}
+
+// ValidatorLiveness provides the liveness of the given validators at the given epoch, indicating if they were
+// seen to be active by the node.
+func (s *Service) ValidatorLiveness(ctx context.Context, epoch spec.Epoch, validatorIndices []spec.ValidatorIndex) (map[spec.ValidatorIndex]bool, error) {
+	currentEpoch, err := s.currentEpoch(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain current epoch")
+	}
+	if epoch > currentEpoch {
+		return nil, fmt.Errorf("epoch %d is after current epoch %d", epoch, currentEpoch)
+	}
+
+	var reqBodyReader bytes.Buffer
+	if err := json.NewEncoder(&reqBodyReader).Encode(validatorIndices); err != nil {
+		return nil, errors.Wrap(err, "failed to encode validator indices")
+	}
+
+	respBodyReader, err := s.post(ctx, fmt.Sprintf("/eth/v1/validator/liveness/%d", epoch), &reqBodyReader)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request validator liveness")
+	}
+
+	var resp validatorLivenessJSON
+	if err := s.decodeJSON(respBodyReader, &resp); err != nil {
+		return nil, errors.Wrap(err, "failed to parse validator liveness response")
+	}
+
+	res := make(map[spec.ValidatorIndex]bool, len(resp.Data))
+	for _, liveness := range resp.Data {
+		res[liveness.Index] = liveness.IsLive
+	}
+
+	return res, nil
+}
@@ -0,0 +1,79 @@
+// Copyright © 2020 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatorLiveness(t *testing.T) {
+	// Genesis time, slot duration and slots per epoch match the static responses.
+	currentEpoch := spec.Epoch(uint64(time.Since(time.Unix(1606824023, 0))/(12*time.Second)) / 32)
+
+	tests := []struct {
+		name     string
+		epoch    spec.Epoch
+		expected map[spec.ValidatorIndex]bool
+		err      string
+	}{
+		{
+			name:  "Good",
+			epoch: currentEpoch - 1,
+			expected: map[spec.ValidatorIndex]bool{
+				1: true,
+				2: false,
+			},
+		},
+		{
+			name:  "FutureEpoch",
+			epoch: currentEpoch + 1,
+			err:   fmt.Sprintf("epoch %d is after current epoch %d", currentEpoch+1, currentEpoch),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := newTestServerWithHandlers(t, map[string]http.HandlerFunc{
+				fmt.Sprintf("/eth/v1/validator/liveness/%d", test.epoch): func(w http.ResponseWriter, r *http.Request) {
+					body, err := ioutil.ReadAll(r.Body)
+					require.NoError(t, err)
+					require.JSONEq(t, `["1","2"]`, string(body))
+					fmt.Fprintf(w, `{"data":[{"index":"1","epoch":"%d","is_live":true},{"index":"2","epoch":"%d","is_live":false}]}`, test.epoch, test.epoch)
+				},
+			})
+			service, err := standardhttp.New(context.Background(),
+				standardhttp.WithTimeout(timeout),
+				standardhttp.WithAddress(server.URL),
+			)
+			require.NoError(t, err)
+
+			liveness, err := service.ValidatorLiveness(context.Background(), test.epoch, []spec.ValidatorIndex{1, 2})
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expected, liveness)
+		})
+	}
+}
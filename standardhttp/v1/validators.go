@@ -15,10 +15,10 @@ package v1
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"strings"
 
+	client "github.com/attestantio/go-eth2-client"
 	api "github.com/attestantio/go-eth2-client/api/v1"
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
@@ -31,18 +31,88 @@ type validatorsJSON struct {
 // Validators provides the validators, with their balance and status, for a given state.
 // stateID can be a slot number or state root, or one of the special values "genesis", "head", "justified" or "finalized".
 // validatorIDs is a list of validators to restrict the returned values.  If no validators are supplied no filter will be applied.
+// If there are more validators than can be requested in a single call and some calls fail, the validators that could be
+// obtained are returned along with a *client.ItemsError.
 func (s *Service) Validators(ctx context.Context, stateID string, validatorIDs []spec.ValidatorIndex) (map[spec.ValidatorIndex]*api.Validator, error) {
+	return s.validators(ctx, stateID, validatorIDs, nil)
+}
+
+// ValidatorsWithStatuses provides the validators, with their balance and status, for a given state, restricted to
+// those with the given statuses.
+// stateID can be a slot number or state root, or one of the special values "genesis", "head", "justified" or "finalized".
+// validatorIDs is a list of validators to restrict the returned values.  If no validators are supplied no filter will be applied.
+// statuses is a list of statuses to restrict the returned values.  These can be individual states such as "active_ongoing"
+// or the general states "pending", "active", "exited" and "withdrawal".  If no statuses are supplied no filter will be applied.
+func (s *Service) ValidatorsWithStatuses(ctx context.Context,
+	stateID string,
+	validatorIDs []spec.ValidatorIndex,
+	statuses []string,
+) (map[spec.ValidatorIndex]*api.Validator, error) {
+	return s.validators(ctx, stateID, validatorIDs, statuses)
+}
+
+// validators fetches validators, splitting the request in to chunks if there are too many validator IDs for a
+// single call.  If some chunks cannot be obtained the validators from the remaining chunks are returned, along with
+// a *client.ItemsError listing the validators that could not be obtained.
+func (s *Service) validators(ctx context.Context,
+	stateID string,
+	validatorIDs []spec.ValidatorIndex,
+	statuses []string,
+) (map[spec.ValidatorIndex]*api.Validator, error) {
 	if stateID == "" {
 		return nil, errors.New("no state ID specified")
 	}
 
-	url := fmt.Sprintf("/eth/v1/beacon/states/%s/validators", stateID)
-	if len(validatorIDs) != 0 {
-		ids := make([]string, len(validatorIDs))
-		for i := range validatorIDs {
-			ids[i] = fmt.Sprintf("%d", validatorIDs[i])
+	if len(validatorIDs) <= s.validatorsChunkSize {
+		return s.validatorsChunk(ctx, stateID, validatorIDs, statuses)
+	}
+
+	res := make(map[spec.ValidatorIndex]*api.Validator, len(validatorIDs))
+	var failures []*client.ItemFailure
+	for start := 0; start < len(validatorIDs); start += s.validatorsChunkSize {
+		end := start + s.validatorsChunkSize
+		if end > len(validatorIDs) {
+			end = len(validatorIDs)
+		}
+		chunk, err := s.validatorsChunk(ctx, stateID, validatorIDs[start:end], statuses)
+		if err != nil {
+			items := make([]uint64, end-start)
+			for i := range items {
+				items[i] = uint64(validatorIDs[start+i])
+			}
+			failures = append(failures, &client.ItemFailure{
+				Items: items,
+				Err:   err,
+			})
+			continue
+		}
+		for index, validator := range chunk {
+			res[index] = validator
 		}
-		url = fmt.Sprintf("%s?id=%s", url, strings.Join(ids, "&id="))
+	}
+	if len(failures) > 0 {
+		return res, &client.ItemsError{ItemName: "validator", Failures: failures}
+	}
+
+	return res, nil
+}
+
+// validatorsChunk fetches validators in a single call.
+func (s *Service) validatorsChunk(ctx context.Context,
+	stateID string,
+	validatorIDs []spec.ValidatorIndex,
+	statuses []string,
+) (map[spec.ValidatorIndex]*api.Validator, error) {
+	url := fmt.Sprintf("/eth/v1/beacon/states/%s/validators", stateID)
+	params := make([]string, 0, len(validatorIDs)+len(statuses))
+	for i := range validatorIDs {
+		params = append(params, fmt.Sprintf("id=%d", validatorIDs[i]))
+	}
+	for i := range statuses {
+		params = append(params, fmt.Sprintf("status=%s", statuses[i]))
+	}
+	if len(params) != 0 {
+		url = fmt.Sprintf("%s?%s", url, strings.Join(params, "&"))
 	}
 
 	respBodyReader, err := s.get(ctx, url)
@@ -50,20 +120,39 @@ func (s *Service) Validators(ctx context.Context, stateID string, validatorIDs [
 		return nil, errors.Wrap(err, "failed to request validators")
 	}
 	if respBodyReader == nil {
-		return nil, errors.New("failed to obtain validators")
+		return nil, errors.Wrap(client.ErrNotFound, "failed to obtain validators")
 	}
 
-	var validatorsJSON validatorsJSON
-	if err := json.NewDecoder(respBodyReader).Decode(&validatorsJSON); err != nil {
+	validators, err := s.decodeValidators(respBodyReader)
+	if err != nil {
 		return nil, errors.Wrap(err, "failed to parse validators")
 	}
-	if validatorsJSON.Data == nil {
+	if validators == nil {
 		return nil, errors.New("no validators returned")
 	}
 
 	res := make(map[spec.ValidatorIndex]*api.Validator)
-	for _, validator := range validatorsJSON.Data {
+	for _, validator := range validators {
+		if !validatorHasStatus(validator, statuses) {
+			// Filter here as well, in case the node does not support filtering by status.
+			continue
+		}
 		res[validator.Index] = validator
 	}
 	return res, nil
 }
+
+// validatorHasStatus returns true if the validator has one of the supplied statuses, or if no statuses are supplied.
+func validatorHasStatus(validator *api.Validator, statuses []string) bool {
+	if len(statuses) == 0 {
+		return true
+	}
+	state := strings.ToLower(validator.Status.String())
+	for _, status := range statuses {
+		status = strings.ToLower(status)
+		if state == status || strings.HasPrefix(state, fmt.Sprintf("%s_", status)) {
+			return true
+		}
+	}
+	return false
+}
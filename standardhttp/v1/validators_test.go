@@ -16,14 +16,21 @@ package v1_test
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
+	"sync/atomic"
 	"testing"
 
+	client "github.com/attestantio/go-eth2-client"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 )
 
 func TestValidators(t *testing.T) {
+	skipWithoutNode(t)
+
 	tests := []struct {
 		name              string
 		stateID           string
@@ -75,3 +82,40 @@ func TestValidators(t *testing.T) {
 		})
 	}
 }
+
+func TestValidatorsPartial(t *testing.T) {
+	var requests int32
+	handler := validatorsHandler(t, false, &requests)
+	server := newTestServerWithHandlers(t, map[string]http.HandlerFunc{
+		"/eth/v1/beacon/states/head/validators": func(w http.ResponseWriter, r *http.Request) {
+			for _, id := range r.URL.Query()["id"] {
+				if id == "4" {
+					w.WriteHeader(http.StatusInternalServerError)
+					_, _ = w.Write([]byte(`{"code":500,"message":"internal error"}`))
+					return
+				}
+			}
+			handler(w, r)
+		},
+	})
+	service, err := standardhttp.New(context.Background(),
+		standardhttp.WithTimeout(timeout),
+		standardhttp.WithAddress(server.URL),
+		standardhttp.WithValidatorsChunkSize(3),
+	)
+	require.NoError(t, err)
+
+	validators, err := service.Validators(context.Background(), "head", []spec.ValidatorIndex{0, 1, 2, 3, 4, 5, 6, 7})
+	require.EqualError(t, err, "failed to obtain 3 of the requested validators: 3 validators from 3: failed to request validators: GET failed with status 500: internal error")
+	var validatorsErr *client.ItemsError
+	require.True(t, errors.As(err, &validatorsErr))
+	require.Len(t, validatorsErr.Failures, 1)
+	require.Equal(t, []uint64{3, 4, 5}, validatorsErr.Failures[0].Items)
+
+	// Validators from successful chunks are returned.
+	require.Len(t, validators, 5)
+	for _, index := range []spec.ValidatorIndex{0, 1, 2, 6, 7} {
+		require.Contains(t, validators, index)
+	}
+	require.Equal(t, int32(3), atomic.LoadInt32(&requests)+1)
+}
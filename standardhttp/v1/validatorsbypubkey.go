@@ -15,10 +15,10 @@ package v1
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"strings"
 
+	client "github.com/attestantio/go-eth2-client"
 	api "github.com/attestantio/go-eth2-client/api/v1"
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
@@ -36,12 +36,15 @@ func (s *Service) ValidatorsByPubKey(ctx context.Context, stateID string, valida
 	if stateID == "" {
 		return nil, errors.New("no state ID specified")
 	}
+	if err := checkPubKeys(validatorPubKeys); err != nil {
+		return nil, errors.Wrap(err, "invalid validator public keys")
+	}
 
 	url := fmt.Sprintf("/eth/v1/beacon/states/%s/validators", stateID)
 	if len(validatorPubKeys) != 0 {
 		ids := make([]string, len(validatorPubKeys))
 		for i := range validatorPubKeys {
-			ids[i] = fmt.Sprintf("%#x", validatorPubKeys[i])
+			ids[i] = hexParam(validatorPubKeys[i][:])
 		}
 		url = fmt.Sprintf("%s?id=%s", url, strings.Join(ids, "&id="))
 	}
@@ -51,11 +54,11 @@ func (s *Service) ValidatorsByPubKey(ctx context.Context, stateID string, valida
 		return nil, errors.Wrap(err, "failed to request validators")
 	}
 	if respBodyReader == nil {
-		return nil, errors.New("failed to obtain validators")
+		return nil, errors.Wrap(client.ErrNotFound, "failed to obtain validators")
 	}
 
 	var validatorsByPubKeyJSON validatorsByPubKeyJSON
-	if err := json.NewDecoder(respBodyReader).Decode(&validatorsByPubKeyJSON); err != nil {
+	if err := s.decodeJSON(respBodyReader, &validatorsByPubKeyJSON); err != nil {
 		return nil, errors.Wrap(err, "failed to parse validators")
 	}
 	if validatorsByPubKeyJSON.Data == nil {
@@ -16,14 +16,18 @@ package v1_test
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"testing"
 
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
 	"github.com/stretchr/testify/require"
 )
 
 func TestValidatorsByPubKey(t *testing.T) {
+	skipWithoutNode(t)
+
 	tests := []struct {
 		name              string
 		stateID           string
@@ -70,3 +74,23 @@ func TestValidatorsByPubKey(t *testing.T) {
 		})
 	}
 }
+
+func TestValidatorsByPubKeyZero(t *testing.T) {
+	server := newTestServerWithHandlers(t, map[string]http.HandlerFunc{
+		"/eth/v1/beacon/states/head/validators": func(w http.ResponseWriter, r *http.Request) {
+			t.Error("request should not have been sent")
+		},
+	})
+	service, err := standardhttp.New(context.Background(),
+		standardhttp.WithTimeout(timeout),
+		standardhttp.WithAddress(server.URL),
+	)
+	require.NoError(t, err)
+
+	pubKeys := []spec.BLSPubKey{
+		{0xb8, 0x9b, 0xeb, 0xc6},
+		{},
+	}
+	_, err = service.ValidatorsByPubKey(context.Background(), "head", pubKeys)
+	require.EqualError(t, err, "invalid validator public keys: public key 1 is zero")
+}
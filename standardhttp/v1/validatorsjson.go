@@ -0,0 +1,412 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"math"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// decodeValidators decodes the validators in a validators response.
+// If fast validators parsing is enabled the response is first parsed by hand, falling back to the standard decoder
+// for anything the hand-written parser does not handle.  This ensures that results and errors are the same either way.
+func (s *Service) decodeValidators(respBodyReader io.Reader) ([]*api.Validator, error) {
+	if s.fastValidatorsParsing && !s.strictJSON {
+		raw, err := ioutil.ReadAll(respBodyReader)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read response")
+		}
+		if validators, parsed := parseValidatorsJSON(raw); parsed {
+			return validators, nil
+		}
+		s.log.Trace().Msg("Validators response not handled by fast parser; using standard decoder")
+		respBodyReader = bytes.NewReader(raw)
+	}
+
+	var validatorsJSON validatorsJSON
+	if err := s.decodeJSON(respBodyReader, &validatorsJSON); err != nil {
+		return nil, err
+	}
+	return validatorsJSON.Data, nil
+}
+
+var (
+	validatorsResponseJSONFields = []string{"data"}
+	validatorJSONFields          = []string{"index", "balance", "status", "validator"}
+	specValidatorJSONFields      = []string{
+		"pubkey",
+		"withdrawal_credentials",
+		"effective_balance",
+		"slashed",
+		"activation_eligibility_epoch",
+		"activation_epoch",
+		"exit_epoch",
+		"withdrawable_epoch",
+	}
+)
+
+// parseValidatorsJSON parses a validators response without reflection.
+// It only handles well-formed responses, returning false for anything that it does not handle, such as missing fields,
+// invalid values or escaped strings, in which case the response should be decoded by the standard decoder.
+func parseValidatorsJSON(data []byte) ([]*api.Validator, bool) {
+	if !json.Valid(data) {
+		return nil, false
+	}
+
+	scanner := &jsonScanner{data: data}
+	// Parsing states is relatively expensive and there are few distinct values, so cache them.
+	states := make(map[string]api.ValidatorState)
+	var validators []*api.Validator
+	seen := uint(0)
+	parsed := scanner.object(func(key []byte) bool {
+		field, known := matchField(key, validatorsResponseJSONFields)
+		if !known {
+			return false
+		}
+		if field == -1 {
+			scanner.skipValue()
+			return true
+		}
+		if seen&(1<<field) != 0 {
+			return false
+		}
+		seen |= 1 << field
+
+		var parsed bool
+		validators, parsed = parseValidatorsList(scanner, states)
+		return parsed
+	})
+	if !parsed || seen != 1 {
+		return nil, false
+	}
+
+	return validators, true
+}
+
+// parseValidatorsList parses the list of validators.
+func parseValidatorsList(scanner *jsonScanner, states map[string]api.ValidatorState) ([]*api.Validator, bool) {
+	if !scanner.consume('[') {
+		return nil, false
+	}
+	validators := make([]*api.Validator, 0)
+	if scanner.consume(']') {
+		return validators, true
+	}
+	for {
+		validator, parsed := parseValidator(scanner, states)
+		if !parsed {
+			return nil, false
+		}
+		validators = append(validators, validator)
+		if scanner.consume(',') {
+			continue
+		}
+		return validators, scanner.consume(']')
+	}
+}
+
+// parseValidator parses a single validator.
+func parseValidator(scanner *jsonScanner, states map[string]api.ValidatorState) (*api.Validator, bool) {
+	validator := &api.Validator{}
+	seen := uint(0)
+	parsed := scanner.object(func(key []byte) bool {
+		field, known := matchField(key, validatorJSONFields)
+		if !known {
+			return false
+		}
+		if field == -1 {
+			scanner.skipValue()
+			return true
+		}
+		if seen&(1<<field) != 0 {
+			return false
+		}
+		seen |= 1 << field
+
+		switch field {
+		case 0:
+			index, parsed := scanner.uint64String()
+			validator.Index = spec.ValidatorIndex(index)
+			return parsed
+		case 1:
+			balance, parsed := scanner.uint64String()
+			validator.Balance = spec.Gwei(balance)
+			return parsed
+		case 2:
+			status, parsed := scanner.str()
+			if !parsed {
+				return false
+			}
+			state, exists := states[string(status)]
+			if !exists {
+				var err error
+				state, err = api.ParseValidatorState(string(status))
+				if err != nil {
+					return false
+				}
+				states[string(status)] = state
+			}
+			validator.Status = state
+			return true
+		default:
+			var parsed bool
+			validator.Validator, parsed = parseSpecValidator(scanner)
+			return parsed
+		}
+	})
+	// Status is optional; all other fields are required.
+	if !parsed || seen|(1<<2) != 1<<len(validatorJSONFields)-1 {
+		return nil, false
+	}
+
+	return validator, true
+}
+
+// parseSpecValidator parses the spec validator within a validator.
+func parseSpecValidator(scanner *jsonScanner) (*spec.Validator, bool) {
+	validator := &spec.Validator{}
+	seen := uint(0)
+	parsed := scanner.object(func(key []byte) bool {
+		field, known := matchField(key, specValidatorJSONFields)
+		if !known {
+			return false
+		}
+		if field == -1 {
+			scanner.skipValue()
+			return true
+		}
+		if seen&(1<<field) != 0 {
+			return false
+		}
+		seen |= 1 << field
+
+		var parsed bool
+		switch field {
+		case 0:
+			parsed = scanner.hexString(validator.PublicKey[:])
+		case 1:
+			validator.WithdrawalCredentials = make([]byte, spec.HashLength)
+			parsed = scanner.hexString(validator.WithdrawalCredentials)
+		case 2:
+			var effectiveBalance uint64
+			effectiveBalance, parsed = scanner.uint64String()
+			validator.EffectiveBalance = spec.Gwei(effectiveBalance)
+		case 3:
+			validator.Slashed, parsed = scanner.boolean()
+		case 4:
+			var epoch uint64
+			epoch, parsed = scanner.uint64String()
+			validator.ActivationEligibilityEpoch = spec.Epoch(epoch)
+		case 5:
+			var epoch uint64
+			epoch, parsed = scanner.uint64String()
+			validator.ActivationEpoch = spec.Epoch(epoch)
+		case 6:
+			var epoch uint64
+			epoch, parsed = scanner.uint64String()
+			validator.ExitEpoch = spec.Epoch(epoch)
+		default:
+			var epoch uint64
+			epoch, parsed = scanner.uint64String()
+			validator.WithdrawableEpoch = spec.Epoch(epoch)
+		}
+		return parsed
+	})
+	// Slashed is optional; all other fields are required.
+	if !parsed || seen|(1<<3) != 1<<len(specValidatorJSONFields)-1 {
+		return nil, false
+	}
+
+	return validator, true
+}
+
+// matchField returns the index of the key in the list of fields, or -1 if it is not present.
+// The standard decoder matches fields case-insensitively, so keys that differ from a field only by case are
+// reported as not known, as they cannot be handled without reference to the standard decoder.
+func matchField(key []byte, fields []string) (int, bool) {
+	for i := range fields {
+		if string(key) == fields[i] {
+			return i, true
+		}
+		if bytes.EqualFold(key, []byte(fields[i])) {
+			return -1, false
+		}
+	}
+	return -1, true
+}
+
+// jsonScanner scans JSON that is already known to be valid.
+type jsonScanner struct {
+	data []byte
+	pos  int
+}
+
+// skipWhitespace skips any whitespace.
+func (s *jsonScanner) skipWhitespace() {
+	for s.pos < len(s.data) {
+		switch s.data[s.pos] {
+		case ' ', '\t', '\n', '\r':
+			s.pos++
+		default:
+			return
+		}
+	}
+}
+
+// consume consumes the next character if it matches the supplied character, returning true if so.
+func (s *jsonScanner) consume(c byte) bool {
+	s.skipWhitespace()
+	if s.pos < len(s.data) && s.data[s.pos] == c {
+		s.pos++
+		return true
+	}
+	return false
+}
+
+// object scans an object, calling the supplied function with each key.  The function must consume the value.
+func (s *jsonScanner) object(fn func(key []byte) bool) bool {
+	if !s.consume('{') {
+		return false
+	}
+	if s.consume('}') {
+		return true
+	}
+	for {
+		key, parsed := s.str()
+		if !parsed || !s.consume(':') || !fn(key) {
+			return false
+		}
+		if s.consume(',') {
+			continue
+		}
+		return s.consume('}')
+	}
+}
+
+// str returns the contents of a string.  Strings containing escapes are not handled.
+func (s *jsonScanner) str() ([]byte, bool) {
+	if !s.consume('"') {
+		return nil, false
+	}
+	start := s.pos
+	for ; s.pos < len(s.data); s.pos++ {
+		switch s.data[s.pos] {
+		case '"':
+			s.pos++
+			return s.data[start : s.pos-1], true
+		case '\\':
+			return nil, false
+		}
+	}
+	return nil, false
+}
+
+// boolean returns the value of a boolean.
+func (s *jsonScanner) boolean() (bool, bool) {
+	s.skipWhitespace()
+	switch {
+	case bytes.HasPrefix(s.data[s.pos:], []byte("true")):
+		s.pos += 4
+		return true, true
+	case bytes.HasPrefix(s.data[s.pos:], []byte("false")):
+		s.pos += 5
+		return false, true
+	default:
+		return false, false
+	}
+}
+
+// uint64String returns the value of a string containing a decimal unsigned integer.
+func (s *jsonScanner) uint64String() (uint64, bool) {
+	str, parsed := s.str()
+	if !parsed || len(str) == 0 {
+		return 0, false
+	}
+	val := uint64(0)
+	for _, c := range str {
+		if c < '0' || c > '9' {
+			return 0, false
+		}
+		digit := uint64(c - '0')
+		if val > (math.MaxUint64-digit)/10 {
+			return 0, false
+		}
+		val = val*10 + digit
+	}
+	return val, true
+}
+
+// hexString decodes a string containing hex, with optional 0x prefix, in to the supplied buffer.
+// The decoded value must be exactly the length of the buffer.
+func (s *jsonScanner) hexString(buf []byte) bool {
+	str, parsed := s.str()
+	if !parsed {
+		return false
+	}
+	str = bytes.TrimPrefix(str, []byte("0x"))
+	if len(str) != hex.EncodedLen(len(buf)) {
+		return false
+	}
+	_, err := hex.Decode(buf, str)
+	return err == nil
+}
+
+// skipValue skips a value.
+func (s *jsonScanner) skipValue() {
+	s.skipWhitespace()
+	depth := 0
+	for s.pos < len(s.data) {
+		switch s.data[s.pos] {
+		case '"':
+			s.pos++
+			for s.pos < len(s.data) && s.data[s.pos] != '"' {
+				if s.data[s.pos] == '\\' {
+					s.pos++
+				}
+				s.pos++
+			}
+			s.pos++
+		case '{', '[':
+			depth++
+			s.pos++
+			continue
+		case '}', ']':
+			if depth == 0 {
+				return
+			}
+			depth--
+			s.pos++
+		case ',', ' ', '\t', '\n', '\r':
+			if depth == 0 {
+				return
+			}
+			s.pos++
+			continue
+		default:
+			s.pos++
+			continue
+		}
+		if depth == 0 {
+			return
+		}
+	}
+}
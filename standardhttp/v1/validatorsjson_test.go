@@ -0,0 +1,300 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// testValidatorJSON is a single validator as returned by the validators endpoint.
+const testValidatorJSON = `{"index":"1","balance":"32000000000","status":"active_ongoing","validator":{"pubkey":"0xb89bebc699769726a318c8e9971bd3171297c61aea4a6578a7a4f94b547dcba5bac16a89108b6b6a1fe3695d1a874a0b","withdrawal_credentials":"0x00ec7ef7780c9d151597924036262dd28dc60e1228f4da6fecf9d402cb3f3594","effective_balance":"32000000000","slashed":false,"activation_eligibility_epoch":"0","activation_epoch":"0","exit_epoch":"18446744073709551615","withdrawable_epoch":"18446744073709551615"}}`
+
+// validatorsFixture generates a validators response containing the given number of validators.
+func validatorsFixture(count int) []byte {
+	statuses := []string{"active_ongoing", "pending_queued", "active_exiting", "exited_slashed", "withdrawal_done", "Active_ongoing", "active-ongoing"}
+	buf := bytes.NewBufferString(`{"execution_optimistic":false,"data":[`)
+	for i := 0; i < count; i++ {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		exitEpoch := "18446744073709551615"
+		if i%11 == 0 {
+			exitEpoch = fmt.Sprintf("%d", 1000+i)
+		}
+		fmt.Fprintf(buf, `{"index":"%d","balance":"%d","status":"%s","validator":{"pubkey":"0x%096x","withdrawal_credentials":"0x%064x","effective_balance":"%d","slashed":%t,"activation_eligibility_epoch":"%d","activation_epoch":"%d","exit_epoch":"%s","withdrawable_epoch":"%s"}}`,
+			i,
+			32000000000+i,
+			statuses[i%len(statuses)],
+			i,
+			i*31,
+			32000000000-(i%3)*1000000000,
+			i%7 == 0,
+			i/4,
+			i/2,
+			exitEpoch,
+			exitEpoch,
+		)
+	}
+	buf.WriteString(`]}`)
+	return buf.Bytes()
+}
+
+// withValidator returns a validators response containing the single validator, with the given replacement applied.
+func withValidator(old string, new string) string {
+	return `{"data":[` + strings.Replace(testValidatorJSON, old, new, 1) + `]}`
+}
+
+func TestParseValidatorsJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		fast  bool
+	}{
+		{
+			name:  "Empty",
+			input: `{"data":[]}`,
+			fast:  true,
+		},
+		{
+			name:  "Single",
+			input: `{"data":[` + testValidatorJSON + `]}`,
+			fast:  true,
+		},
+		{
+			name:  "Fixture",
+			input: string(validatorsFixture(1000)),
+			fast:  true,
+		},
+		{
+			name:  "Whitespace",
+			input: " {\n  \"data\" : [\n\t" + strings.ReplaceAll(strings.ReplaceAll(testValidatorJSON, ":", " : "), ",", " ,\r\n ") + " ]\n} ",
+			fast:  true,
+		},
+		{
+			name:  "UnknownFields",
+			input: `{"meta":{"a":[1,"b\"]",{"c":null}]},"data":[` + strings.Replace(strings.Replace(testValidatorJSON, `"index"`, `"extra":[{"x":"}"}],"index"`, 1), `"pubkey"`, `"extra":true,"pubkey"`, 1) + `],"more":-1.5e3}`,
+			fast:  true,
+		},
+		{
+			name:  "StatusMissing",
+			input: withValidator(`"status":"active_ongoing",`, ``),
+			fast:  true,
+		},
+		{
+			name:  "StatusAlternative",
+			input: withValidator(`"active_ongoing"`, `"Active Ongoing"`),
+			fast:  true,
+		},
+		{
+			name:  "SlashedMissing",
+			input: withValidator(`"slashed":false,`, ``),
+			fast:  true,
+		},
+		{
+			name:  "Slashed",
+			input: withValidator(`"slashed":false`, `"slashed":true`),
+			fast:  true,
+		},
+		{
+			name:  "LeadingZeros",
+			input: withValidator(`"index":"1"`, `"index":"007"`),
+			fast:  true,
+		},
+		{
+			name:  "NoPrefix",
+			input: withValidator(`"0xb89b`, `"b89b`),
+			fast:  true,
+		},
+		{
+			name:  "UpperCaseHex",
+			input: withValidator(`"0xb89bebc6`, `"0xB89BEBC6`),
+			fast:  true,
+		},
+		{
+			name:  "DataMissing",
+			input: `{}`,
+		},
+		{
+			name:  "DataNull",
+			input: `{"data":null}`,
+		},
+		{
+			name:  "DataDuplicate",
+			input: `{"data":[],"data":[` + testValidatorJSON + `]}`,
+		},
+		{
+			name:  "DataCase",
+			input: `{"Data":[` + testValidatorJSON + `]}`,
+		},
+		{
+			name:  "ValidatorNull",
+			input: `{"data":[null]}`,
+		},
+		{
+			name:  "FieldCase",
+			input: withValidator(`"index"`, `"Index"`),
+		},
+		{
+			name:  "FieldDuplicate",
+			input: withValidator(`"index":"1"`, `"index":"1","balance":"2"`),
+		},
+		{
+			name:  "SpecFieldCase",
+			input: withValidator(`"pubkey"`, `"PubKey"`),
+		},
+		{
+			name:  "IndexMissing",
+			input: withValidator(`"index":"1",`, ``),
+		},
+		{
+			name:  "IndexEmpty",
+			input: withValidator(`"index":"1"`, `"index":""`),
+		},
+		{
+			name:  "IndexNegative",
+			input: withValidator(`"index":"1"`, `"index":"-1"`),
+		},
+		{
+			name:  "IndexOverflow",
+			input: withValidator(`"index":"1"`, `"index":"18446744073709551616"`),
+		},
+		{
+			name:  "IndexUnquoted",
+			input: withValidator(`"index":"1"`, `"index":1`),
+		},
+		{
+			name:  "IndexEscaped",
+			input: withValidator(`"index":"1"`, `"index":"\u0031"`),
+		},
+		{
+			name:  "StatusUnknown",
+			input: withValidator(`"active_ongoing"`, `"unknown_state"`),
+		},
+		{
+			name:  "StatusNumber",
+			input: withValidator(`"active_ongoing"`, `1`),
+		},
+		{
+			name:  "SpecValidatorMissing",
+			input: `{"data":[{"index":"1","balance":"32000000000","status":"active_ongoing"}]}`,
+		},
+		{
+			name:  "SpecValidatorNull",
+			input: `{"data":[{"index":"1","balance":"32000000000","status":"active_ongoing","validator":null}]}`,
+		},
+		{
+			name:  "PubKeyShort",
+			input: withValidator(`"0xb89b`, `"0x9b`),
+		},
+		{
+			name:  "PubKeyOdd",
+			input: withValidator(`"0xb89b`, `"0xb9b`),
+		},
+		{
+			name:  "PubKeyInvalid",
+			input: withValidator(`"0xb89b`, `"0xzz9b`),
+		},
+		{
+			name:  "WithdrawalCredentialsLong",
+			input: withValidator(`"0x00ec`, `"0x0000ec`),
+		},
+		{
+			name:  "SlashedNull",
+			input: withValidator(`"slashed":false`, `"slashed":null`),
+		},
+		{
+			name:  "SlashedString",
+			input: withValidator(`"slashed":false`, `"slashed":"false"`),
+		},
+		{
+			name:  "EpochMissing",
+			input: withValidator(`"activation_epoch":"0",`, ``),
+		},
+		{
+			name:  "TrailingData",
+			input: `{"data":[` + testValidatorJSON + `]}{}`,
+		},
+		{
+			name:  "InvalidJSON",
+			input: `{"data":[` + testValidatorJSON + `}`,
+		},
+		{
+			name:  "NotObject",
+			input: `[` + testValidatorJSON + `]`,
+		},
+	}
+
+	reflectService := &Service{}
+	fastService := &Service{fastValidatorsParsing: true}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, parsed := parseValidatorsJSON([]byte(test.input))
+			require.Equal(t, test.fast, parsed)
+
+			// Results and errors must be the same regardless of the parser.
+			expected, expectedErr := reflectService.decodeValidators(strings.NewReader(test.input))
+			res, err := fastService.decodeValidators(strings.NewReader(test.input))
+			if expectedErr != nil {
+				require.EqualError(t, err, expectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, expected, res)
+		})
+	}
+}
+
+func TestDecodeValidatorsStrict(t *testing.T) {
+	service := &Service{fastValidatorsParsing: true, strictJSON: true}
+	_, err := service.decodeValidators(strings.NewReader(withValidator(`"index"`, `"extra":true,"index"`)))
+	require.EqualError(t, err, "unknown field data[0].extra")
+}
+
+func BenchmarkDecodeValidators(b *testing.B) {
+	fixture := validatorsFixture(300000)
+	benchmarks := []struct {
+		name    string
+		service *Service
+	}{
+		{
+			name:    "Reflect",
+			service: &Service{},
+		},
+		{
+			name:    "Fast",
+			service: &Service{fastValidatorsParsing: true},
+		},
+	}
+
+	for _, benchmark := range benchmarks {
+		b.Run(benchmark.name, func(b *testing.B) {
+			b.SetBytes(int64(len(fixture)))
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				validators, err := benchmark.service.decodeValidators(bytes.NewReader(fixture))
+				if err != nil {
+					b.Fatal(err)
+				}
+				if len(validators) != 300000 {
+					b.Fatalf("unexpected number of validators %d", len(validators))
+				}
+			}
+		})
+	}
+}
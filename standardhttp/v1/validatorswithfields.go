@@ -0,0 +1,145 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	client "github.com/attestantio/go-eth2-client"
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// validatorFields are the fields that can be selected when fetching validators.
+var validatorFields = map[string]bool{
+	"balance":   true,
+	"status":    true,
+	"validator": true,
+}
+
+// partialValidatorJSON is the spec representation of a validator, with only the index decoded up front.
+type partialValidatorJSON struct {
+	Index     string          `json:"index"`
+	Balance   string          `json:"balance"`
+	Status    json.RawMessage `json:"status"`
+	Validator json.RawMessage `json:"validator"`
+}
+
+// ValidatorsWithFields provides the validators for a given state, populating only the requested fields.
+// stateID can be a slot number or state root, or one of the special values "genesis", "head", "justified" or "finalized".
+// validatorIndices is a list of validators to restrict the returned values.  If no validators are supplied no filter will be applied.
+// fields is a list of the fields to populate, from "balance", "status" and "validator".  The index is always populated.
+// The standard API does not provide server-side projection, so the full response is streamed from the node and only
+// the requested fields are parsed.
+func (s *Service) ValidatorsWithFields(ctx context.Context,
+	stateID string,
+	validatorIndices []spec.ValidatorIndex,
+	fields []string,
+) (map[spec.ValidatorIndex]*api.Validator, error) {
+	if stateID == "" {
+		return nil, errors.New("no state ID specified")
+	}
+	requested := make(map[string]bool, len(fields))
+	for _, field := range fields {
+		if !validatorFields[field] {
+			return nil, fmt.Errorf("unsupported validator field %s", field)
+		}
+		requested[field] = true
+	}
+
+	url := fmt.Sprintf("/eth/v1/beacon/states/%s/validators", stateID)
+	if len(validatorIndices) != 0 {
+		ids := make([]string, len(validatorIndices))
+		for i := range validatorIndices {
+			ids[i] = fmt.Sprintf("%d", validatorIndices[i])
+		}
+		url = fmt.Sprintf("%s?id=%s", url, strings.Join(ids, "&id="))
+	}
+
+	respBodyReader, err := s.get(ctx, url)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request validators")
+	}
+	if respBodyReader == nil {
+		return nil, errors.Wrap(client.ErrNotFound, "failed to obtain validators")
+	}
+
+	res := make(map[spec.ValidatorIndex]*api.Validator)
+	err = forEachDataItem(respBodyReader, func(decoder *json.Decoder) error {
+		var data partialValidatorJSON
+		if err := decoder.Decode(&data); err != nil {
+			return errors.Wrap(err, "invalid JSON")
+		}
+		validator, err := data.unpack(requested)
+		if err != nil {
+			return err
+		}
+		res[validator.Index] = validator
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse validators")
+	}
+
+	return res, nil
+}
+
+// unpack populates a validator with the requested fields.
+func (p *partialValidatorJSON) unpack(requested map[string]bool) (*api.Validator, error) {
+	if p.Index == "" {
+		return nil, errors.New("index missing")
+	}
+	index, err := strconv.ParseUint(p.Index, 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid value for index")
+	}
+	validator := &api.Validator{
+		Index: spec.ValidatorIndex(index),
+	}
+
+	if requested["balance"] {
+		if p.Balance == "" {
+			return nil, errors.New("balance missing")
+		}
+		balance, err := strconv.ParseUint(p.Balance, 10, 64)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid value for balance")
+		}
+		validator.Balance = spec.Gwei(balance)
+	}
+	if requested["status"] {
+		if len(p.Status) == 0 {
+			return nil, errors.New("status missing")
+		}
+		if err := json.Unmarshal(p.Status, &validator.Status); err != nil {
+			return nil, errors.Wrap(err, "invalid value for status")
+		}
+	}
+	if requested["validator"] {
+		if len(p.Validator) == 0 {
+			return nil, errors.New("validator missing")
+		}
+		validator.Validator = &spec.Validator{}
+		if err := json.Unmarshal(p.Validator, validator.Validator); err != nil {
+			return nil, errors.Wrap(err, "invalid value for validator")
+		}
+	}
+
+	return validator, nil
+}
@@ -0,0 +1,110 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"context"
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatorsWithFields(t *testing.T) {
+	server := newTestServer(t, map[string]string{
+		"/eth/v1/beacon/states/head/validators": `{"data":[{"index":"1","balance":"32000000001","status":"active_ongoing","validator":{"pubkey":"0xb89bebc699769726a318c8e9971bd3171297c61aea4a6578a7a4f94b547dcba5bac16a89108b6b6a1fe3695d1a874a0b","withdrawal_credentials":"0x00ec7ef7780c9d151597924036262dd28dc60e1228f4da6fecf9d402cb3f3594","effective_balance":"32000000000","slashed":false,"activation_eligibility_epoch":"0","activation_epoch":"0","exit_epoch":"18446744073709551615","withdrawable_epoch":"18446744073709551615"}},{"index":"2","balance":"32000000002","status":"active_ongoing","validator":{"pubkey":"0xb89bebc699769726a318c8e9971bd3171297c61aea4a6578a7a4f94b547dcba5bac16a89108b6b6a1fe3695d1a874a0b","withdrawal_credentials":"0x00ec7ef7780c9d151597924036262dd28dc60e1228f4da6fecf9d402cb3f3594","effective_balance":"32000000000","slashed":false,"activation_eligibility_epoch":"0","activation_epoch":"0","exit_epoch":"18446744073709551615","withdrawable_epoch":"18446744073709551615"}}]}`,
+	})
+	service, err := standardhttp.New(context.Background(),
+		standardhttp.WithTimeout(timeout),
+		standardhttp.WithAddress(server.URL),
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		stateID  string
+		fields   []string
+		balances map[spec.ValidatorIndex]spec.Gwei
+		status   bool
+		details  bool
+		err      string
+	}{
+		{
+			name:    "NoStateID",
+			stateID: "",
+			err:     "no state ID specified",
+		},
+		{
+			name:    "UnknownField",
+			stateID: "head",
+			fields:  []string{"balance", "pubkey"},
+			err:     "unsupported validator field pubkey",
+		},
+		{
+			name:     "IndexOnly",
+			stateID:  "head",
+			balances: map[spec.ValidatorIndex]spec.Gwei{1: 0, 2: 0},
+		},
+		{
+			name:     "Balance",
+			stateID:  "head",
+			fields:   []string{"balance"},
+			balances: map[spec.ValidatorIndex]spec.Gwei{1: 32000000001, 2: 32000000002},
+		},
+		{
+			name:     "All",
+			stateID:  "head",
+			fields:   []string{"balance", "status", "validator"},
+			balances: map[spec.ValidatorIndex]spec.Gwei{1: 32000000001, 2: 32000000002},
+			status:   true,
+			details:  true,
+		},
+		{
+			name:    "NotFound",
+			stateID: "finalized",
+			err:     "failed to obtain validators: not found",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			validators, err := service.ValidatorsWithFields(context.Background(), test.stateID, nil, test.fields)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, validators, len(test.balances))
+			for index, balance := range test.balances {
+				validator, exists := validators[index]
+				require.True(t, exists)
+				require.Equal(t, index, validator.Index)
+				require.Equal(t, balance, validator.Balance)
+				if test.status {
+					require.Equal(t, api.ValidatorStateActiveOngoing, validator.Status)
+				} else {
+					require.Equal(t, api.ValidatorStateUnknown, validator.Status)
+				}
+				if test.details {
+					require.NotNil(t, validator.Validator)
+					require.Equal(t, spec.Gwei(32000000000), validator.Validator.EffectiveBalance)
+				} else {
+					require.Nil(t, validator.Validator)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
+	"github.com/stretchr/testify/require"
+)
+
+// validatorsHandler returns a handler for validator requests against a state of ten validators, of which even
+// indices are active and odd indices are pending.  If filterStatus is false the status parameter is ignored.
+func validatorsHandler(t *testing.T, filterStatus bool, requests *int32) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(requests, 1)
+		ids := make(map[uint64]bool)
+		for _, id := range r.URL.Query()["id"] {
+			index, err := strconv.ParseUint(id, 10, 64)
+			require.NoError(t, err)
+			ids[index] = true
+		}
+		statuses := r.URL.Query()["status"]
+		items := make([]string, 0)
+		for index := uint64(0); index < 10; index++ {
+			if len(ids) > 0 && !ids[index] {
+				continue
+			}
+			status := "active_ongoing"
+			if index%2 == 1 {
+				status = "pending_queued"
+			}
+			if filterStatus && len(statuses) > 0 && !strings.HasPrefix(status, statuses[0]) {
+				continue
+			}
+			items = append(items, fmt.Sprintf(`{"index":"%d","balance":"32000000000","status":"%s","validator":{"pubkey":"0xb89bebc699769726a318c8e9971bd3171297c61aea4a6578a7a4f94b547dcba5bac16a89108b6b6a1fe3695d1a874a0b","withdrawal_credentials":"0x00ec7ef7780c9d151597924036262dd28dc60e1228f4da6fecf9d402cb3f3594","effective_balance":"32000000000","slashed":false,"activation_eligibility_epoch":"0","activation_epoch":"0","exit_epoch":"18446744073709551615","withdrawable_epoch":"18446744073709551615"}}`, index, status))
+		}
+		respondWith(fmt.Sprintf(`{"data":[%s]}`, strings.Join(items, ",")))(w, r)
+	}
+}
+
+func TestValidatorsWithStatuses(t *testing.T) {
+	tests := []struct {
+		name         string
+		filterStatus bool
+		indices      []spec.ValidatorIndex
+		statuses     []string
+		expected     []spec.ValidatorIndex
+		requests     int32
+	}{
+		{
+			name:         "All",
+			filterStatus: true,
+			expected:     []spec.ValidatorIndex{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+			requests:     1,
+		},
+		{
+			name:         "Active",
+			filterStatus: true,
+			statuses:     []string{"active"},
+			expected:     []spec.ValidatorIndex{0, 2, 4, 6, 8},
+			requests:     1,
+		},
+		{
+			name:         "ActiveChunked",
+			filterStatus: true,
+			indices:      []spec.ValidatorIndex{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+			statuses:     []string{"active"},
+			expected:     []spec.ValidatorIndex{0, 2, 4, 6, 8},
+			requests:     4,
+		},
+		{
+			name:         "ActiveChunkedRepeated",
+			filterStatus: true,
+			indices:      []spec.ValidatorIndex{1, 2, 3, 2, 4, 5, 4},
+			statuses:     []string{"active"},
+			expected:     []spec.ValidatorIndex{2, 4},
+			requests:     3,
+		},
+		{
+			name:     "ActiveChunkedUnfilteredNode",
+			indices:  []spec.ValidatorIndex{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+			statuses: []string{"active"},
+			expected: []spec.ValidatorIndex{0, 2, 4, 6, 8},
+			requests: 4,
+		},
+		{
+			name:     "PendingQueuedUnfilteredNode",
+			statuses: []string{"pending_queued"},
+			expected: []spec.ValidatorIndex{1, 3, 5, 7, 9},
+			requests: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			requests := int32(0)
+			server := newTestServerWithHandlers(t, map[string]http.HandlerFunc{
+				"/eth/v1/beacon/states/head/validators": validatorsHandler(t, test.filterStatus, &requests),
+			})
+			service, err := standardhttp.New(context.Background(),
+				standardhttp.WithTimeout(timeout),
+				standardhttp.WithAddress(server.URL),
+				standardhttp.WithValidatorsChunkSize(3),
+			)
+			require.NoError(t, err)
+
+			validators, err := service.ValidatorsWithStatuses(context.Background(), "head", test.indices, test.statuses)
+			require.NoError(t, err)
+			require.Len(t, validators, len(test.expected))
+			for _, index := range test.expected {
+				validator, exists := validators[index]
+				require.True(t, exists)
+				require.Equal(t, index, validator.Index)
+			}
+			require.Equal(t, test.requests, atomic.LoadInt32(&requests))
+		})
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/pkg/errors"
+)
+
+// VerifyStateRoot confirms that the state root in the given block matches the root of the state at the block's
+// slot.  If they differ this returns false along with a client.StateRootMismatchError.
+// The state at a slot is that of the canonical chain, so only canonical blocks can be verified; for other blocks this
+// returns an error that wraps client.ErrNotCanonical.
+func (s *Service) VerifyStateRoot(ctx context.Context, blockID string) (bool, error) {
+	if blockID == "" {
+		return false, errors.New("no block ID specified")
+	}
+
+	header, err := s.BeaconBlockHeader(ctx, blockID)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to obtain block header")
+	}
+	if header == nil || header.Header == nil || header.Header.Message == nil {
+		return false, errors.Wrap(client.ErrNotFound, "failed to obtain block header")
+	}
+	if !header.Canonical {
+		return false, errors.Wrap(client.ErrNotCanonical, "cannot verify state root of block")
+	}
+	blockStateRoot := header.Header.Message.StateRoot
+
+	stateRoot, err := s.StateRoot(ctx, fmt.Sprintf("%d", header.Header.Message.Slot))
+	if err != nil {
+		return false, errors.Wrap(err, "failed to obtain state root")
+	}
+
+	if !bytes.Equal(blockStateRoot[:], stateRoot) {
+		mismatchErr := &client.StateRootMismatchError{
+			BlockID:        blockID,
+			BlockStateRoot: blockStateRoot,
+		}
+		copy(mismatchErr.StateRoot[:], stateRoot)
+		return false, mismatchErr
+	}
+
+	return true, nil
+}
@@ -0,0 +1,110 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyStateRoot(t *testing.T) {
+	ctx := context.Background()
+	header := `{"data":{"root":"0xbc354f1a5f27f8d096eee9e6b6139e1b730385f9752513832a57c9849a149df7","canonical":true,"header":{"message":{"slot":"1000","proposer_index":"29787","parent_root":"0xba4d784293df28bab771a14df58cdbed9d8d64afd0ddf1c52dff3e25fcdd51df","state_root":"0x4e405274abd4f59c6a2268b4e6ca93dba01e15ae6b56401fb20a1ad9701b036d","body_root":"0x57bb79520694c132a35dc887cac2e4dad9acc5ded58b5ae66b491644ab8835c8"},"signature":"0xa8d684242ee025ee96e877b28433d93176072b8c8e8295609501863147bb1d174b8a16aed661d001f30859c9e42c0f9d18ea35786a9bdf115dff1877980046e19e0e4c9310e281f8129f2692ddc4680673ab78b7f8db72f91be7863dd9fe1e55"}}}`
+
+	tests := []struct {
+		name      string
+		blockID   string
+		responses map[string]string
+		res       bool
+		err       string
+		mismatch  bool
+		notFound  bool
+	}{
+		{
+			name: "BlockIDMissing",
+			err:  "no block ID specified",
+		},
+		{
+			name:     "BlockNotFound",
+			blockID:  "head",
+			notFound: true,
+		},
+		{
+			name:    "StateNotFound",
+			blockID: "head",
+			responses: map[string]string{
+				"/eth/v1/beacon/headers/head": header,
+			},
+			notFound: true,
+		},
+		{
+			name:    "NotCanonical",
+			blockID: "head",
+			responses: map[string]string{
+				"/eth/v1/beacon/headers/head":     strings.Replace(header, `"canonical":true`, `"canonical":false`, 1),
+				"/eth/v1/beacon/states/1000/root": `{"data":{"root":"0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20"}}`,
+			},
+			err: "cannot verify state root of block: not canonical",
+		},
+		{
+			name:    "Match",
+			blockID: "head",
+			responses: map[string]string{
+				"/eth/v1/beacon/headers/head":     header,
+				"/eth/v1/beacon/states/1000/root": `{"data":{"root":"0x4e405274abd4f59c6a2268b4e6ca93dba01e15ae6b56401fb20a1ad9701b036d"}}`,
+			},
+			res: true,
+		},
+		{
+			name:    "Mismatch",
+			blockID: "head",
+			responses: map[string]string{
+				"/eth/v1/beacon/headers/head":     header,
+				"/eth/v1/beacon/states/1000/root": `{"data":{"root":"0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20"}}`,
+			},
+			err:      "block head has state root 0x4e405274abd4f59c6a2268b4e6ca93dba01e15ae6b56401fb20a1ad9701b036d but state has root 0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20",
+			mismatch: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := newTestServer(t, test.responses)
+			service, err := standardhttp.New(ctx,
+				standardhttp.WithTimeout(timeout),
+				standardhttp.WithAddress(server.URL),
+			)
+			require.NoError(t, err)
+
+			res, err := service.VerifyStateRoot(ctx, test.blockID)
+			switch {
+			case test.notFound:
+				require.True(t, errors.Is(err, client.ErrNotFound))
+			case test.err != "":
+				require.EqualError(t, err, test.err)
+			default:
+				require.NoError(t, err)
+			}
+			require.Equal(t, test.res, res)
+			var mismatchErr *client.StateRootMismatchError
+			require.Equal(t, test.mismatch, errors.As(err, &mismatchErr))
+		})
+	}
+}
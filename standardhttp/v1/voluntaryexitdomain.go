@@ -21,5 +21,5 @@ import (
 
 // VoluntaryExitDomain provides the voluntary exit domain of the chain.
 func (s *Service) VoluntaryExitDomain(ctx context.Context) (spec.DomainType, error) {
-	return s.spec["DOMAIN_VOLUNTARY_EXIT"].(spec.DomainType), nil
+	return s.chainConfig.DomainVoluntaryExit, nil
 }
@@ -0,0 +1,136 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// sharedSlotTicker passes the slots from a single slot ticker on to any number of waiters.
+type sharedSlotTicker struct {
+	mutex sync.Mutex
+	// slot is the most recent slot from the ticker, if ticked is set.
+	slot   spec.Slot
+	ticked bool
+	// stopped is set when the ticker has stopped.
+	stopped bool
+	// changed is closed, and replaced, whenever the state above changes.
+	changed chan struct{}
+}
+
+// state provides the current state of the ticker, along with a channel that is closed when it next changes.
+func (t *sharedSlotTicker) state() (spec.Slot, bool, bool, <-chan struct{}) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	return t.slot, t.ticked, t.stopped, t.changed
+}
+
+// update updates the state of the ticker and wakes any waiters.
+func (t *sharedSlotTicker) update(f func()) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	f()
+	close(t.changed)
+	t.changed = make(chan struct{})
+}
+
+// slotTicker provides the slot ticker shared by callers of WaitForSlot, starting it if required.
+// The ticker runs until the service is closed, so waiting for slots does not open an event stream per caller.
+func (s *Service) slotTicker() (*sharedSlotTicker, error) {
+	s.sharedSlotTickerMutex.Lock()
+	defer s.sharedSlotTickerMutex.Unlock()
+
+	if s.sharedSlotTicker != nil {
+		return s.sharedSlotTicker, nil
+	}
+
+	ticks, err := s.SlotTicker(s.runCtx)
+	if err != nil {
+		return nil, err
+	}
+	ticker := &sharedSlotTicker{
+		changed: make(chan struct{}),
+	}
+	if err := s.background.run("shared slot ticker", func() {
+		for tick := range ticks {
+			ticker.update(func() {
+				ticker.slot = tick
+				ticker.ticked = true
+			})
+		}
+		ticker.update(func() {
+			ticker.stopped = true
+		})
+	}); err != nil {
+		return nil, err
+	}
+	s.sharedSlotTicker = ticker
+
+	return ticker, nil
+}
+
+// WaitForSlot blocks until the start of the given slot or the context is done.
+// The start of the slot is calculated from the genesis time, and corrected by head events from the node if the
+// local clock is behind.  If the slot has already started this returns immediately.
+// If the service is closed before the slot starts this returns an error.
+func (s *Service) WaitForSlot(ctx context.Context, slot spec.Slot) error {
+	genesisTime, err := s.GenesisTime(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain genesis time")
+	}
+	slotDuration, err := s.SlotDuration(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain slot duration")
+	}
+	if !time.Now().Before(genesisTime.Add(time.Duration(slot) * slotDuration)) {
+		return nil
+	}
+
+	ticker, err := s.slotTicker()
+	if err != nil {
+		return errors.Wrap(err, "failed to start slot ticker")
+	}
+	for {
+		current, ticked, stopped, changed := ticker.state()
+		if ticked && current >= slot {
+			return nil
+		}
+		if stopped {
+			return errors.Wrap(errServiceClosed, "slot ticker stopped")
+		}
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// WaitForEpoch blocks until the start of the given epoch or the context is done.
+// If the epoch has already started this returns immediately.
+func (s *Service) WaitForEpoch(ctx context.Context, epoch spec.Epoch) error {
+	slotsPerEpoch, err := s.SlotsPerEpoch(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain slots per epoch")
+	}
+
+	return s.WaitForSlot(ctx, spec.Slot(uint64(epoch)*slotsPerEpoch))
+}
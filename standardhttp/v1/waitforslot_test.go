@@ -0,0 +1,112 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWaitForSlot(t *testing.T) {
+	genesisTime := time.Now().Add(-10 * time.Second)
+	server := newTestServer(t, map[string]string{
+		"/eth/v1/beacon/genesis": genesisResponse(genesisTime),
+		"/eth/v1/config/spec":    `{"data":{"SECONDS_PER_SLOT":"1","SLOTS_PER_EPOCH":"2"}}`,
+	})
+	service, err := standardhttp.New(context.Background(),
+		standardhttp.WithTimeout(timeout),
+		standardhttp.WithAddress(server.URL),
+	)
+	require.NoError(t, err)
+	// Genesis time is truncated to the second by the response.
+	genesisTime = time.Unix(genesisTime.Unix(), 0)
+
+	// Past slot returns immediately.
+	started := time.Now()
+	require.NoError(t, service.WaitForSlot(context.Background(), 5))
+	require.Less(t, int64(time.Since(started)), int64(100*time.Millisecond))
+
+	// Future slot waits until its start.
+	currentSlot := spec.Slot(time.Since(genesisTime) / time.Second)
+	require.NoError(t, service.WaitForSlot(context.Background(), currentSlot+2))
+	require.False(t, time.Now().Before(genesisTime.Add(time.Duration(currentSlot+2)*time.Second)))
+
+	// Future epoch waits until its start.
+	currentEpoch := spec.Epoch(time.Since(genesisTime) / (2 * time.Second))
+	require.NoError(t, service.WaitForEpoch(context.Background(), currentEpoch+1))
+	require.False(t, time.Now().Before(genesisTime.Add(time.Duration(currentEpoch+1)*2*time.Second)))
+
+	// Context done returns an error.
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	require.EqualError(t, service.WaitForSlot(ctx, currentSlot+1000), "context deadline exceeded")
+}
+
+func TestWaitForSlotShared(t *testing.T) {
+	genesisTime := time.Now().Add(-10 * time.Second)
+	streams := int32(0)
+	server := newTestServerWithHandlers(t, map[string]http.HandlerFunc{
+		"/eth/v1/beacon/genesis": respondWith(genesisResponse(genesisTime)),
+		"/eth/v1/config/spec":    respondWith(`{"data":{"SECONDS_PER_SLOT":"1","SLOTS_PER_EPOCH":"2"}}`),
+		"/eth/v1/events": func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&streams, 1)
+			w.Header().Set("Content-Type", "text/event-stream")
+			w.(http.Flusher).Flush()
+			<-r.Context().Done()
+		},
+	})
+	service, err := standardhttp.New(context.Background(),
+		standardhttp.WithTimeout(timeout),
+		standardhttp.WithAddress(server.URL),
+	)
+	require.NoError(t, err)
+	genesisTime = time.Unix(genesisTime.Unix(), 0)
+	currentSlot := spec.Slot(time.Since(genesisTime) / time.Second)
+
+	// Concurrent waiters share a single head event stream.
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			require.NoError(t, service.WaitForSlot(context.Background(), currentSlot+2))
+		}()
+	}
+	wg.Wait()
+	require.Equal(t, int32(1), atomic.LoadInt32(&streams))
+
+	// Closing the service whilst waiting returns an error rather than reporting the slot as reached.
+	errs := make(chan error, 1)
+	go func() {
+		errs <- service.WaitForSlot(context.Background(), currentSlot+1000)
+	}()
+	time.Sleep(100 * time.Millisecond)
+	closeCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	require.NoError(t, service.Close(closeCtx))
+	select {
+	case err := <-errs:
+		require.EqualError(t, err, "slot ticker stopped: service closed")
+	case <-time.After(10 * time.Second):
+		require.Fail(t, "wait not ended by service close")
+	}
+}
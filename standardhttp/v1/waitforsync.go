@@ -0,0 +1,50 @@
+// Copyright © 2020 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// WaitForSync blocks until the node is synced or the context is done, checking every poll interval.
+func (s *Service) WaitForSync(ctx context.Context, pollInterval time.Duration) error {
+	if pollInterval == 0 {
+		return errors.New("no poll interval specified")
+	}
+	if pollInterval < 0 {
+		return errors.New("poll interval must be positive")
+	}
+
+	for {
+		syncState, err := s.NodeSyncing(ctx)
+		if err != nil {
+			// The node may be starting up, so keep trying.
+			s.log.Warn().Err(err).Msg("Failed to obtain sync state")
+		} else {
+			if !syncState.IsSyncing {
+				return nil
+			}
+			s.log.Info().Uint64("head_slot", uint64(syncState.HeadSlot)).Uint64("sync_distance", uint64(syncState.SyncDistance)).Msg("Waiting for node to sync")
+		}
+
+		select {
+		case <-ctx.Done():
+			return errors.Wrap(ctx.Err(), "node did not sync")
+		case <-time.After(pollInterval):
+		}
+	}
+}
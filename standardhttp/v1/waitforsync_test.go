@@ -0,0 +1,89 @@
+// Copyright © 2020 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWaitForSync(t *testing.T) {
+	tests := []struct {
+		name         string
+		pollInterval time.Duration
+		synced       int
+		err          string
+	}{
+		{
+			name: "PollIntervalMissing",
+			err:  "no poll interval specified",
+		},
+		{
+			name:         "PollIntervalNegative",
+			pollInterval: -time.Second,
+			err:          "poll interval must be positive",
+		},
+		{
+			name:         "Synced",
+			pollInterval: 10 * time.Millisecond,
+		},
+		{
+			name:         "SyncedAfterPolls",
+			pollInterval: 10 * time.Millisecond,
+			synced:       3,
+		},
+		{
+			name:         "NotSynced",
+			pollInterval: 10 * time.Millisecond,
+			synced:       1000,
+			err:          "node did not sync: context deadline exceeded",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			polls := 0
+			server := newTestServerWithHandlers(t, map[string]http.HandlerFunc{
+				"/eth/v1/node/syncing": func(w http.ResponseWriter, r *http.Request) {
+					polls++
+					if polls > test.synced {
+						respondWith(`{"data":{"head_slot":"100","sync_distance":"0","is_syncing":false}}`)(w, r)
+					} else {
+						respondWith(`{"data":{"head_slot":"50","sync_distance":"50","is_syncing":true}}`)(w, r)
+					}
+				},
+			})
+			service, err := standardhttp.New(context.Background(),
+				standardhttp.WithTimeout(timeout),
+				standardhttp.WithAddress(server.URL),
+			)
+			require.NoError(t, err)
+
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+			err = service.WaitForSync(ctx, test.pollInterval)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.synced+1, polls)
+			}
+		})
+	}
+}
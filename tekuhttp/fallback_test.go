@@ -0,0 +1,139 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tekuhttp_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/tekuhttp"
+	"github.com/stretchr/testify/require"
+)
+
+// newFallbackTestServer creates a server that provides the static values required to start a service, and
+// responds to version requests with the given status and version.
+func newFallbackTestServer(t *testing.T, status int, version string, requests *int32) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/node/genesis_time":
+			fmt.Fprint(w, `"1606824023"`)
+		case "/eth/v1/config/spec":
+			fmt.Fprint(w, `{"data":{"GENESIS_FORK_VERSION":"0x00000000"}}`)
+		case "/node/version":
+			atomic.AddInt32(requests, 1)
+			w.WriteHeader(status)
+			fmt.Fprintf(w, `"%s"`, version)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestFallbackAddresses(t *testing.T) {
+	tests := []struct {
+		name             string
+		primaryStatus    int
+		primaryDown      bool
+		version          string
+		primaryVersion   string
+		primaryRequests  int32
+		fallbackRequests int32
+		err              string
+	}{
+		{
+			name:             "PrimaryGood",
+			primaryStatus:    http.StatusOK,
+			version:          "primary",
+			primaryRequests:  1,
+			fallbackRequests: 0,
+		},
+		{
+			name:             "PrimaryServerError",
+			primaryStatus:    http.StatusServiceUnavailable,
+			version:          "fallback",
+			primaryRequests:  1,
+			fallbackRequests: 1,
+		},
+		{
+			name:             "PrimaryNotFound",
+			primaryStatus:    http.StatusNotFound,
+			primaryRequests:  1,
+			fallbackRequests: 0,
+			err:              `failed to obtain node version: GET failed with status 404: "primary": not found`,
+		},
+		{
+			name:             "PrimaryBadRequest",
+			primaryStatus:    http.StatusBadRequest,
+			primaryRequests:  1,
+			fallbackRequests: 0,
+			err:              `failed to obtain node version: GET failed with status 400: "primary"`,
+		},
+		{
+			name:             "PrimaryTooLarge",
+			primaryStatus:    http.StatusOK,
+			primaryVersion:   strings.Repeat("x", 2048),
+			primaryRequests:  1,
+			fallbackRequests: 0,
+			err:              "failed to obtain node version: failed to read GET response: response exceeds maximum size of 1024 bytes",
+		},
+		{
+			name:             "PrimaryDown",
+			primaryDown:      true,
+			version:          "fallback",
+			fallbackRequests: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			primaryRequests := int32(0)
+			fallbackRequests := int32(0)
+			primaryVersion := "primary"
+			if test.primaryVersion != "" {
+				primaryVersion = test.primaryVersion
+			}
+			primary := newFallbackTestServer(t, test.primaryStatus, primaryVersion, &primaryRequests)
+			if test.primaryDown {
+				primary.Close()
+			}
+			fallback := newFallbackTestServer(t, http.StatusOK, "fallback", &fallbackRequests)
+
+			service, err := tekuhttp.New(context.Background(),
+				tekuhttp.WithTimeout(timeout),
+				tekuhttp.WithAddress(primary.URL),
+				tekuhttp.WithFallbackAddresses([]string{fallback.URL}),
+				tekuhttp.WithMaxResponseBytes(1024),
+			)
+			require.NoError(t, err)
+
+			version, err := service.NodeVersion(context.Background())
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.version, version)
+			}
+			require.Equal(t, test.primaryRequests, atomic.LoadInt32(&primaryRequests))
+			require.Equal(t, test.fallbackRequests, atomic.LoadInt32(&fallbackRequests))
+		})
+	}
+}
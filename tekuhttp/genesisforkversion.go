@@ -0,0 +1,60 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tekuhttp
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+type genesisForkVersionJSON struct {
+	Data struct {
+		GenesisForkVersion string `json:"GENESIS_FORK_VERSION"`
+	} `json:"data"`
+}
+
+// GenesisForkVersion provides the genesis fork version of the chain.
+// This is the version used when signing deposits, regardless of the current fork.
+func (s *Service) GenesisForkVersion(ctx context.Context) (spec.Version, error) {
+	if s.genesisForkVersion == nil {
+		respBodyReader, err := s.get(ctx, "/eth/v1/config/spec")
+		if err != nil {
+			return spec.Version{}, errors.Wrap(err, "failed to obtain spec")
+		}
+
+		var genesisForkVersionJSON genesisForkVersionJSON
+		if err := json.NewDecoder(respBodyReader).Decode(&genesisForkVersionJSON); err != nil {
+			return spec.Version{}, errors.Wrap(err, "failed to parse spec")
+		}
+		if genesisForkVersionJSON.Data.GenesisForkVersion == "" {
+			return spec.Version{}, errors.New("genesis fork version missing")
+		}
+		data, err := hex.DecodeString(strings.TrimPrefix(genesisForkVersionJSON.Data.GenesisForkVersion, "0x"))
+		if err != nil {
+			return spec.Version{}, errors.Wrap(err, "failed to parse genesis fork version")
+		}
+		if len(data) != len(spec.Version{}) {
+			return spec.Version{}, errors.New("incorrect length for genesis fork version")
+		}
+		var genesisForkVersion spec.Version
+		copy(genesisForkVersion[:], data)
+		s.genesisForkVersion = &genesisForkVersion
+	}
+	return *s.genesisForkVersion, nil
+}
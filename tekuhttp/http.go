@@ -19,13 +19,16 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"math"
 	"net/http"
 	"net/url"
 
+	client "github.com/attestantio/go-eth2-client"
 	"github.com/pkg/errors"
 )
 
 // get sends an HTTP get request and returns the body.
+// If the request fails due to a connection problem or server error it is retried against any fallback addresses.
 func (s *Service) get(ctx context.Context, endpoint string) (io.Reader, error) {
 	log.Trace().Str("endpoint", endpoint).Msg("GET request")
 
@@ -33,82 +36,179 @@ func (s *Service) get(ctx context.Context, endpoint string) (io.Reader, error) {
 	if err != nil {
 		return nil, errors.Wrap(err, "invalid endpoint")
 	}
-	url := s.base.ResolveReference(reference).String()
+
+	var res io.Reader
+	for _, base := range s.bases() {
+		var retry bool
+		res, retry, err = s.getFromBase(ctx, base, reference)
+		if !retry || ctx.Err() != nil {
+			break
+		}
+		log.Debug().Str("address", base.String()).Err(err).Msg("GET request failed; trying next address")
+	}
+
+	return res, err
+}
+
+// getFromBase sends an HTTP get request to a single base address, returning the body and, on failure,
+// if the request should be retried against another address.
+func (s *Service) getFromBase(ctx context.Context, base *url.URL, reference *url.URL) (io.Reader, bool, error) {
+	url := base.ResolveReference(reference).String()
 	log.Trace().Str("url", url).Msg("GET request")
 	opCtx, cancel := context.WithTimeout(ctx, s.timeout)
 	req, err := http.NewRequestWithContext(opCtx, http.MethodGet, url, nil)
 	if err != nil {
 		cancel()
-		return nil, errors.Wrap(err, "failed to create GET request")
+		return nil, false, errors.Wrap(err, "failed to create GET request")
 	}
 	resp, err := s.client.Do(req)
 	if err != nil {
 		cancel()
-		return nil, errors.Wrap(err, "failed to connect to GET endpoint")
+		return nil, true, errors.Wrap(err, "failed to connect to GET endpoint")
 	}
+	defer resp.Body.Close()
 
-	data, err := ioutil.ReadAll(resp.Body)
+	data, err := readBody(resp.Body, s.maxResponseBytes)
 	if err != nil {
 		cancel()
-		return nil, errors.Wrap(err, "failed to read GET response")
+		// Another address would return the same oversized response, so only retry other failures.
+		return nil, !isResponseTooLarge(err), errors.Wrap(err, "failed to read GET response")
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		cancel()
+		return nil, false, errors.Wrap(client.ErrNotFound, fmt.Sprintf("GET failed with status %d: %s", resp.StatusCode, string(data)))
 	}
 
 	statusFamily := resp.StatusCode / 100
 	if statusFamily != 2 {
 		cancel()
-		return nil, fmt.Errorf("GET failed with status %d: %s", resp.StatusCode, string(data))
+		return nil, statusFamily == 5, fmt.Errorf("GET failed with status %d: %s", resp.StatusCode, string(data))
 	}
 	cancel()
 
 	log.Trace().Str("response", string(data)).Msg("GET response")
 
-	return bytes.NewReader(data), nil
+	return bytes.NewReader(data), false, nil
+}
+
+// probe sends an HTTP get request to a health endpoint and returns true if the node responds with success.
+// An error is only returned if the node cannot be reached.
+func (s *Service) probe(ctx context.Context, endpoint string) (bool, error) {
+	reference, err := url.Parse(endpoint)
+	if err != nil {
+		return false, errors.Wrap(err, "invalid endpoint")
+	}
+	url := s.base.ResolveReference(reference).String()
+	log.Trace().Str("url", url).Msg("GET request")
+	opCtx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(opCtx, http.MethodGet, url, nil)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to create GET request")
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to connect to GET endpoint")
+	}
+	defer resp.Body.Close()
+	// The body is not needed, but is drained to allow the connection to be reused.
+	if _, err := readBody(resp.Body, s.maxResponseBytes); err != nil {
+		log.Trace().Err(err).Msg("Failed to read GET response")
+	}
+	log.Trace().Int("status", resp.StatusCode).Msg("GET response")
+
+	return resp.StatusCode/100 == 2, nil
 }
 
 // post sends an HTTP post request and returns the body.
+// If the request fails due to a connection problem or server error it is retried against any fallback addresses.
 func (s *Service) post(ctx context.Context, endpoint string, body io.Reader) (io.Reader, error) {
 	reference, err := url.Parse(endpoint)
 	if err != nil {
 		return nil, errors.Wrap(err, "invalid endpoint")
 	}
-	url := s.base.ResolveReference(reference).String()
 
-	if e := log.Trace(); e.Enabled() {
-		bodyBytes, err := ioutil.ReadAll(body)
-		if err != nil {
-			return nil, errors.New("failed to read request body")
-		}
-		body = bytes.NewReader(bodyBytes)
+	// The body is read up front, so that it can be resent to fallback addresses.
+	bodyBytes, err := ioutil.ReadAll(body)
+	if err != nil {
+		return nil, errors.New("failed to read request body")
+	}
 
-		e.Str("url", url).Str("body", string(bodyBytes)).Msg("POST request")
+	var res io.Reader
+	for _, base := range s.bases() {
+		var retry bool
+		res, retry, err = s.postToBase(ctx, base, reference, bodyBytes)
+		if !retry || ctx.Err() != nil {
+			break
+		}
+		log.Debug().Str("address", base.String()).Err(err).Msg("POST request failed; trying next address")
 	}
 
+	return res, err
+}
+
+// postToBase sends an HTTP post request to a single base address, returning the body and, on failure,
+// if the request should be retried against another address.
+func (s *Service) postToBase(ctx context.Context, base *url.URL, reference *url.URL, body []byte) (io.Reader, bool, error) {
+	url := base.ResolveReference(reference).String()
+	log.Trace().Str("url", url).Str("body", string(body)).Msg("POST request")
+
 	opCtx, cancel := context.WithTimeout(ctx, s.timeout)
-	req, err := http.NewRequestWithContext(opCtx, http.MethodPost, url, body)
+	req, err := http.NewRequestWithContext(opCtx, http.MethodPost, url, bytes.NewReader(body))
 	if err != nil {
 		cancel()
-		return nil, errors.Wrap(err, "failed to create POST request")
+		return nil, false, errors.Wrap(err, "failed to create POST request")
 	}
 	resp, err := s.client.Do(req)
 	if err != nil {
 		cancel()
-		return nil, errors.Wrap(err, "failed to connect to POST endpoint")
+		return nil, true, errors.Wrap(err, "failed to connect to POST endpoint")
 	}
+	defer resp.Body.Close()
 
-	data, err := ioutil.ReadAll(resp.Body)
+	data, err := readBody(resp.Body, s.maxResponseBytes)
 	if err != nil {
 		cancel()
-		return nil, errors.Wrap(err, "failed to read POST response")
+		return nil, !isResponseTooLarge(err), errors.Wrap(err, "failed to read POST response")
 	}
 
 	statusFamily := resp.StatusCode / 100
 	if statusFamily != 2 {
 		cancel()
-		return nil, fmt.Errorf("POST failed with status %d: %s", resp.StatusCode, string(data))
+		return nil, statusFamily == 5, fmt.Errorf("POST failed with status %d: %s", resp.StatusCode, string(data))
 	}
 	cancel()
 
 	log.Trace().Str("response", string(data)).Msg("POST response")
 
-	return bytes.NewReader(data), nil
+	return bytes.NewReader(data), false, nil
+}
+
+// bases returns the base addresses to which requests are sent, in order of preference.
+func (s *Service) bases() []*url.URL {
+	return append([]*url.URL{s.base}, s.fallbacks...)
+}
+
+// isResponseTooLarge returns true if the error is due to a response body being larger than the maximum allowed size.
+func isResponseTooLarge(err error) bool {
+	var tooLargeErr *client.ResponseTooLargeError
+	return errors.As(err, &tooLargeErr)
+}
+
+// readBody reads a response body, returning an error if it is larger than the maximum allowed size.
+func readBody(body io.Reader, maxBytes int64) ([]byte, error) {
+	if maxBytes == math.MaxInt64 {
+		// The size cannot be exceeded, and the limit below would overflow.
+		return ioutil.ReadAll(body)
+	}
+	data, err := ioutil.ReadAll(io.LimitReader(body, maxBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > maxBytes {
+		return nil, &client.ResponseTooLargeError{MaxBytes: maxBytes}
+	}
+
+	return data, nil
 }
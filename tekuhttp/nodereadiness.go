@@ -0,0 +1,30 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tekuhttp
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// NodeReadiness returns true if the node is ready to serve requests.
+// A node that is not ready, for example because it is syncing, should not be sent requests.
+func (s *Service) NodeReadiness(ctx context.Context) (bool, error) {
+	res, err := s.probe(ctx, "/teku/v1/admin/readiness")
+	if err != nil {
+		return false, errors.Wrap(err, "failed to obtain node readiness")
+	}
+	return res, nil
+}
@@ -24,6 +24,12 @@ type parameters struct {
 	logLevel zerolog.Level
 	address  string
 	timeout  time.Duration
+
+	connectionTimeout time.Duration
+
+	maxResponseBytes int64
+
+	fallbackAddresses []string
 }
 
 // Parameter is the interface for service parameters.
@@ -58,12 +64,38 @@ func WithTimeout(timeout time.Duration) Parameter {
 	})
 }
 
+// WithMaxResponseBytes sets the maximum size of a response body from the endpoint.
+// This does not apply to event streams.
+func WithMaxResponseBytes(maxResponseBytes int64) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.maxResponseBytes = maxResponseBytes
+	})
+}
+
+// WithConnectionTimeout sets the maximum duration to establish a connection to the endpoint.
+// This is separate from, and should be lower than, the request timeout.
+func WithConnectionTimeout(timeout time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.connectionTimeout = timeout
+	})
+}
+
+// WithFallbackAddresses provides ordered addresses of further endpoints.  If a request to the primary address fails
+// due to a connection problem or server error it is retried against each fallback address in turn.
+func WithFallbackAddresses(addresses []string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.fallbackAddresses = addresses
+	})
+}
+
 // parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
 func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	parameters := parameters{
-		logLevel: zerolog.GlobalLevel(),
-		address:  "http://localhost:5052",
-		timeout:  2 * time.Minute,
+		logLevel:          zerolog.GlobalLevel(),
+		address:           "http://localhost:5052",
+		timeout:           2 * time.Minute,
+		connectionTimeout: 30 * time.Second,
+		maxResponseBytes:  256 * 1024 * 1024,
 	}
 	for _, p := range params {
 		if params != nil {
@@ -74,6 +106,17 @@ func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	if parameters.address == "" {
 		return nil, errors.New("no address specified")
 	}
+	for _, address := range parameters.fallbackAddresses {
+		if address == "" {
+			return nil, errors.New("empty fallback address specified")
+		}
+	}
+	if parameters.connectionTimeout == 0 {
+		return nil, errors.New("no connection timeout specified")
+	}
+	if parameters.maxResponseBytes <= 0 {
+		return nil, errors.New("no maximum response size specified")
+	}
 
 	return &parameters, nil
 }
@@ -16,6 +16,7 @@ package tekuhttp
 import (
 	"context"
 	"fmt"
+	"net"
 	"net/http"
 	"net/url"
 	"strings"
@@ -23,6 +24,7 @@ import (
 	"time"
 
 	client "github.com/attestantio/go-eth2-client"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
 	zerologger "github.com/rs/zerolog/log"
@@ -38,9 +40,16 @@ type Service struct {
 	client  *http.Client
 	timeout time.Duration
 
+	// fallbacks are tried in order if a request to base fails.
+	fallbacks []*url.URL
+
+	// maxResponseBytes is the largest response body that will be read.
+	maxResponseBytes int64
+
 	// Various information from the node that never changes once we have it.
 	genesisTime           *time.Time
 	genesisValidatorsRoot []byte
+	genesisForkVersion    *spec.Version
 
 	// Event handlers.
 	beaconChainHeadUpdatedMutex    sync.RWMutex
@@ -51,6 +60,7 @@ type Service struct {
 var log zerolog.Logger
 
 // New creates a new Ethereum 2 client service, connecting with Teku HTTP.
+// Cancelling the supplied context stops all background work, such as event streams, and closes the connection.
 func New(ctx context.Context, params ...Parameter) (*Service, error) {
 	parameters, err := parseAndCheckParameters(params...)
 	if err != nil {
@@ -65,20 +75,27 @@ func New(ctx context.Context, params ...Parameter) (*Service, error) {
 
 	client := &http.Client{
 		Transport: &http.Transport{
+			DialContext: (&net.Dialer{
+				Timeout:   parameters.connectionTimeout,
+				KeepAlive: 30 * time.Second,
+			}).DialContext,
 			MaxIdleConns:        16,
 			MaxIdleConnsPerHost: 16,
 			IdleConnTimeout:     384 * time.Second,
 		},
 	}
 
-	address := parameters.address
-	if !strings.HasPrefix(address, "http") {
-		address = fmt.Sprintf("http://%s", parameters.address)
-	}
-	base, err := url.Parse(address)
+	base, err := parseAddress(parameters.address)
 	if err != nil {
 		return nil, errors.Wrap(err, "invalid URL")
 	}
+	fallbacks := make([]*url.URL, len(parameters.fallbackAddresses))
+	for i := range parameters.fallbackAddresses {
+		fallbacks[i], err = parseAddress(parameters.fallbackAddresses[i])
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid URL for fallback address %d", i)
+		}
+	}
 
 	s := &Service{
 		ctx:     ctx,
@@ -86,9 +103,14 @@ func New(ctx context.Context, params ...Parameter) (*Service, error) {
 		address: parameters.address,
 		client:  client,
 		timeout: parameters.timeout,
+
+		fallbacks: fallbacks,
+
+		maxResponseBytes: parameters.maxResponseBytes,
 	}
 
-	// Fetch static values to confirm the connection is good.
+	// Fetch static values to confirm the connection is good.  Each request is bounded by the request timeout, so
+	// an unresponsive node cannot block creation indefinitely even if the supplied context has no deadline.
 	if err := s.fetchStaticValues(ctx); err != nil {
 		return nil, errors.Wrap(err, "failed to confirm node connection")
 	}
@@ -103,12 +125,23 @@ func New(ctx context.Context, params ...Parameter) (*Service, error) {
 	return s, nil
 }
 
+// parseAddress parses an address, adding an HTTP scheme if none is present.
+func parseAddress(address string) (*url.URL, error) {
+	if !strings.HasPrefix(address, "http") {
+		address = fmt.Sprintf("http://%s", address)
+	}
+	return url.Parse(address)
+}
+
 // fetchStaticValues fetches values that never change.
 // This caches the values, avoiding future API calls.
 func (s *Service) fetchStaticValues(ctx context.Context) error {
 	if _, err := s.GenesisTime(ctx); err != nil {
 		return errors.Wrap(err, "failed to fetch genesis time")
 	}
+	if _, err := s.GenesisForkVersion(ctx); err != nil {
+		return errors.Wrap(err, "failed to fetch genesis fork version")
+	}
 	//	if _, err := s.GenesisValidatorsRoot(ctx); err != nil {
 	//		return errors.Wrap(err, "failed to fetch genesis validators root")
 	//	}
@@ -159,6 +192,12 @@ func (s *Service) Address() string {
 	return s.address
 }
 
+// Context provides the context supplied when the service was created.
+// The service is closed when this context is done, so it can be used to tie goroutines to the lifecycle of the service.
+func (s *Service) Context() context.Context {
+	return s.ctx
+}
+
 // close frees up any resources held.
 func (s *Service) close() {
 	s.beaconChainHeadUpdatedMutex.Lock()
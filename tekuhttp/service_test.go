@@ -0,0 +1,42 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tekuhttp_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/tekuhttp"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceUnresponsive(t *testing.T) {
+	// The server accepts connections but never responds.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	started := time.Now()
+	_, err := tekuhttp.New(context.Background(),
+		tekuhttp.WithAddress(server.URL),
+		tekuhttp.WithTimeout(100*time.Millisecond),
+	)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "context deadline exceeded")
+	require.Less(t, int64(time.Since(started)), int64(5*time.Second))
+}
@@ -21,6 +21,7 @@ import (
 	"io/ioutil"
 	"regexp"
 
+	client "github.com/attestantio/go-eth2-client"
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 )
@@ -34,6 +35,7 @@ type tekuSignedBeaconBlockJSON struct {
 var signedBeaconBlockRe1 = regexp.MustCompile(`"header_([12])"`)
 
 // SignedBeaconBlockBySlot fetches a signed beacon block given its slot.
+// N.B if there is no block at the given slot this will return an error that wraps client.ErrNotFound.
 func (s *Service) SignedBeaconBlockBySlot(ctx context.Context, slot spec.Slot) (*spec.SignedBeaconBlock, error) {
 	respBodyReader, err := s.get(ctx, fmt.Sprintf("/beacon/block?slot=%d", slot))
 	if err != nil {
@@ -52,13 +54,16 @@ func (s *Service) SignedBeaconBlockBySlot(ctx context.Context, slot spec.Slot) (
 		return nil, errors.Wrap(err, "failed to parse signed beacon block")
 	}
 	block := response.SignedBlock
+	if block == nil || block.Message == nil {
+		return nil, errors.Wrap(client.ErrNotFound, "failed to obtain signed beacon block")
+	}
 
 	// Ensure the data returned to us is as expected given our input.
 	if block.Message.Slot != slot {
 		if block.Message.Slot < slot {
 			// If teku does not have a block in a slot it will return an earlier one; treat this as not found.
 			log.Trace().Uint64("requested_slot", uint64(slot)).Uint64("returned_slot", uint64(block.Message.Slot)).Msg("Block returned for earlier slot; ignoring")
-			return nil, nil
+			return nil, errors.Wrap(client.ErrNotFound, "failed to obtain signed beacon block")
 		}
 
 		return nil, fmt.Errorf("failed to obtain correct block (requested %d, returned %d)", slot, block.Message.Slot)
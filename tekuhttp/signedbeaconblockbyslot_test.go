@@ -15,9 +15,11 @@ package tekuhttp_test
 
 import (
 	"context"
+	"errors"
 	"os"
 	"testing"
 
+	client "github.com/attestantio/go-eth2-client"
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/attestantio/go-eth2-client/tekuhttp"
 	"github.com/stretchr/testify/require"
@@ -25,12 +27,14 @@ import (
 
 func TestSignedBeaconBlockBySlot(t *testing.T) {
 	tests := []struct {
-		name string
-		slot spec.Slot
+		name     string
+		slot     spec.Slot
+		notFound bool
 	}{
 		{
-			name: "Missing",
-			slot: spec.Slot(1),
+			name:     "Missing",
+			slot:     spec.Slot(1),
+			notFound: true,
 		},
 		{
 			name: "Good",
@@ -59,6 +63,10 @@ func TestSignedBeaconBlockBySlot(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			block, err := service.SignedBeaconBlockBySlot(context.Background(), test.slot)
+			if test.notFound {
+				require.True(t, errors.Is(err, client.ErrNotFound))
+				return
+			}
 			require.NoError(t, err)
 			require.NotNil(t, block)
 		})